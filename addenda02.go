@@ -27,40 +27,40 @@ import (
 // It is used for following StandardEntryClassCode: MTE, POS, and SHR.
 type Addenda02 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. entryAddenda02 Pos 7
 	recordType string
 	// TypeCode Addenda02 type code '02'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// ReferenceInformationOne may be used for additional reference numbers, identification numbers,
 	// or codes that the merchant needs to identify the particular transaction or customer.
-	ReferenceInformationOne string `json:"referenceInformationOne,omitempty"`
+	ReferenceInformationOne string `json:"referenceInformationOne,omitempty" xml:"referenceInformationOne,omitempty"`
 	// ReferenceInformationTwo  may be used for additional reference numbers, identification numbers,
 	// or codes that the merchant needs to identify the particular transaction or customer.
-	ReferenceInformationTwo string `json:"referenceInformationTwo,omitempty"`
+	ReferenceInformationTwo string `json:"referenceInformationTwo,omitempty" xml:"referenceInformationTwo,omitempty"`
 	// TerminalIdentificationCode identifies an Electronic terminal with a unique code that allows
 	// a terminal owner and/or switching network to identify the terminal at which an Entry originated.
-	TerminalIdentificationCode string `json:"terminalIdentificationCode"`
+	TerminalIdentificationCode string `json:"terminalIdentificationCode" xml:"terminalIdentificationCode"`
 	// TransactionSerialNumber is assigned by the terminal at the time the transaction is originated.  The
 	// number, with the Terminal Identification Code, serves as an audit trail for the transaction and is
 	// usually assigned in ascending sequence.
-	TransactionSerialNumber string `json:"transactionSerialNumber"`
+	TransactionSerialNumber string `json:"transactionSerialNumber" xml:"transactionSerialNumber"`
 	// TransactionDate expressed MMDD identifies the date on which the transaction occurred.
-	TransactionDate string `json:"transactionDate"`
+	TransactionDate string `json:"transactionDate" xml:"transactionDate"`
 	// AuthorizationCodeOrExpireDate indicates the code that a card authorization center has
 	// furnished to the merchant.
-	AuthorizationCodeOrExpireDate string `json:"authorizationCodeOrExpireDate,omitempty"`
+	AuthorizationCodeOrExpireDate string `json:"authorizationCodeOrExpireDate,omitempty" xml:"authorizationCodeOrExpireDate,omitempty"`
 	// Terminal Location identifies the specific location of a terminal (i.e., street names of an
 	// intersection, address, etc.) in accordance with the requirements of Regulation E.
-	TerminalLocation string `json:"terminalLocation"`
+	TerminalLocation string `json:"terminalLocation" xml:"terminalLocation"`
 	// TerminalCity Identifies the city in which the electronic terminal is located.
-	TerminalCity string `json:"terminalCity"`
+	TerminalCity string `json:"terminalCity" xml:"terminalCity"`
 	// TerminalState Identifies the state in which the electronic terminal is located
-	TerminalState string `json:"terminalState"`
+	TerminalState string `json:"terminalState" xml:"terminalState"`
 	// TraceNumber Standard Entry Detail Trace Number
 	//
 	// Use TraceNumberField() for a properly formatted string representation.
-	TraceNumber string `json:"traceNumber,omitempty"`
+	TraceNumber string `json:"traceNumber,omitempty" xml:"traceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
@@ -27,22 +27,22 @@ import (
 // It is used for the following StandardEntryClassCode: ACK, ATX, CCD, CIE, CTX, DNE, ENR, WEB, PPD, TRX.
 type Addenda05 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. entryAddenda05 Pos 7
 	recordType string
 	// TypeCode Addenda05 types code '05'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// PaymentRelatedInformation
-	PaymentRelatedInformation string `json:"paymentRelatedInformation"`
+	PaymentRelatedInformation string `json:"paymentRelatedInformation" xml:"paymentRelatedInformation"`
 	// SequenceNumber is consecutively assigned to each Addenda05 Record following
 	// an Entry Detail Record. The first addenda05 sequence number must always
 	// be a "1".
-	SequenceNumber int `json:"sequenceNumber,omitempty"`
+	SequenceNumber int `json:"sequenceNumber,omitempty" xml:"sequenceNumber,omitempty"`
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
@@ -31,31 +31,31 @@ import (
 // the payment.
 type Addenda10 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// TypeCode Addenda10 types code '10'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// Transaction Type Code Describes the type of payment:
 	// ANN = Annuity, BUS = Business/Commercial, DEP = Deposit, LOA = Loan, MIS = Miscellaneous, MOR = Mortgage
 	// PEN = Pension, RLS = Rent/Lease, REM = Remittance2, SAL = Salary/Payroll, TAX = Tax, TEL = Telephone-Initiated Transaction
 	// WEB = Internet-Initiated Transaction, ARC = Accounts Receivable Entry, BOC = Back Office Conversion Entry,
 	// POP = Point of Purchase Entry, RCK = Re-presented Check Entry
-	TransactionTypeCode string `json:"transactionTypeCode"`
+	TransactionTypeCode string `json:"transactionTypeCode" xml:"transactionTypeCode"`
 	// Foreign Payment Amount $$$$$$$$$$$$$$$$¢¢
 	// For inbound IAT payments this field should contain the USD amount or may be blank.
-	ForeignPaymentAmount int `json:"foreignPaymentAmount"`
+	ForeignPaymentAmount int `json:"foreignPaymentAmount" xml:"foreignPaymentAmount"`
 	// Foreign Trace Number
-	ForeignTraceNumber string `json:"foreignTraceNumber,omitempty"`
+	ForeignTraceNumber string `json:"foreignTraceNumber,omitempty" xml:"foreignTraceNumber,omitempty"`
 	// Receiving Company Name/Individual Name
-	Name string `json:"name"`
+	Name string `json:"name" xml:"name"`
 	// reserved - Leave blank
 	reserved string
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
@@ -31,22 +31,22 @@ import (
 // the entry.
 type Addenda11 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// TypeCode Addenda11 types code '11'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// Originator Name contains the originators name (your company name / name)
-	OriginatorName string `json:"originatorName"`
+	OriginatorName string `json:"originatorName" xml:"originatorName"`
 	// Originator Street Address Contains the originators street address (your company's address / your address)
-	OriginatorStreetAddress string `json:"originatorStreetAddress"`
+	OriginatorStreetAddress string `json:"originatorStreetAddress" xml:"originatorStreetAddress"`
 	// reserved - Leave blank
 	reserved string
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
@@ -32,27 +32,27 @@ import (
 // foreign financial institution that is providing the funding and payment instruction for the IAT entry.
 type Addenda13 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// TypeCode Addenda13 types code '13'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// Originating DFI Name
 	// For Outbound IAT Entries, this field must contain the name of the U.S. ODFI.
 	// For Inbound IATs: Name of the foreign bank providing funding for the payment transaction
-	ODFIName string `json:"ODFIName"`
+	ODFIName string `json:"ODFIName" xml:"ODFIName"`
 	// Originating DFI Identification Number Qualifier
 	// For Inbound IATs: The 2-digit code that identifies the numbering scheme used in the
 	// Foreign DFI Identification Number field:
 	// 01 = National Clearing System
 	// 02 = BIC Code
 	// 03 = IBAN Code
-	ODFIIDNumberQualifier string `json:"ODFIIDNumberQualifier"`
+	ODFIIDNumberQualifier string `json:"ODFIIDNumberQualifier" xml:"ODFIIDNumberQualifier"`
 	// Originating DFI Identification
 	// This field contains the routing number that identifies the U.S. ODFI initiating the entry.
 	// For Inbound IATs: This field contains the bank ID number of the Foreign Bank providing funding
 	// for the payment transaction.
-	ODFIIdentification string `json:"ODFIIdentification"`
+	ODFIIdentification string `json:"ODFIIdentification" xml:"ODFIIdentification"`
 	// Originating DFI Branch Country Code
 	// USb” = United States
 	//(“b” indicates a blank space)
@@ -60,14 +60,14 @@ type Addenda13 struct {
 	// International Organization for Standardization (ISO) used to identify the country in which
 	// the branch of the bank that originated the entry is located. Values for other countries can
 	// be found on the International Organization for Standardization website: www.iso.org.
-	ODFIBranchCountryCode string `json:"ODFIBranchCountryCode"`
+	ODFIBranchCountryCode string `json:"ODFIBranchCountryCode" xml:"ODFIBranchCountryCode"`
 	// reserved - Leave blank
 	reserved string
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
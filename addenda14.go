@@ -30,25 +30,25 @@ import (
 // The Addenda14 identifies the Receiving financial institution holding the Receiver's account.
 type Addenda14 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// TypeCode Addenda14 types code '14'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// Receiving DFI Name
 	// Name of the Receiver's bank
-	RDFIName string `json:"RDFIName"`
+	RDFIName string `json:"RDFIName" xml:"RDFIName"`
 	// Receiving DFI Identification Number Qualifier
 	// The 2-digit code that identifies the numbering scheme used in the
 	// Receiving DFI Identification Number field:
 	// 01 = National Clearing System
 	// 02 = BIC Code
 	// 03 = IBAN Code
-	RDFIIDNumberQualifier string `json:"RDFIIDNumberQualifier"`
+	RDFIIDNumberQualifier string `json:"RDFIIDNumberQualifier" xml:"RDFIIDNumberQualifier"`
 	// Receiving DFI Identification
 	// This field contains the bank identification number of the DFI at which the
 	// Receiver maintains his account.
-	RDFIIdentification string `json:"RDFIIdentification"`
+	RDFIIdentification string `json:"RDFIIdentification" xml:"RDFIIdentification"`
 	// Receiving DFI Branch Country Code
 	// USb” = United States
 	//(“b” indicates a blank space)
@@ -56,14 +56,14 @@ type Addenda14 struct {
 	// Organization for Standardization (ISO) used to identify the country in which the
 	// branch of the bank that receives the entry is located. Values for other countries can
 	// be found on the International Organization for Standardization website: www.iso.org
-	RDFIBranchCountryCode string `json:"RDFIBranchCountryCode"`
+	RDFIBranchCountryCode string `json:"RDFIBranchCountryCode" xml:"RDFIBranchCountryCode"`
 	// reserved - Leave blank
 	reserved string
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
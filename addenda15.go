@@ -30,24 +30,24 @@ import (
 // The Addenda15 record identifies key information related to the Receiver.
 type Addenda15 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// TypeCode Addenda15 types code '15'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// Receiver Identification Number contains the accounting number by which the Originator is known to
 	// the Receiver for descriptive purposes. NACHA Rules recommend but do not require the RDFI to print
 	// the contents of this field on the receiver's statement.
-	ReceiverIDNumber string `json:"receiverIDNumber,omitempty"`
+	ReceiverIDNumber string `json:"receiverIDNumber,omitempty" xml:"receiverIDNumber,omitempty"`
 	// Receiver Street Address contains the Receiver's physical address
-	ReceiverStreetAddress string `json:"receiverStreetAddress"`
+	ReceiverStreetAddress string `json:"receiverStreetAddress" xml:"receiverStreetAddress"`
 	// reserved - Leave blank
 	reserved string
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
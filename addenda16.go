@@ -30,27 +30,27 @@ import (
 // The Addenda16 record identifies key information related to the Receiver.
 type Addenda16 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// TypeCode Addenda16 types code '16'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// Receiver City & State / Province
 	// Data elements City and State / Province  should be separated with an asterisk (*) as a delimiter
 	// and the field should end with a backslash (\).
 	// For example: San Francisco*CA\
-	ReceiverCityStateProvince string `json:"receiverCityStateProvince"`
+	ReceiverCityStateProvince string `json:"receiverCityStateProvince" xml:"receiverCityStateProvince"`
 	// Receiver Country & Postal Code
 	// Data elements must be separated by an asterisk (*) and must end with a backslash (\)
 	// For example: US*10036\
-	ReceiverCountryPostalCode string `json:"receiverCountryPostalCode"`
+	ReceiverCountryPostalCode string `json:"receiverCountryPostalCode" xml:"receiverCountryPostalCode"`
 	// reserved - Leave blank
 	reserved string
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
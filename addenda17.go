@@ -31,22 +31,22 @@ import (
 // may be included with each IAT entry.
 type Addenda17 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. entryAddenda17 Pos 7
 	recordType string
 	// TypeCode Addenda17 types code '17'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// PaymentRelatedInformation
-	PaymentRelatedInformation string `json:"paymentRelatedInformation"`
+	PaymentRelatedInformation string `json:"paymentRelatedInformation" xml:"paymentRelatedInformation"`
 	// SequenceNumber is consecutively assigned to each Addenda17 Record following
 	// an Entry Detail Record. The first addenda17 sequence number must always
 	// be a "1".
-	SequenceNumber int `json:"sequenceNumber,omitempty"`
+	SequenceNumber int `json:"sequenceNumber,omitempty" xml:"sequenceNumber,omitempty"`
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
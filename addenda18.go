@@ -32,39 +32,39 @@ import (
 // included. A maximum of five of these Addenda Records may be included with each IAT entry.
 type Addenda18 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. entryAddenda18 Pos 7
 	recordType string
 	// TypeCode Addenda18 types code '18'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// ForeignCorrespondentBankName contains the name of the Foreign Correspondent Bank
-	ForeignCorrespondentBankName string `json:"foreignCorrespondentBankName"`
+	ForeignCorrespondentBankName string `json:"foreignCorrespondentBankName" xml:"foreignCorrespondentBankName"`
 	// Foreign Correspondent Bank Identification Number Qualifier contains a 2-digit code that
 	// identifies the numbering scheme used in the Foreign Correspondent Bank Identification Number
 	// field. Code values for this field are:
 	// “01” = National Clearing System
 	// “02” = BIC Code
 	// “03” = IBAN Code
-	ForeignCorrespondentBankIDNumberQualifier string `json:"foreignCorrespondentBankIDNumberQualifier"`
+	ForeignCorrespondentBankIDNumberQualifier string `json:"foreignCorrespondentBankIDNumberQualifier" xml:"foreignCorrespondentBankIDNumberQualifier"`
 	// Foreign Correspondent Bank Identification Number contains the bank ID number of the Foreign
 	// Correspondent Bank
-	ForeignCorrespondentBankIDNumber string `json:"foreignCorrespondentBankIDNumber"`
+	ForeignCorrespondentBankIDNumber string `json:"foreignCorrespondentBankIDNumber" xml:"foreignCorrespondentBankIDNumber"`
 	// Foreign Correspondent Bank Branch Country Code contains the two-character code, as approved by
 	// the International Organization for Standardization (ISO), to identify the country in which the
 	// branch of the Foreign Correspondent Bank is located. Values can be found on the International
 	// Organization for Standardization website: www.iso.org
-	ForeignCorrespondentBankBranchCountryCode string `json:"foreignCorrespondentBankBranchCountryCode"`
+	ForeignCorrespondentBankBranchCountryCode string `json:"foreignCorrespondentBankBranchCountryCode" xml:"foreignCorrespondentBankBranchCountryCode"`
 	// reserved - Leave blank
 	reserved string
 	// SequenceNumber is consecutively assigned to each Addenda18 Record following
 	// an Entry Detail Record. The first addenda18 sequence number must always
 	// be a "1".
-	SequenceNumber int `json:"sequenceNumber,omitempty"`
+	SequenceNumber int `json:"sequenceNumber,omitempty" xml:"sequenceNumber,omitempty"`
 	// EntryDetailSequenceNumber contains the ascending sequence number section of the Entry
 	// Detail or Corporate Entry Detail Record's trace number This number is
 	// the same as the last seven digits of the trace number of the related
 	// Entry Detail Record or Corporate Entry Detail Record.
-	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty"`
+	EntryDetailSequenceNumber int `json:"entryDetailSequenceNumber,omitempty" xml:"entryDetailSequenceNumber,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
@@ -28,26 +28,26 @@ import (
 // The field contents for Notification of Change Entries must match the field contents of the original Entries
 type Addenda98 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. entryAddendaPos 7
 	recordType string
 	// TypeCode Addenda types code '98'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// ChangeCode field contains a standard code used by an ACH Operator or RDFI to describe the reason for a change Entry.
 	// Must exist in changeCodeDict
-	ChangeCode string `json:"changeCode"`
+	ChangeCode string `json:"changeCode" xml:"changeCode"`
 	// OriginalTrace This field contains the Trace Number as originally included on the forward Entry or Prenotification.
 	// The RDFI must include the Original Entry Trace Number in the Addenda Record of an Entry being returned to an ODFI,
 	// in the Addenda Record of an 98, within an Acknowledgment Entry, or with an RDFI request for a copy of an authorization.
-	OriginalTrace string `json:"originalTrace"`
+	OriginalTrace string `json:"originalTrace" xml:"originalTrace"`
 	// OriginalDFI field contains the Receiving DFI Identification (addenda.RDFIIdentification) as originally included on the forward Entry or Prenotification that the RDFI is returning or correcting.
-	OriginalDFI string `json:"originalDFI"`
+	OriginalDFI string `json:"originalDFI" xml:"originalDFI"`
 	// CorrectedData
-	CorrectedData string `json:"correctedData"`
+	CorrectedData string `json:"correctedData" xml:"correctedData"`
 	// TraceNumber matches the Entry Detail Trace Number of the entry being returned.
 	//
 	// Use TraceNumberField() for a properly formatted string representation.
-	TraceNumber string `json:"traceNumber,omitempty"`
+	TraceNumber string `json:"traceNumber,omitempty" xml:"traceNumber,omitempty"`
 
 	// validator is composed for data validation
 	validator
@@ -42,28 +42,28 @@ func init() {
 // Addenda99 utilized for Notification of Change Entry (COR) and Return types.
 type Addenda99 struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. entryAddendaPos 7
 	recordType string
 	// TypeCode Addenda types code '99'
-	TypeCode string `json:"typeCode"`
+	TypeCode string `json:"typeCode" xml:"typeCode"`
 	// ReturnCode field contains a standard code used by an ACH Operator or RDFI to describe the reason for returning an Entry.
 	// Must exist in returnCodeDict
-	ReturnCode string `json:"returnCode"`
+	ReturnCode string `json:"returnCode" xml:"returnCode"`
 	// OriginalTrace This field contains the Trace Number as originally included on the forward Entry or Prenotification.
 	// The RDFI must include the Original Entry Trace Number in the Addenda Record of an Entry being returned to an ODFI,
 	// in the Addenda Record of an 98, within an Acknowledgment Entry, or with an RDFI request for a copy of an authorization.
-	OriginalTrace string `json:"originalTrace"`
+	OriginalTrace string `json:"originalTrace" xml:"originalTrace"`
 	// DateOfDeath The field date of death is to be supplied on Entries being returned for reason of death (return reason codes R14 and R15). Format: YYMMDD (Y=Year, M=Month, D=Day)
-	DateOfDeath string `json:"dateOfDeath"`
+	DateOfDeath string `json:"dateOfDeath" xml:"dateOfDeath"`
 	// OriginalDFI field contains the Receiving DFI Identification (addenda.RDFIIdentification) as originally included on the forward Entry or Prenotification that the RDFI is returning or correcting.
-	OriginalDFI string `json:"originalDFI"`
+	OriginalDFI string `json:"originalDFI" xml:"originalDFI"`
 	// AddendaInformation
-	AddendaInformation string `json:"addendaInformation,omitempty"`
+	AddendaInformation string `json:"addendaInformation,omitempty" xml:"addendaInformation,omitempty"`
 	// TraceNumber matches the Entry Detail Trace Number of the entry being returned.
 	//
 	// Use TraceNumberField() for a properly formatted string representation.
-	TraceNumber string `json:"traceNumber,omitempty"`
+	TraceNumber string `json:"traceNumber,omitempty" xml:"traceNumber,omitempty"`
 
 	// validator is composed for data validation
 	validator
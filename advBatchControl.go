@@ -28,34 +28,34 @@ import (
 // entries contained in the preceding batch
 type ADVBatchControl struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// This should be the same as BatchHeader ServiceClassCode for ADV: AutomatedAccountingAdvices.
-	ServiceClassCode int `json:"serviceClassCode"`
+	ServiceClassCode int `json:"serviceClassCode" xml:"serviceClassCode"`
 	// EntryAddendaCount is a tally of each Entry Detail Record and each Addenda
 	// Record processed, within either the batch or file as appropriate.
-	EntryAddendaCount int `json:"entryAddendaCount"`
+	EntryAddendaCount int `json:"entryAddendaCount" xml:"entryAddendaCount"`
 	// validate the Receiving DFI Identification in each Entry Detail Record is hashed
 	// to provide a check against inadvertent alteration of data contents due
 	// to hardware failure or program error
 	//
 	// In this context the Entry Hash is the sum of the corresponding fields in the
 	// Entry Detail Records on the file.
-	EntryHash int `json:"entryHash"`
+	EntryHash int `json:"entryHash" xml:"entryHash"`
 	// TotalDebitEntryDollarAmount Contains accumulated Entry debit totals within the batch.
-	TotalDebitEntryDollarAmount int `json:"totalDebit"`
+	TotalDebitEntryDollarAmount int `json:"totalDebit" xml:"totalDebit"`
 	// TotalCreditEntryDollarAmount Contains accumulated Entry credit totals within the batch.
-	TotalCreditEntryDollarAmount int `json:"totalCredit"`
+	TotalCreditEntryDollarAmount int `json:"totalCredit" xml:"totalCredit"`
 	// ACHOperatorData is an alphanumeric code used to identify an ACH Operator
-	ACHOperatorData string `json:"achOperatorData"`
+	ACHOperatorData string `json:"achOperatorData" xml:"achOperatorData"`
 	// ODFIIdentification the routing number is used to identify the DFI originating entries within a given branch.
-	ODFIIdentification string `json:"ODFIIdentification"`
+	ODFIIdentification string `json:"ODFIIdentification" xml:"ODFIIdentification"`
 	// BatchNumber this number is assigned in ascending sequence to each batch by the ODFI
 	// or its Sending Point in a given file of entries. Since the batch number
 	// in the Batch Header Record and the Batch Control Record is the same,
 	// the ascending sequence number should be assigned by batch and not by record.
-	BatchNumber int `json:"batchNumber"`
+	BatchNumber int `json:"batchNumber" xml:"batchNumber"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to golang Converters
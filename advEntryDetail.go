@@ -30,7 +30,7 @@ import (
 // institution, the account number (left justify,no zero fill), name, and dollar amount.
 type ADVEntryDetail struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. 6
 	recordType string
 	// TransactionCode representing Accounting Entries
@@ -42,46 +42,46 @@ type ADVEntryDetail struct {
 	// Debit for ACH debits in rejected batches - 86
 	// Summary credit for respondent ACH activity - 87
 	// Summary debit for respondent ACH activity - 88
-	TransactionCode int `json:"transactionCode"`
+	TransactionCode int `json:"transactionCode" xml:"transactionCode"`
 	// RDFIIdentification is the RDFI's routing number without the last digit.
 	// Receiving Depository Financial Institution
-	RDFIIdentification string `json:"RDFIIdentification"`
+	RDFIIdentification string `json:"RDFIIdentification" xml:"RDFIIdentification"`
 	// CheckDigit the last digit of the RDFI's routing number
-	CheckDigit string `json:"checkDigit"`
+	CheckDigit string `json:"checkDigit" xml:"checkDigit"`
 	// DFIAccountNumber is the receiver's bank account number you are crediting/debiting.
 	// It important to note that this is an alphanumeric field, so its space padded, no zero padded
-	DFIAccountNumber string `json:"DFIAccountNumber"`
+	DFIAccountNumber string `json:"DFIAccountNumber" xml:"DFIAccountNumber"`
 	// Amount Number of cents you are debiting/crediting this account
-	Amount int `json:"amount"`
+	Amount int `json:"amount" xml:"amount"`
 	// AdviceRoutingNumber
-	AdviceRoutingNumber string `json:"adviceRoutingNumber"`
+	AdviceRoutingNumber string `json:"adviceRoutingNumber" xml:"adviceRoutingNumber"`
 	// FileIdentification
-	FileIdentification string `json:"fileIdentification,omitempty"`
+	FileIdentification string `json:"fileIdentification,omitempty" xml:"fileIdentification,omitempty"`
 	// ACHOperatorData
-	ACHOperatorData string `json:"achOperatorData,omitempty"`
+	ACHOperatorData string `json:"achOperatorData,omitempty" xml:"achOperatorData,omitempty"`
 	// IndividualName The name of the receiver, usually the name on the bank account
-	IndividualName string `json:"individualName"`
+	IndividualName string `json:"individualName" xml:"individualName"`
 	// DiscretionaryData allows ODFIs to include codes, of significance only to them,
 	// to enable specialized handling of the entry. There will be no
 	// standardized interpretation for the value of this field. It can either
 	// be a single two-character code, or two distinct one-character codes,
 	// according to the needs of the ODFI and/or Originator involved. This
 	// field must be returned intact for any returned entry.
-	DiscretionaryData string `json:"discretionaryData,omitempty"`
+	DiscretionaryData string `json:"discretionaryData,omitempty" xml:"discretionaryData,omitempty"`
 	// AddendaRecordIndicator indicates the existence of an Addenda Record.
 	// A value of "1" indicates that one ore more addenda records follow,
 	// and "0" means no such record is present.
-	AddendaRecordIndicator int `json:"addendaRecordIndicator,omitempty"`
+	AddendaRecordIndicator int `json:"addendaRecordIndicator,omitempty" xml:"addendaRecordIndicator,omitempty"`
 	// ACHOperatorRoutingNumber
-	ACHOperatorRoutingNumber string `json:"achOperatorRoutingNumber"`
+	ACHOperatorRoutingNumber string `json:"achOperatorRoutingNumber" xml:"achOperatorRoutingNumber"`
 	// JulianDay
-	JulianDay int `json:"julianDay"`
+	JulianDay int `json:"julianDay" xml:"julianDay"`
 	// SequenceNumber
-	SequenceNumber int `json:"sequenceNumber,omitempty"`
+	SequenceNumber int `json:"sequenceNumber,omitempty" xml:"sequenceNumber,omitempty"`
 	// Addenda99 for use with Returns
-	Addenda99 *Addenda99 `json:"addenda99,omitempty"`
+	Addenda99 *Addenda99 `json:"addenda99,omitempty" xml:"addenda99,omitempty"`
 	// Category defines if the entry is a Forward, Return, or NOC
-	Category string `json:"category,omitempty"`
+	Category string `json:"category,omitempty" xml:"category,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to golang Converters
@@ -0,0 +1,122 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package audit records the history of changes to ACH files stored by the server.
+//
+// Each Event names the operation, the file (and batch, entry or addenda) it changed,
+// who made the change in which request and summaries of the file before and after.
+// Events are kept in a Store, such as NewStoreInMemory or NewStoreOnDisk.
+package audit
+
+import (
+	"time"
+
+	"github.com/moov-io/ach"
+)
+
+// Operations recorded in an Event
+const (
+	FileCreated          = "file.created"
+	FileValidated        = "file.validated"
+	FileValidationFailed = "file.validationFailed"
+	FileBalanced         = "file.balanced"
+	FileSegmented        = "file.segmented"
+	FileFlattened        = "file.flattened"
+	FileMerged           = "file.merged"
+	FileDeleted          = "file.deleted"
+	FileExpired          = "file.expired"
+	BatchCreated         = "batch.created"
+	BatchDeleted         = "batch.deleted"
+	EntryCreated         = "entry.created"
+	EntryUpdated         = "entry.updated"
+	EntryDeleted         = "entry.deleted"
+	AddendaCreated       = "addenda.created"
+	AddendaUpdated       = "addenda.updated"
+	AddendaDeleted       = "addenda.deleted"
+)
+
+// Event is an operation on a file.
+type Event struct {
+	ID        string `json:"id"`
+	Operation string `json:"operation"`
+	FileID    string `json:"fileID"`
+	BatchID   string `json:"batchID,omitempty"`
+	EntryID   string `json:"entryID,omitempty"`
+	AddendaID string `json:"addendaID,omitempty"`
+
+	// RelatedFileIDs are the files created from this one (such as by FileSegmented),
+	// or the files this one was created from.
+	RelatedFileIDs []string `json:"relatedFileIDs,omitempty"`
+
+	// RequestID and Actor identify the request which made the change and who sent it
+	RequestID string `json:"requestID,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+
+	// Tenant owns the file, it's empty unless the server authenticates requests
+	Tenant string `json:"tenant,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Before and After summarize the file around the operation, they're nil when the file didn't exist
+	Before *Summary `json:"before,omitempty"`
+	After  *Summary `json:"after,omitempty"`
+
+	// Error is the validation error of FileValidationFailed events, or problems with created files
+	Error string `json:"error,omitempty"`
+}
+
+// Summary describes the contents of a file at a point in time.
+type Summary struct {
+	Batches int `json:"batches"`
+	Entries int `json:"entries"`
+
+	// TotalDebit and TotalCredit are the sum of entry amounts in cents
+	TotalDebit  int `json:"totalDebit"`
+	TotalCredit int `json:"totalCredit"`
+}
+
+// Summarize returns the Summary of f, or nil if f is nil.
+func Summarize(f *ach.File) *Summary {
+	if f == nil {
+		return nil
+	}
+	s := &Summary{
+		Batches: len(f.Batches) + len(f.IATBatches),
+	}
+	add := func(transactionCode int, amount int) {
+		s.Entries++
+		switch (&ach.EntryDetail{TransactionCode: transactionCode}).CreditOrDebit() {
+		case "C":
+			s.TotalCredit += amount
+		case "D":
+			s.TotalDebit += amount
+		}
+	}
+	for _, b := range f.Batches {
+		for _, e := range b.GetEntries() {
+			add(e.TransactionCode, e.Amount)
+		}
+		// ADV entries are accounting records, so count them without adding to the totals
+		s.Entries += len(b.GetADVEntries())
+	}
+	for _, b := range f.IATBatches {
+		for _, e := range b.Entries {
+			add(e.TransactionCode, e.Amount)
+		}
+	}
+	return s
+}
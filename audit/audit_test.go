@@ -0,0 +1,46 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func TestSummarize(t *testing.T) {
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := Summarize(&file)
+	if s.Batches != 1 || s.Entries != 1 || s.TotalDebit != 100000000 || s.TotalCredit != 0 {
+		t.Errorf("unexpected summary: %#v", s)
+	}
+	if s := Summarize(nil); s != nil {
+		t.Errorf("unexpected summary: %#v", s)
+	}
+}
@@ -0,0 +1,127 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Store keeps the history of each file.
+type Store interface {
+	// Record saves event.
+	Record(event Event) error
+	// History returns the events of fileID, oldest first.
+	History(fileID string) ([]Event, error)
+}
+
+type storeInMemory struct {
+	mu     sync.RWMutex
+	events map[string][]Event // fileID -> events
+}
+
+// NewStoreInMemory returns a Store which keeps events in memory.
+func NewStoreInMemory() Store {
+	return &storeInMemory{
+		events: make(map[string][]Event),
+	}
+}
+
+func (s *storeInMemory) Record(event Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events[event.FileID] = append(s.events[event.FileID], event)
+	return nil
+}
+
+func (s *storeInMemory) History(fileID string) ([]Event, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]Event(nil), s.events[fileID]...), nil
+}
+
+// StoreOnDisk keeps every event in memory and appends new events to a file so they
+// survive restarts. Each line of the file is an Event encoded as JSON.
+type StoreOnDisk struct {
+	mem *storeInMemory
+
+	mu sync.Mutex
+	fd *os.File
+}
+
+// NewStoreOnDisk returns a Store which appends events to the file at path. Existing
+// events in path are loaded, so history is kept across restarts.
+//
+// Callers should Close the returned store when finished.
+func NewStoreOnDisk(path string) (*StoreOnDisk, error) {
+	if path == "" {
+		return nil, errors.New("audit: empty path")
+	}
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("audit: opening %s: %v", path, err)
+	}
+	mem := &storeInMemory{events: make(map[string][]Event)}
+	scanner := bufio.NewScanner(fd)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil || event.FileID == "" {
+			continue // skip partially written lines
+		}
+		mem.Record(event)
+	}
+	if err := scanner.Err(); err != nil {
+		fd.Close()
+		return nil, fmt.Errorf("audit: reading %s: %v", path, err)
+	}
+	return &StoreOnDisk{mem: mem, fd: fd}, nil
+}
+
+func (s *StoreOnDisk) Record(event Event) error {
+	bs, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.fd.Write(append(bs, '\n')); err != nil {
+		return err
+	}
+	if err := s.fd.Sync(); err != nil {
+		return err
+	}
+	return s.mem.Record(event)
+}
+
+func (s *StoreOnDisk) History(fileID string) ([]Event, error) {
+	return s.mem.History(fileID)
+}
+
+// Close releases the underlying file.
+func (s *StoreOnDisk) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fd.Close()
+}
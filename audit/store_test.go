@@ -0,0 +1,97 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testStore(t *testing.T, store Store) {
+	t.Helper()
+
+	if events, err := store.History("f1"); len(events) != 0 || err != nil {
+		t.Fatalf("events=%#v error=%v", events, err)
+	}
+	now := time.Now()
+	for _, event := range []Event{
+		{ID: "1", Operation: FileCreated, FileID: "f1", CreatedAt: now, After: &Summary{Batches: 1, Entries: 1, TotalDebit: 100}},
+		{ID: "2", Operation: FileCreated, FileID: "f2", CreatedAt: now},
+		{ID: "3", Operation: BatchDeleted, FileID: "f1", BatchID: "b1", Actor: "jane", CreatedAt: now.Add(time.Second)},
+	} {
+		if err := store.Record(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+	events, err := store.History("f1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].ID != "1" || events[1].ID != "3" {
+		t.Fatalf("events=%#v", events)
+	}
+	if events[0].After == nil || events[0].After.TotalDebit != 100 || events[1].Actor != "jane" {
+		t.Errorf("events=%#v", events)
+	}
+}
+
+func TestStoreInMemory(t *testing.T) {
+	testStore(t, NewStoreInMemory())
+}
+
+func TestStoreOnDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "history")
+	store, err := NewStoreOnDisk(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, store)
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// re-open and read the history again, skipping a partially written line
+	fd, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd.WriteString(`{"id":"4","operation":"file.del`)
+	fd.Close()
+
+	store, err = NewStoreOnDisk(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if events, err := store.History("f1"); len(events) != 2 || err != nil {
+		t.Fatalf("events=%#v error=%v", events, err)
+	}
+
+	if _, err := NewStoreOnDisk(""); err == nil {
+		t.Error("expected error")
+	}
+}
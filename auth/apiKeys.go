@@ -0,0 +1,86 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// APIKey grants the Principal to callers sending Key.
+type APIKey struct {
+	Key string `json:"key"`
+
+	Principal
+}
+
+type apiKeys map[[sha256.Size]byte]Principal
+
+// NewAPIKeys returns an Authenticator for the static keys.
+func NewAPIKeys(keys []APIKey) (Authenticator, error) {
+	out := make(apiKeys, len(keys))
+	for i := range keys {
+		if keys[i].Key == "" {
+			return nil, fmt.Errorf("API key %d: missing key", i)
+		}
+		if err := keys[i].Principal.Validate(); err != nil {
+			return nil, fmt.Errorf("API key %d: %v", i, err)
+		}
+		hash := sha256.Sum256([]byte(keys[i].Key))
+		if _, exists := out[hash]; exists {
+			return nil, fmt.Errorf("API key %d: duplicate key", i)
+		}
+		out[hash] = keys[i].Principal
+	}
+	return out, nil
+}
+
+// ReadAPIKeysFile reads a JSON array of APIKey objects from path, for example:
+//
+//	[{"key": "...", "subject": "payroll", "tenant": "acme", "scopes": ["read", "write"]}]
+func ReadAPIKeysFile(path string) (Authenticator, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var keys []APIKey
+	if err := json.Unmarshal(bs, &keys); err != nil {
+		return nil, fmt.Errorf("problem reading API keys: %v", err)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no API keys found")
+	}
+	return NewAPIKeys(keys)
+}
+
+// Authenticate compares the SHA-256 hash of token against each key's, so lookups don't
+// depend on the key's value.
+func (keys apiKeys) Authenticate(token string) (*Principal, error) {
+	if token == "" {
+		return nil, ErrUnauthenticated
+	}
+	p, ok := keys[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	p.Scopes = append([]string(nil), p.Scopes...)
+	return &p, nil
+}
@@ -0,0 +1,85 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAPIKeys(t *testing.T) {
+	keys, err := NewAPIKeys([]APIKey{
+		{Key: "secret", Principal: Principal{Subject: "payroll", Tenant: "acme", Scopes: []string{ScopeRead, ScopeWrite}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := keys.Authenticate("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Subject != "payroll" || p.Tenant != "acme" || !p.Allowed(ScopeWrite) {
+		t.Errorf("unexpected principal: %#v", p)
+	}
+	for _, token := range []string{"", "other"} {
+		if _, err := keys.Authenticate(token); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("%q: unexpected error: %v", token, err)
+		}
+	}
+
+	// invalid keys
+	for _, k := range [][]APIKey{
+		{{Principal: Principal{Tenant: "acme"}}},
+		{{Key: "secret", Principal: Principal{Tenant: "a.b"}}},
+		{{Key: "secret", Principal: Principal{Tenant: "a"}}, {Key: "secret", Principal: Principal{Tenant: "b"}}},
+	} {
+		if _, err := NewAPIKeys(k); err == nil {
+			t.Errorf("expected error: %#v", k)
+		}
+	}
+}
+
+func TestReadAPIKeysFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "auth")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "keys.json")
+	ioutil.WriteFile(path, []byte(`[{"key": "secret", "tenant": "acme", "scopes": ["read"]}]`), 0600)
+
+	keys, err := ReadAPIKeysFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err := keys.Authenticate("secret"); err != nil || p.Tenant != "acme" || !p.Allowed(ScopeRead) {
+		t.Errorf("principal=%#v error=%v", p, err)
+	}
+
+	ioutil.WriteFile(path, []byte(`[]`), 0600)
+	if _, err := ReadAPIKeysFile(path); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := ReadAPIKeysFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,154 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package auth authenticates requests to the ACH server with static API keys or JWTs
+// signed by keys from a local JWKS file.
+//
+// Each credential resolves to a Principal which names the tenant whose files it can
+// access and the scopes (read, write, delete or admin) it's allowed to use.
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// Scopes which can be granted to a Principal.
+const (
+	// ScopeRead allows reading files (GET requests)
+	ScopeRead = "read"
+	// ScopeWrite allows creating and changing files (POST and PUT requests)
+	ScopeWrite = "write"
+	// ScopeDelete allows deleting files and batches (DELETE requests)
+	ScopeDelete = "delete"
+	// ScopeAdmin allows everything, including routes shared by every tenant
+	ScopeAdmin = "admin"
+)
+
+var (
+	// ErrUnauthenticated is returned when credentials are missing or don't match any Authenticator.
+	ErrUnauthenticated = errors.New("unauthenticated")
+
+	tenantPattern = regexp.MustCompile(`^[A-Za-z0-9-]{1,64}$`)
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// Subject identifies the caller, such as the API key's name or the JWT's "sub" claim
+	Subject string `json:"subject"`
+	// Tenant scopes every file the caller can access
+	Tenant string   `json:"tenant"`
+	Scopes []string `json:"scopes"`
+}
+
+// Allowed returns true if the Principal was granted scope or ScopeAdmin.
+func (p *Principal) Allowed(scope string) bool {
+	if p == nil {
+		return false
+	}
+	for _, s := range p.Scopes {
+		if s == scope || s == ScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the Principal has a valid tenant and known scopes.
+func (p *Principal) Validate() error {
+	if p == nil {
+		return errors.New("nil Principal")
+	}
+	if err := ValidateTenant(p.Tenant); err != nil {
+		return err
+	}
+	for _, s := range p.Scopes {
+		switch s {
+		case ScopeRead, ScopeWrite, ScopeDelete, ScopeAdmin:
+		default:
+			return fmt.Errorf("unknown scope %q", s)
+		}
+	}
+	return nil
+}
+
+// ValidateTenant checks tenant is 1-64 letters, digits or dashes.
+func ValidateTenant(tenant string) error {
+	if !tenantPattern.MatchString(tenant) {
+		return fmt.Errorf("invalid tenant %q: must be 1-64 letters, digits or dashes", tenant)
+	}
+	return nil
+}
+
+// Authenticator resolves the token sent by a caller into a Principal.
+type Authenticator interface {
+	// Authenticate returns the Principal of token or an error wrapping ErrUnauthenticated.
+	Authenticate(token string) (*Principal, error)
+}
+
+type chain []Authenticator
+
+// Chain returns an Authenticator which tries each of auths in order and returns the first Principal found.
+func Chain(auths ...Authenticator) Authenticator {
+	return chain(auths)
+}
+
+func (c chain) Authenticate(token string) (*Principal, error) {
+	err := ErrUnauthenticated
+	for _, a := range c {
+		p, e := a.Authenticate(token)
+		if e == nil {
+			return p, nil
+		}
+		if !errors.Is(e, ErrUnauthenticated) {
+			err = e // keep the most specific problem
+		}
+	}
+	return nil, err
+}
+
+// TokenFromRequest returns the token of an "Authorization: Bearer <token>" header, or the
+// "X-API-Key" header when there is none.
+func TokenFromRequest(r *http.Request) string {
+	return TokenFromHeaders(r.Header.Get("Authorization"), r.Header.Get("X-API-Key"))
+}
+
+// TokenFromHeaders is TokenFromRequest for the values of the Authorization and X-API-Key headers,
+// such as those read from gRPC metadata.
+func TokenFromHeaders(authorization, apiKey string) string {
+	if parts := strings.SplitN(strings.TrimSpace(authorization), " ", 2); len(parts) == 2 && strings.EqualFold(parts[0], "bearer") {
+		return strings.TrimSpace(parts[1])
+	}
+	return strings.TrimSpace(apiKey)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx which carries p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the Principal stored by NewContext, or nil.
+func FromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(contextKey{}).(*Principal)
+	return p
+}
@@ -0,0 +1,95 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPrincipal(t *testing.T) {
+	p := &Principal{Tenant: "acme", Scopes: []string{ScopeRead}}
+	if err := p.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Allowed(ScopeRead) || p.Allowed(ScopeDelete) {
+		t.Errorf("unexpected scopes: %v", p.Scopes)
+	}
+
+	admin := &Principal{Tenant: "acme", Scopes: []string{ScopeAdmin}}
+	if !admin.Allowed(ScopeDelete) {
+		t.Error("admin should be allowed everything")
+	}
+
+	var none *Principal
+	if none.Allowed(ScopeRead) || none.Validate() == nil {
+		t.Error("nil Principal")
+	}
+	for _, tenant := range []string{"", "a.b", "a/b", "a_b"} {
+		if err := (&Principal{Tenant: tenant}).Validate(); err == nil {
+			t.Errorf("tenant %q: expected error", tenant)
+		}
+	}
+	if err := (&Principal{Tenant: "acme", Scopes: []string{"root"}}).Validate(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestChain(t *testing.T) {
+	first, _ := NewAPIKeys([]APIKey{{Key: "one", Principal: Principal{Tenant: "a"}}})
+	second, _ := NewAPIKeys([]APIKey{{Key: "two", Principal: Principal{Tenant: "b"}}})
+	a := Chain(first, second)
+
+	if p, err := a.Authenticate("two"); err != nil || p.Tenant != "b" {
+		t.Errorf("principal=%#v error=%v", p, err)
+	}
+	if _, err := a.Authenticate("three"); !errors.Is(err, ErrUnauthenticated) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTokenFromRequest(t *testing.T) {
+	r := httptest.NewRequest("GET", "/files", nil)
+	if tok := TokenFromRequest(r); tok != "" {
+		t.Errorf("token=%q", tok)
+	}
+	r.Header.Set("X-API-Key", "key")
+	if tok := TokenFromRequest(r); tok != "key" {
+		t.Errorf("token=%q", tok)
+	}
+	r.Header.Set("Authorization", "Bearer jwt")
+	if tok := TokenFromRequest(r); tok != "jwt" {
+		t.Errorf("token=%q", tok)
+	}
+	if tok := TokenFromHeaders("Basic abc", ""); tok != "" {
+		t.Errorf("token=%q", tok)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if p := FromContext(ctx); p != nil {
+		t.Errorf("unexpected principal: %#v", p)
+	}
+	ctx = NewContext(ctx, &Principal{Tenant: "acme"})
+	if p := FromContext(ctx); p == nil || p.Tenant != "acme" {
+		t.Errorf("unexpected principal: %#v", p)
+	}
+}
@@ -0,0 +1,336 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // register SHA-256 for crypto.Hash
+	_ "crypto/sha512" // register SHA-384 and SHA-512 for crypto.Hash
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// JWTConfig describes which tokens are accepted and how claims map onto a Principal.
+type JWTConfig struct {
+	// Issuer and Audience must match the "iss" and "aud" claims when set
+	Issuer   string
+	Audience string
+
+	// TenantClaim names the claim holding the Principal's tenant, defaults to "tenant"
+	TenantClaim string
+
+	// Leeway allows for clock skew when checking the "exp" and "nbf" claims
+	Leeway time.Duration
+}
+
+// jsonWebKey is a public key of a JWKS (RFC 7517)
+type jsonWebKey struct {
+	KeyType string `json:"kty"`
+	KeyID   string `json:"kid"`
+	Use     string `json:"use"`
+	Alg     string `json:"alg"`
+
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+
+	// EC
+	Curve string `json:"crv"`
+	X     string `json:"x"`
+	Y     string `json:"y"`
+}
+
+type publicKey struct {
+	id  string
+	alg string // optional, restricts the key to one algorithm
+	key crypto.PublicKey
+}
+
+type jwtAuthenticator struct {
+	keys []publicKey
+	cfg  JWTConfig
+	now  func() time.Time
+}
+
+// NewJWT returns an Authenticator for JWTs signed (RS256, RS384, RS512, ES256, ES384 or ES512)
+// by a key of the JWKS document jwks.
+func NewJWT(jwks []byte, cfg JWTConfig) (Authenticator, error) {
+	var doc struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(jwks, &doc); err != nil {
+		return nil, fmt.Errorf("problem reading JWKS: %v", err)
+	}
+	a := &jwtAuthenticator{cfg: cfg, now: time.Now}
+	if a.cfg.TenantClaim == "" {
+		a.cfg.TenantClaim = "tenant"
+	}
+	for i := range doc.Keys {
+		if doc.Keys[i].Use != "" && doc.Keys[i].Use != "sig" {
+			continue
+		}
+		key, err := doc.Keys[i].publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %d (kid=%q): %v", i, doc.Keys[i].KeyID, err)
+		}
+		a.keys = append(a.keys, publicKey{id: doc.Keys[i].KeyID, alg: doc.Keys[i].Alg, key: key})
+	}
+	if len(a.keys) == 0 {
+		return nil, errors.New("no signing keys found in JWKS")
+	}
+	return a, nil
+}
+
+// ReadJWKSFile returns an Authenticator for JWTs signed by a key of the JWKS file at path.
+func ReadJWKSFile(path string, cfg JWTConfig) (Authenticator, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewJWT(bs, cfg)
+}
+
+func decodeSegment(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	bs, err := decodeSegment(s)
+	if err != nil || len(bs) == 0 {
+		return nil, fmt.Errorf("invalid integer %q", s)
+	}
+	return new(big.Int).SetBytes(bs), nil
+}
+
+func (k jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if n.BitLen() < 2048 {
+			return nil, fmt.Errorf("RSA key of %d bits is too small", n.BitLen())
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Curve)
+		}
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("EC point is not on the curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.KeyType)
+}
+
+func unauthenticated(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrUnauthenticated, fmt.Sprintf(format, args...))
+}
+
+// Authenticate verifies the signature and claims of token.
+func (a *jwtAuthenticator) Authenticate(token string) (*Principal, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrUnauthenticated // not a JWT, maybe an API key
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	bs, err := decodeSegment(parts[0])
+	if err != nil || json.Unmarshal(bs, &header) != nil {
+		return nil, unauthenticated("malformed JWT header")
+	}
+	sig, err := decodeSegment(parts[2])
+	if err != nil {
+		return nil, unauthenticated("malformed JWT signature")
+	}
+	if err := a.verify(header.Alg, header.Kid, []byte(parts[0]+"."+parts[1]), sig); err != nil {
+		return nil, err
+	}
+
+	bs, err = decodeSegment(parts[1])
+	if err != nil {
+		return nil, unauthenticated("malformed JWT claims")
+	}
+	return a.principal(bs)
+}
+
+func (a *jwtAuthenticator) verify(alg, kid string, signed, sig []byte) error {
+	var hash crypto.Hash
+	switch alg {
+	case "RS256", "ES256":
+		hash = crypto.SHA256
+	case "RS384", "ES384":
+		hash = crypto.SHA384
+	case "RS512", "ES512":
+		hash = crypto.SHA512
+	default:
+		return unauthenticated("unsupported JWT algorithm %q", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+
+	for _, k := range a.keys {
+		if (kid != "" && k.id != kid) || (k.alg != "" && k.alg != alg) {
+			continue
+		}
+		switch key := k.key.(type) {
+		case *rsa.PublicKey:
+			if strings.HasPrefix(alg, "RS") && rsa.VerifyPKCS1v15(key, hash, digest, sig) == nil {
+				return nil
+			}
+		case *ecdsa.PublicKey:
+			if strings.HasPrefix(alg, "ES") && verifyECDSA(key, alg, digest, sig) {
+				return nil
+			}
+		}
+	}
+	return unauthenticated("invalid JWT signature")
+}
+
+// verifyECDSA checks sig, the concatenated R and S values of RFC 7518 section 3.4
+func verifyECDSA(key *ecdsa.PublicKey, alg string, digest, sig []byte) bool {
+	expected := map[string]string{"ES256": "P-256", "ES384": "P-384", "ES512": "P-521"}
+	if key.Curve.Params().Name != expected[alg] {
+		return false
+	}
+	size := (key.Curve.Params().BitSize + 7) / 8
+	if len(sig) != 2*size {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:size])
+	s := new(big.Int).SetBytes(sig[size:])
+	return ecdsa.Verify(key, digest, r, s)
+}
+
+func (a *jwtAuthenticator) principal(bs []byte) (*Principal, error) {
+	var claims map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.UseNumber()
+	if err := dec.Decode(&claims); err != nil {
+		return nil, unauthenticated("malformed JWT claims")
+	}
+
+	now := a.now()
+	exp, ok := numericClaim(claims, "exp")
+	if !ok {
+		return nil, unauthenticated("JWT has no expiration")
+	}
+	if now.After(exp.Add(a.cfg.Leeway)) {
+		return nil, unauthenticated("JWT expired")
+	}
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Add(a.cfg.Leeway).Before(nbf) {
+		return nil, unauthenticated("JWT not valid yet")
+	}
+	if a.cfg.Issuer != "" && claims["iss"] != a.cfg.Issuer {
+		return nil, unauthenticated("unexpected JWT issuer")
+	}
+	if a.cfg.Audience != "" && !hasAudience(claims["aud"], a.cfg.Audience) {
+		return nil, unauthenticated("unexpected JWT audience")
+	}
+
+	p := &Principal{}
+	p.Subject, _ = claims["sub"].(string)
+	p.Tenant, _ = claims[a.cfg.TenantClaim].(string)
+	var scopes []string
+	switch v := claims["scope"].(type) {
+	case string:
+		scopes = strings.Fields(v)
+	case []interface{}:
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	for _, s := range scopes {
+		switch s {
+		case ScopeRead, ScopeWrite, ScopeDelete, ScopeAdmin:
+			p.Scopes = append(p.Scopes, s) // other scopes are meant for other services
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, unauthenticated("%v", err)
+	}
+	return p, nil
+}
+
+func numericClaim(claims map[string]interface{}, name string) (time.Time, bool) {
+	n, ok := claims[name].(json.Number)
+	if !ok {
+		return time.Time{}, false
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(f), 0), true
+}
+
+func hasAudience(aud interface{}, expected string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == expected
+	case []interface{}:
+		for _, a := range aud {
+			if a == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
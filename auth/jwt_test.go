@@ -0,0 +1,219 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+)
+
+type testKeys struct {
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKeys{rsa: rsaKey, ec: ecKey}
+}
+
+func b64(bs []byte) string {
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func (k testKeys) jwks(t *testing.T) []byte {
+	t.Helper()
+
+	bs, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA",
+				"kid": "rsa",
+				"use": "sig",
+				"n":   b64(k.rsa.N.Bytes()),
+				"e":   b64(big.NewInt(int64(k.rsa.E)).Bytes()),
+			},
+			{
+				"kty": "EC",
+				"kid": "ec",
+				"crv": "P-256",
+				"x":   b64(k.ec.X.Bytes()),
+				"y":   b64(k.ec.Y.Bytes()),
+			},
+			{
+				"kty": "RSA",
+				"kid": "encryption",
+				"use": "enc",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
+
+func (k testKeys) sign(t *testing.T, alg, kid string, claims map[string]interface{}) string {
+	t.Helper()
+
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	body, _ := json.Marshal(claims)
+	signed := b64(header) + "." + b64(body)
+
+	digest := crypto.SHA256.New()
+	digest.Write([]byte(signed))
+
+	var sig []byte
+	switch alg {
+	case "RS256":
+		s, err := rsa.SignPKCS1v15(rand.Reader, k.rsa, crypto.SHA256, digest.Sum(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig = s
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, k.ec, digest.Sum(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig = make([]byte, 64)
+		rb, sb := r.Bytes(), s.Bytes()
+		copy(sig[32-len(rb):32], rb)
+		copy(sig[64-len(sb):], sb)
+	}
+	return signed + "." + b64(sig)
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":    "user",
+		"tenant": "acme",
+		"scope":  "openid read write",
+		"iss":    "https://issuer.example.com",
+		"aud":    []string{"ach"},
+		"exp":    time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestJWT(t *testing.T) {
+	keys := newTestKeys(t)
+	a, err := NewJWT(keys.jwks(t), JWTConfig{Issuer: "https://issuer.example.com", Audience: "ach"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alg := range []string{"RS256", "ES256"} {
+		kid := "rsa"
+		if alg == "ES256" {
+			kid = "ec"
+		}
+		p, err := a.Authenticate(keys.sign(t, alg, kid, validClaims()))
+		if err != nil {
+			t.Fatalf("%s: %v", alg, err)
+		}
+		if p.Subject != "user" || p.Tenant != "acme" || len(p.Scopes) != 2 || !p.Allowed(ScopeWrite) {
+			t.Errorf("%s: unexpected principal: %#v", alg, p)
+		}
+
+		// without a kid every key is tried
+		if _, err := a.Authenticate(keys.sign(t, alg, "", validClaims())); err != nil {
+			t.Errorf("%s: %v", alg, err)
+		}
+	}
+}
+
+func TestJWT__invalid(t *testing.T) {
+	keys := newTestKeys(t)
+	a, err := NewJWT(keys.jwks(t), JWTConfig{Issuer: "https://issuer.example.com", Audience: "ach", Leeway: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims := func(name string, value interface{}) map[string]interface{} {
+		c := validClaims()
+		if value == nil {
+			delete(c, name)
+		} else {
+			c[name] = value
+		}
+		return c
+	}
+	cases := map[string]string{
+		"not a jwt":     "api-key",
+		"wrong kid":     keys.sign(t, "RS256", "ec", validClaims()),
+		"unknown kid":   keys.sign(t, "RS256", "other", validClaims()),
+		"no expiration": keys.sign(t, "RS256", "rsa", claims("exp", nil)),
+		"expired":       keys.sign(t, "RS256", "rsa", claims("exp", time.Now().Add(-2*time.Minute).Unix())),
+		"not before":    keys.sign(t, "RS256", "rsa", claims("nbf", time.Now().Add(time.Hour).Unix())),
+		"issuer":        keys.sign(t, "RS256", "rsa", claims("iss", "https://other.example.com")),
+		"audience":      keys.sign(t, "RS256", "rsa", claims("aud", "other")),
+		"tenant":        keys.sign(t, "RS256", "rsa", claims("tenant", "a/b")),
+		"no tenant":     keys.sign(t, "RS256", "rsa", claims("tenant", nil)),
+		"alg none":      b64([]byte(`{"alg":"none"}`)) + "." + b64([]byte(`{"tenant":"acme"}`)) + ".",
+	}
+	other := newTestKeys(t)
+	cases["other key"] = other.sign(t, "RS256", "rsa", validClaims())
+
+	tampered := keys.sign(t, "ES256", "ec", validClaims())
+	cases["tampered"] = tampered[:len(tampered)-4] + "AAAA"
+
+	for name, token := range cases {
+		if p, err := a.Authenticate(token); !errors.Is(err, ErrUnauthenticated) {
+			t.Errorf("%s: principal=%#v error=%v", name, p, err)
+		}
+	}
+
+	// expired, but within the leeway
+	if _, err := a.Authenticate(keys.sign(t, "RS256", "rsa", claims("exp", time.Now().Add(-30*time.Second).Unix()))); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewJWT__invalid(t *testing.T) {
+	for name, jwks := range map[string]string{
+		"json":       `keys`,
+		"empty":      `{"keys": []}`,
+		"small rsa":  `{"keys": [{"kty": "RSA", "n": "AQAB", "e": "AQAB"}]}`,
+		"curve":      `{"keys": [{"kty": "EC", "crv": "P-224", "x": "AQAB", "y": "AQAB"}]}`,
+		"off curve":  `{"keys": [{"kty": "EC", "crv": "P-256", "x": "AQAB", "y": "AQAB"}]}`,
+		"key type":   `{"keys": [{"kty": "oct", "k": "c2VjcmV0"}]}`,
+		"encryption": `{"keys": [{"kty": "RSA", "use": "enc"}]}`,
+	} {
+		if _, err := NewJWT([]byte(jwks), JWTConfig{}); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -30,11 +30,11 @@ import (
 type Batch struct {
 	// id is a client defined string used as a reference to this record. accessed via ID/SetID
 	id         string
-	Header     *BatchHeader      `json:"batchHeader,omitempty"`
-	Entries    []*EntryDetail    `json:"entryDetails,omitempty"`
-	Control    *BatchControl     `json:"batchControl,omitempty"`
-	ADVEntries []*ADVEntryDetail `json:"advEntryDetails,omitempty"`
-	ADVControl *ADVBatchControl  `json:"advBatchControl,omitempty"`
+	Header     *BatchHeader      `json:"batchHeader,omitempty" xml:"batchHeader,omitempty"`
+	Entries    []*EntryDetail    `json:"entryDetails,omitempty" xml:"entryDetail,omitempty"`
+	Control    *BatchControl     `json:"batchControl,omitempty" xml:"batchControl,omitempty"`
+	ADVEntries []*ADVEntryDetail `json:"advEntryDetails,omitempty" xml:"advEntryDetail,omitempty"`
+	ADVControl *ADVBatchControl  `json:"advBatchControl,omitempty" xml:"advBatchControl,omitempty"`
 
 	// offset holds the information to build an EntryDetail record which
 	// balances the batch by debiting or crediting the sum of amounts in the batch.
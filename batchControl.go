@@ -28,7 +28,7 @@ import (
 // entries contained in the preceding batch
 type BatchControl struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block.
 	recordType string
 	// ServiceClassCode ACH Mixed Debits and Credits '200'
@@ -36,21 +36,21 @@ type BatchControl struct {
 	// ACH Debits Only '225'
 	// Constants: MixedCreditsAnDebits (220), CReditsOnly 9220), DebitsOnly (225)
 	// Same as 'ServiceClassCode' in BatchHeaderRecord
-	ServiceClassCode int `json:"serviceClassCode"`
+	ServiceClassCode int `json:"serviceClassCode" xml:"serviceClassCode"`
 	// EntryAddendaCount is a tally of each Entry Detail Record and each Addenda
 	// Record processed, within either the batch or file as appropriate.
-	EntryAddendaCount int `json:"entryAddendaCount"`
+	EntryAddendaCount int `json:"entryAddendaCount" xml:"entryAddendaCount"`
 	// validate the Receiving DFI Identification in each Entry Detail Record is hashed
 	// to provide a check against inadvertent alteration of data contents due
 	// to hardware failure or program error
 	//
 	// In this context the Entry Hash is the sum of the corresponding fields in the
 	// Entry Detail Records on the file.
-	EntryHash int `json:"entryHash"`
+	EntryHash int `json:"entryHash" xml:"entryHash"`
 	// TotalDebitEntryDollarAmount Contains accumulated Entry debit totals within the batch.
-	TotalDebitEntryDollarAmount int `json:"totalDebit"`
+	TotalDebitEntryDollarAmount int `json:"totalDebit" xml:"totalDebit"`
 	// TotalCreditEntryDollarAmount Contains accumulated Entry credit totals within the batch.
-	TotalCreditEntryDollarAmount int `json:"totalCredit"`
+	TotalCreditEntryDollarAmount int `json:"totalCredit" xml:"totalCredit"`
 	// CompanyIdentification is an alphanumeric code used to identify an Originator
 	// The Company Identification Field must be included on all
 	// prenotification records and on each entry initiated pursuant to such
@@ -62,23 +62,23 @@ type BatchControl struct {
 	// IRS Employer Identification Number (EIN) "1"
 	// Data Universal Numbering Systems (DUNS) "3"
 	// User Assigned Number "9"
-	CompanyIdentification string `json:"companyIdentification"`
+	CompanyIdentification string `json:"companyIdentification" xml:"companyIdentification"`
 	// MessageAuthenticationCode the MAC is an eight character code derived from a special key used in
 	// conjunction with the DES algorithm. The purpose of the MAC is to
 	// validate the authenticity of ACH entries. The DES algorithm and key
 	// message standards must be in accordance with standards adopted by the
 	// American National Standards Institute. The remaining eleven characters
 	// of this field are blank.
-	MessageAuthenticationCode string `json:"messageAuthentication,omitempty"`
+	MessageAuthenticationCode string `json:"messageAuthentication,omitempty" xml:"messageAuthentication,omitempty"`
 	// Reserved for the future - Blank, 6 characters long
 	reserved string
 	// ODFIIdentification the routing number is used to identify the DFI originating entries within a given branch.
-	ODFIIdentification string `json:"ODFIIdentification"`
+	ODFIIdentification string `json:"ODFIIdentification" xml:"ODFIIdentification"`
 	// BatchNumber this number is assigned in ascending sequence to each batch by the ODFI
 	// or its Sending Point in a given file of entries. Since the batch number
 	// in the Batch Header Record and the Batch Control Record is the same,
 	// the ascending sequence number should be assigned by batch and not by record.
-	BatchNumber int `json:"batchNumber"`
+	BatchNumber int `json:"batchNumber" xml:"batchNumber"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to golang Converters
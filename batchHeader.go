@@ -34,28 +34,28 @@ import (
 // field is not entered as it is determined by the ACH operator
 type BatchHeader struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. 5
 	recordType string
 
 	// ServiceClassCode ACH Mixed Debits and Credits '200'
 	// ACH Credits Only '220'
 	// ACH Debits Only '225'
-	ServiceClassCode int `json:"serviceClassCode"`
+	ServiceClassCode int `json:"serviceClassCode" xml:"serviceClassCode"`
 
 	// CompanyName the company originating the entries in the batch
-	CompanyName string `json:"companyName"`
+	CompanyName string `json:"companyName" xml:"companyName"`
 
 	// CompanyDiscretionaryData allows Originators and/or ODFIs to include codes (one or more),
 	// of significance only to them, to enable specialized handling of all
 	// subsequent entries in that batch. There will be no standardized
 	// interpretation for the value of the field. This field must be returned
 	// intact on any return entry.
-	CompanyDiscretionaryData string `json:"companyDiscretionaryData,omitempty"`
+	CompanyDiscretionaryData string `json:"companyDiscretionaryData,omitempty" xml:"companyDiscretionaryData,omitempty"`
 
 	// CompanyIdentification The 9 digit FEIN number (proceeded by a predetermined
 	// alpha or numeric character) of the entity in the company name field
-	CompanyIdentification string `json:"companyIdentification"`
+	CompanyIdentification string `json:"companyIdentification" xml:"companyIdentification"`
 
 	// StandardEntryClassCode
 	// Identifies the payment type (product) found within an ACH batch-using a 3-character code.
@@ -64,7 +64,7 @@ type BatchHeader struct {
 	// Determines addenda records (required or optional PLUS one or up to 9,999 records).
 	// Determines rules to follow (return time frames).
 	// Some SEC codes require specific data in predetermined fields within the ACH record
-	StandardEntryClassCode string `json:"standardEntryClassCode,omitempty"`
+	StandardEntryClassCode string `json:"standardEntryClassCode,omitempty" xml:"standardEntryClassCode,omitempty"`
 
 	// CompanyEntryDescription A description of the entries contained in the batch
 	//
@@ -81,7 +81,7 @@ type BatchHeader struct {
 	//
 	// This field must contain the word "NONSETTLED" (left justified) when the
 	// batch contains entries which could not settle.
-	CompanyEntryDescription string `json:"companyEntryDescription,omitempty"`
+	CompanyEntryDescription string `json:"companyEntryDescription,omitempty" xml:"companyEntryDescription,omitempty"`
 	// CompanyDescriptiveDate currently, the Rules provide that the “Originator establishes this field as the date it
 	// would like to see displayed to the Receiver for descriptive purposes.” NACHA recommends that, as desired,
 	// the content of this field be formatted using the convention “SDHHMM”, where the “SD” in positions 64- 65 denotes
@@ -93,10 +93,10 @@ type BatchHeader struct {
 	// same-day settlement using an optional, yet standardized, same-day indicator in the Company Descriptive Date
 	// field. The Company Descriptive Date field (5 record, field 8) is an optional field with 6 positions available
 	// (positions 64-69).
-	CompanyDescriptiveDate string `json:"companyDescriptiveDate,omitempty"`
+	CompanyDescriptiveDate string `json:"companyDescriptiveDate,omitempty" xml:"companyDescriptiveDate,omitempty"`
 
 	// EffectiveEntryDate the date on which the entries are to settle. Format: YYMMDD (Y=Year, M=Month, D=Day)
-	EffectiveEntryDate string `json:"effectiveEntryDate,omitempty"`
+	EffectiveEntryDate string `json:"effectiveEntryDate,omitempty" xml:"effectiveEntryDate,omitempty"`
 
 	// SettlementDate Leave blank, this field is inserted by the ACH operator
 	settlementDate string
@@ -105,17 +105,17 @@ type BatchHeader struct {
 	// 0 ADV File prepared by an ACH Operator.
 	// 1 This code identifies the Originator as a depository financial institution.
 	// 2 This code identifies the Originator as a Federal Government entity or agency.
-	OriginatorStatusCode int `json:"originatorStatusCode,omitempty"`
+	OriginatorStatusCode int `json:"originatorStatusCode" xml:"originatorStatusCode"`
 
 	//ODFIIdentification First 8 digits of the originating DFI transit routing number
-	ODFIIdentification string `json:"ODFIIdentification"`
+	ODFIIdentification string `json:"ODFIIdentification" xml:"ODFIIdentification"`
 
 	// BatchNumber is assigned in ascending sequence to each batch by the ODFI
 	// or its Sending Point in a given file of entries. Since the batch number
 	// in the Batch Header Record and the Batch Control Record is the same,
 	// the ascending sequence number should be assigned by batch and not by
 	// record.
-	BatchNumber int `json:"batchNumber,omitempty"`
+	BatchNumber int `json:"batchNumber,omitempty" xml:"batchNumber"`
 
 	// validator is composed for data validation
 	validator
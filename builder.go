@@ -0,0 +1,483 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FileBuilder creates valid ACH files with sensible defaults.
+//
+// A builder fills in the FileCreationDate and FileCreationTime, batch numbers, ServiceClassCode,
+// sequential TraceNumbers from the ODFI and AddendaRecordIndicator values. Each method returns
+// the builder so calls can be chained:
+//
+//	file, err := ach.NewFileBuilder("121042882", "231380104").
+//	    AddPPDBatch(ach.Company{Name: "Your Company", Identification: "121042882", EntryDescription: "PAYROLL"}).
+//	    Credit(ach.Account{RoutingNumber: "231380104", Number: "81967038518"}, 100000, "Jane Doe").
+//	    Build()
+//
+// Build returns the first error encountered while adding batches or entries.
+type FileBuilder struct {
+	header  FileHeader
+	odfi    string
+	now     func() time.Time
+	batches []*BatchBuilder
+	opts    *ValidateOpts
+	err     error
+}
+
+// Company describes the originator of a batch.
+type Company struct {
+	Name              string
+	Identification    string
+	EntryDescription  string
+	DiscretionaryData string
+	DescriptiveDate   string
+
+	// EffectiveEntryDate is when entries in the batch settle. Defaults to the next day.
+	EffectiveEntryDate time.Time
+}
+
+// Account identifies a receiver's account at their RDFI.
+type Account struct {
+	RoutingNumber string
+	Number        string
+
+	// Savings uses savings account TransactionCodes rather than checking
+	Savings bool
+}
+
+// NewFileBuilder returns a FileBuilder for a file sent from origin to destination. The ODFI
+// used in BatchHeaders and TraceNumbers defaults to the first eight digits of origin.
+func NewFileBuilder(origin, destination string) *FileBuilder {
+	fh := NewFileHeader()
+	fh.ImmediateOrigin = origin
+	fh.ImmediateDestination = destination
+	return &FileBuilder{
+		header: fh,
+		odfi:   origin,
+		now:    time.Now,
+	}
+}
+
+// OriginName sets the ImmediateOriginName of the FileHeader.
+func (fb *FileBuilder) OriginName(name string) *FileBuilder {
+	fb.header.ImmediateOriginName = name
+	return fb
+}
+
+// DestinationName sets the ImmediateDestinationName of the FileHeader.
+func (fb *FileBuilder) DestinationName(name string) *FileBuilder {
+	fb.header.ImmediateDestinationName = name
+	return fb
+}
+
+// FileIDModifier sets the FileIDModifier of the FileHeader, which defaults to "A".
+func (fb *FileBuilder) FileIDModifier(modifier string) *FileBuilder {
+	fb.header.FileIDModifier = modifier
+	return fb
+}
+
+// ODFI sets the routing number of the originating financial institution used in
+// each BatchHeader and for TraceNumbers.
+func (fb *FileBuilder) ODFI(routingNumber string) *FileBuilder {
+	fb.odfi = routingNumber
+	return fb
+}
+
+// CreatedAt sets the FileCreationDate and FileCreationTime, which default to the time Build is called.
+func (fb *FileBuilder) CreatedAt(t time.Time) *FileBuilder {
+	fb.now = func() time.Time { return t }
+	return fb
+}
+
+// ValidateWith overrides NACHA validation rules when building the File.
+func (fb *FileBuilder) ValidateWith(opts *ValidateOpts) *FileBuilder {
+	fb.opts = opts
+	return fb
+}
+
+// AddBatch starts a new batch of the given StandardEntryClassCode (e.g. PPD) for company.
+// Any SEC code supported by NewBatch can be used.
+func (fb *FileBuilder) AddBatch(sec string, company Company) *BatchBuilder {
+	bb := &BatchBuilder{
+		file:    fb,
+		sec:     strings.ToUpper(sec),
+		company: company,
+	}
+	fb.batches = append(fb.batches, bb)
+	return bb
+}
+
+// AddPPDBatch starts a new batch of Prearranged Payment and Deposit (PPD) entries.
+func (fb *FileBuilder) AddPPDBatch(company Company) *BatchBuilder {
+	return fb.AddBatch(PPD, company)
+}
+
+// AddCCDBatch starts a new batch of Corporate Credit or Debit (CCD) entries.
+func (fb *FileBuilder) AddCCDBatch(company Company) *BatchBuilder {
+	return fb.AddBatch(CCD, company)
+}
+
+// AddCTXBatch starts a new batch of Corporate Trade Exchange (CTX) entries.
+func (fb *FileBuilder) AddCTXBatch(company Company) *BatchBuilder {
+	return fb.AddBatch(CTX, company)
+}
+
+// AddWEBBatch starts a new batch of Internet-Initiated (WEB) entries.
+func (fb *FileBuilder) AddWEBBatch(company Company) *BatchBuilder {
+	return fb.AddBatch(WEB, company)
+}
+
+// AddTELBatch starts a new batch of Telephone-Initiated (TEL) entries.
+func (fb *FileBuilder) AddTELBatch(company Company) *BatchBuilder {
+	return fb.AddBatch(TEL, company)
+}
+
+func (fb *FileBuilder) setError(err error) {
+	if fb.err == nil {
+		fb.err = err
+	}
+}
+
+// Build assembles, tabulates and validates the File.
+func (fb *FileBuilder) Build() (*File, error) {
+	if fb.err != nil {
+		return nil, fb.err
+	}
+	if len(fb.batches) == 0 {
+		return nil, errors.New("builder: no batches added")
+	}
+
+	now := fb.now()
+	odfi := strings.TrimSpace(fb.odfi)
+	if len(odfi) > 8 {
+		odfi = odfi[:8]
+	}
+
+	file := NewFile()
+	file.Header = fb.header
+	file.Header.FileCreationDate = now.Format("060102")
+	file.Header.FileCreationTime = now.Format("1504")
+	if fb.opts != nil {
+		file.SetValidation(fb.opts)
+	}
+
+	sequence := 0
+	for i, bb := range fb.batches {
+		batch, err := bb.build(i+1, odfi, now, &sequence)
+		if err != nil {
+			return nil, fmt.Errorf("builder: batch %d (%s): %v", i+1, bb.sec, err)
+		}
+		file.AddBatch(batch)
+	}
+	if err := file.Create(); err != nil {
+		return nil, err
+	}
+	if err := file.Validate(); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// BatchBuilder adds entries to a batch within a FileBuilder.
+type BatchBuilder struct {
+	file    *FileBuilder
+	sec     string
+	company Company
+	header  func(*BatchHeader)
+
+	entries    []*EntryDetail
+	advEntries []*ADVEntryDetail
+
+	// receivingCompanies holds names for CTX, ATX and TRX entries, which are written
+	// after the count of addenda records in IndividualName.
+	receivingCompanies map[*EntryDetail]string
+}
+
+// Header allows modifying the BatchHeader after defaults are set, for SEC codes which
+// require additional fields.
+func (bb *BatchBuilder) Header(fn func(*BatchHeader)) *BatchBuilder {
+	bb.header = fn
+	return bb
+}
+
+// Credit adds an entry crediting account for amount (in cents).
+func (bb *BatchBuilder) Credit(account Account, amount int, name string) *BatchBuilder {
+	code := CheckingCredit
+	if account.Savings {
+		code = SavingsCredit
+	}
+	return bb.addEntry(code, account, amount, name)
+}
+
+// Debit adds an entry debiting account for amount (in cents).
+func (bb *BatchBuilder) Debit(account Account, amount int, name string) *BatchBuilder {
+	code := CheckingDebit
+	if account.Savings {
+		code = SavingsDebit
+	}
+	return bb.addEntry(code, account, amount, name)
+}
+
+// Prenote adds a zero dollar prenotification entry for account. Prenotes are credits
+// unless debit is true.
+func (bb *BatchBuilder) Prenote(account Account, name string, debit bool) *BatchBuilder {
+	code := CheckingPrenoteCredit
+	switch {
+	case account.Savings && debit:
+		code = SavingsPrenoteDebit
+	case account.Savings:
+		code = SavingsPrenoteCredit
+	case debit:
+		code = CheckingPrenoteDebit
+	}
+	return bb.addEntry(code, account, 0, name)
+}
+
+func (bb *BatchBuilder) addEntry(code int, account Account, amount int, name string) *BatchBuilder {
+	if amount < 0 {
+		bb.file.setError(fmt.Errorf("builder: negative amount %d for %s", amount, name))
+		return bb
+	}
+	ed := NewEntryDetail()
+	ed.TransactionCode = code
+	ed.SetRDFI(account.RoutingNumber)
+	ed.DFIAccountNumber = account.Number
+	ed.Amount = amount
+	ed.IndividualName = name
+	ed.Category = CategoryForward
+	switch bb.sec {
+	case WEB, TEL:
+		ed.SetPaymentType("S") // single entry
+	case CTX, ATX, TRX:
+		if bb.receivingCompanies == nil {
+			bb.receivingCompanies = make(map[*EntryDetail]string)
+		}
+		bb.receivingCompanies[ed] = name
+	}
+	bb.entries = append(bb.entries, ed)
+	return bb
+}
+
+// AddEntry adds a copy of a fully formed EntryDetail to the batch, so Entry and Build never
+// modify ed. The built File has its AddendaRecordIndicator, and a TraceNumber when one isn't
+// set, filled in.
+func (bb *BatchBuilder) AddEntry(ed *EntryDetail) *BatchBuilder {
+	if ed == nil {
+		bb.file.setError(errors.New("builder: nil EntryDetail"))
+		return bb
+	}
+	bb.entries = append(bb.entries, copyEntryDetail(ed))
+	return bb
+}
+
+// AddADVEntry adds an ADVEntryDetail to an ADV batch. The built File holds a copy of ed with its SequenceNumber set.
+func (bb *BatchBuilder) AddADVEntry(ed *ADVEntryDetail) *BatchBuilder {
+	if ed == nil {
+		bb.file.setError(errors.New("builder: nil ADVEntryDetail"))
+		return bb
+	}
+	bb.advEntries = append(bb.advEntries, ed)
+	return bb
+}
+
+// Entry allows modifying the most recently added entry, for SEC codes which
+// require additional fields (e.g. check serial numbers).
+func (bb *BatchBuilder) Entry(fn func(*EntryDetail)) *BatchBuilder {
+	if len(bb.entries) == 0 {
+		bb.file.setError(errors.New("builder: no entry to modify"))
+		return bb
+	}
+	fn(bb.entries[len(bb.entries)-1])
+	return bb
+}
+
+// IdentificationNumber sets the IdentificationNumber of the most recently added entry.
+func (bb *BatchBuilder) IdentificationNumber(id string) *BatchBuilder {
+	return bb.Entry(func(ed *EntryDetail) {
+		ed.IdentificationNumber = id
+	})
+}
+
+// Addenda adds an Addenda05 with paymentInfo to the most recently added entry.
+func (bb *BatchBuilder) Addenda(paymentInfo string) *BatchBuilder {
+	return bb.Entry(func(ed *EntryDetail) {
+		addenda := NewAddenda05()
+		addenda.PaymentRelatedInformation = paymentInfo
+		ed.AddAddenda05(addenda)
+	})
+}
+
+// AddPPDBatch finishes this batch and starts a new PPD batch. See FileBuilder.AddPPDBatch
+func (bb *BatchBuilder) AddPPDBatch(company Company) *BatchBuilder {
+	return bb.file.AddPPDBatch(company)
+}
+
+// AddCCDBatch finishes this batch and starts a new CCD batch. See FileBuilder.AddCCDBatch
+func (bb *BatchBuilder) AddCCDBatch(company Company) *BatchBuilder {
+	return bb.file.AddCCDBatch(company)
+}
+
+// AddCTXBatch finishes this batch and starts a new CTX batch. See FileBuilder.AddCTXBatch
+func (bb *BatchBuilder) AddCTXBatch(company Company) *BatchBuilder {
+	return bb.file.AddCTXBatch(company)
+}
+
+// AddWEBBatch finishes this batch and starts a new WEB batch. See FileBuilder.AddWEBBatch
+func (bb *BatchBuilder) AddWEBBatch(company Company) *BatchBuilder {
+	return bb.file.AddWEBBatch(company)
+}
+
+// AddTELBatch finishes this batch and starts a new TEL batch. See FileBuilder.AddTELBatch
+func (bb *BatchBuilder) AddTELBatch(company Company) *BatchBuilder {
+	return bb.file.AddTELBatch(company)
+}
+
+// AddBatch finishes this batch and starts a new one. See FileBuilder.AddBatch
+func (bb *BatchBuilder) AddBatch(sec string, company Company) *BatchBuilder {
+	return bb.file.AddBatch(sec, company)
+}
+
+// Build finishes this batch and builds the File. See FileBuilder.Build
+func (bb *BatchBuilder) Build() (*File, error) {
+	return bb.file.Build()
+}
+
+func (bb *BatchBuilder) build(batchNumber int, odfi string, now time.Time, sequence *int) (Batcher, error) {
+	if len(bb.entries) == 0 && len(bb.advEntries) == 0 {
+		return nil, errors.New("no entries")
+	}
+
+	bh := NewBatchHeader()
+	bh.StandardEntryClassCode = bb.sec
+	bh.ServiceClassCode = bb.serviceClassCode()
+	bh.CompanyName = bb.company.Name
+	bh.CompanyIdentification = bb.company.Identification
+	bh.CompanyEntryDescription = bb.company.EntryDescription
+	bh.CompanyDiscretionaryData = bb.company.DiscretionaryData
+	bh.CompanyDescriptiveDate = bb.company.DescriptiveDate
+	if bb.company.EffectiveEntryDate.IsZero() {
+		bh.EffectiveEntryDate = now.AddDate(0, 0, 1).Format("060102")
+	} else {
+		bh.EffectiveEntryDate = bb.company.EffectiveEntryDate.Format("060102")
+	}
+	bh.ODFIIdentification = odfi
+	bh.BatchNumber = batchNumber
+	if bb.sec == ADV {
+		bh.OriginatorStatusCode = 0 // ADV files are created by an ACH Operator
+	}
+	if bb.header != nil {
+		bb.header(bh)
+	}
+
+	batch, err := NewBatch(bh)
+	if err != nil {
+		return nil, err
+	}
+	if bb.file.opts != nil {
+		batch.SetValidation(bb.file.opts)
+	}
+	// Entries are copied again so building never modifies the builder's records
+	for _, original := range bb.entries {
+		ed := copyEntryDetail(original)
+		if ed.TraceNumber == "" {
+			*sequence++
+			ed.SetTraceNumber(odfi, *sequence)
+		}
+		if ed.Addenda02 != nil || len(ed.Addenda05) > 0 || ed.Addenda98 != nil || ed.Addenda99 != nil {
+			ed.AddendaRecordIndicator = 1
+		}
+		if name, ok := bb.receivingCompanies[original]; ok {
+			ed.SetCATXAddendaRecords(len(ed.Addenda05))
+			ed.SetCATXReceivingCompany(name)
+		}
+		if ed.Category == "" {
+			ed.Category = CategoryForward
+		}
+		batch.AddEntry(ed)
+	}
+	for _, original := range bb.advEntries {
+		ed := *original
+		if ed.Addenda99 != nil {
+			addenda := *ed.Addenda99
+			ed.Addenda99 = &addenda
+		}
+		if ed.Category == "" {
+			ed.Category = CategoryForward
+		}
+		batch.AddADVEntry(&ed)
+	}
+	if err := batch.Create(); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
+// copyEntryDetail returns a copy of ed and its addenda records.
+func copyEntryDetail(ed *EntryDetail) *EntryDetail {
+	out := *ed
+	if ed.Addenda02 != nil {
+		addenda := *ed.Addenda02
+		out.Addenda02 = &addenda
+	}
+	if ed.Addenda05 != nil {
+		out.Addenda05 = make([]*Addenda05, len(ed.Addenda05))
+		for i := range ed.Addenda05 {
+			addenda := *ed.Addenda05[i]
+			out.Addenda05[i] = &addenda
+		}
+	}
+	if ed.Addenda98 != nil {
+		addenda := *ed.Addenda98
+		out.Addenda98 = &addenda
+	}
+	if ed.Addenda99 != nil {
+		addenda := *ed.Addenda99
+		out.Addenda99 = &addenda
+	}
+	return &out
+}
+
+// serviceClassCode returns CreditsOnly, DebitsOnly or MixedDebitsAndCredits based on the batch's entries.
+func (bb *BatchBuilder) serviceClassCode() int {
+	if bb.sec == ADV {
+		return AutomatedAccountingAdvices
+	}
+	credits, debits := false, false
+	for _, ed := range bb.entries {
+		switch ed.CreditOrDebit() {
+		case "C":
+			credits = true
+		case "D":
+			debits = true
+		}
+	}
+	switch {
+	case credits && !debits:
+		return CreditsOnly
+	case debits && !credits:
+		return DebitsOnly
+	default:
+		return MixedDebitsAndCredits
+	}
+}
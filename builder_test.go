@@ -0,0 +1,468 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	builderCompany = Company{
+		Name:             "My Company",
+		Identification:   "121042882",
+		EntryDescription: "PAYROLL",
+	}
+	builderAccount = Account{RoutingNumber: "231380104", Number: "81967038518"}
+)
+
+func TestBuilder__PPD(t *testing.T) {
+	created := time.Date(2019, time.August, 15, 10, 30, 0, 0, time.UTC)
+	file, err := NewFileBuilder("121042882", "231380104").
+		OriginName("My Bank Name").
+		DestinationName("Federal Reserve Bank").
+		CreatedAt(created).
+		AddPPDBatch(builderCompany).
+		Credit(builderAccount, 100000, "Jane Doe").
+		Credit(Account{RoutingNumber: "231380104", Number: "12345678", Savings: true}, 2500, "John Doe").
+		IdentificationNumber("#83738AB#").
+		AddPPDBatch(builderCompany).
+		Debit(builderAccount, 500, "Jane Doe").
+		AddPPDBatch(builderCompany).
+		Credit(builderAccount, 100, "Jane Doe").
+		Debit(builderAccount, 100, "Jane Doe").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if file.Header.FileCreationDate != "190815" || file.Header.FileCreationTime != "1030" {
+		t.Errorf("FileCreationDate=%q FileCreationTime=%q", file.Header.FileCreationDate, file.Header.FileCreationTime)
+	}
+	if len(file.Batches) != 3 {
+		t.Fatalf("got %d batches", len(file.Batches))
+	}
+	for i, code := range []int{CreditsOnly, DebitsOnly, MixedDebitsAndCredits} {
+		bh := file.Batches[i].GetHeader()
+		if bh.ServiceClassCode != code {
+			t.Errorf("batch %d: ServiceClassCode=%d", i, bh.ServiceClassCode)
+		}
+		if bh.BatchNumber != i+1 {
+			t.Errorf("batch %d: BatchNumber=%d", i, bh.BatchNumber)
+		}
+		if bh.EffectiveEntryDate != "190816" {
+			t.Errorf("batch %d: EffectiveEntryDate=%q", i, bh.EffectiveEntryDate)
+		}
+	}
+
+	// TraceNumbers are sequential across the file
+	var traces []string
+	for _, b := range file.Batches {
+		for _, ed := range b.GetEntries() {
+			traces = append(traces, ed.TraceNumber)
+		}
+	}
+	expected := []string{"121042880000001", "121042880000002", "121042880000003", "121042880000004", "121042880000005"}
+	if strings.Join(traces, ",") != strings.Join(expected, ",") {
+		t.Errorf("unexpected TraceNumbers: %v", traces)
+	}
+	if ed := file.Batches[0].GetEntries()[1]; ed.TransactionCode != SavingsCredit || ed.IdentificationNumber != "#83738AB#" {
+		t.Errorf("unexpected entry: %#v", ed)
+	}
+	if file.Control.TotalCreditEntryDollarAmountInFile != 102600 || file.Control.TotalDebitEntryDollarAmountInFile != 600 {
+		t.Errorf("unexpected FileControl: %#v", file.Control)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewReader(&buf).Read(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBuilder__addenda(t *testing.T) {
+	file, err := NewFileBuilder("121042882", "231380104").
+		AddCCDBatch(builderCompany).
+		Debit(builderAccount, 12500, "Best Co").
+		Addenda("INVOICE 1234").
+		AddCTXBatch(builderCompany).
+		Credit(builderAccount, 100000, "Receiver Company").
+		Addenda("INVOICE 1").
+		Addenda("INVOICE 2").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ed := file.Batches[0].GetEntries()[0]
+	if ed.AddendaRecordIndicator != 1 || len(ed.Addenda05) != 1 {
+		t.Errorf("AddendaRecordIndicator=%d addenda=%d", ed.AddendaRecordIndicator, len(ed.Addenda05))
+	}
+	if ed.Addenda05[0].EntryDetailSequenceNumber != 1 {
+		t.Errorf("EntryDetailSequenceNumber=%d", ed.Addenda05[0].EntryDetailSequenceNumber)
+	}
+
+	ed = file.Batches[1].GetEntries()[0]
+	if v := ed.CATXAddendaRecordsField(); v != "0002" {
+		t.Errorf("CATXAddendaRecords=%q", v)
+	}
+	if v := ed.CATXReceivingCompanyField(); v != "Receiver Company" {
+		t.Errorf("CATXReceivingCompany=%q", v)
+	}
+}
+
+func TestBuilder__AddEntry(t *testing.T) {
+	traced := NewEntryDetail()
+	traced.TransactionCode = CheckingCredit
+	traced.SetRDFI("231380104")
+	traced.DFIAccountNumber = "81967038518"
+	traced.Amount = 100
+	traced.IndividualName = "Jane Doe"
+	traced.SetTraceNumber("12104288", 50)
+
+	untraced := *traced
+	untraced.TraceNumber = ""
+	untraced.AddAddenda05(NewAddenda05())
+	untraced.Addenda05[0].PaymentRelatedInformation = "INVOICE 1"
+
+	bb := NewFileBuilder("121042882", "231380104").
+		AddPPDBatch(builderCompany).
+		AddEntry(&untraced).
+		Credit(builderAccount, 100, "John Doe").
+		AddEntry(traced).
+		IdentificationNumber("ID 1").
+		Addenda("INVOICE 2")
+	for i := 0; i < 2; i++ {
+		file, err := bb.Build()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// only entries without a TraceNumber use the sequence
+		var traces []string
+		for _, ed := range file.Batches[0].GetEntries() {
+			traces = append(traces, ed.TraceNumber)
+		}
+		expected := []string{"121042880000001", "121042880000002", "121042880000050"}
+		if strings.Join(traces, ",") != strings.Join(expected, ",") {
+			t.Errorf("unexpected TraceNumbers: %v", traces)
+		}
+		if ed := file.Batches[0].GetEntries()[2]; ed.IdentificationNumber != "ID 1" || len(ed.Addenda05) != 1 {
+			t.Errorf("IdentificationNumber=%q addenda=%d", ed.IdentificationNumber, len(ed.Addenda05))
+		}
+	}
+
+	// the caller's entries aren't modified
+	if traced.IdentificationNumber != "" || len(traced.Addenda05) != 0 {
+		t.Errorf("entry was modified: %#v", traced)
+	}
+	if untraced.TraceNumber != "" || untraced.AddendaRecordIndicator != 0 {
+		t.Errorf("entry was modified: %#v", untraced)
+	}
+	if a := untraced.Addenda05[0]; a.SequenceNumber != 0 || a.EntryDetailSequenceNumber != 0 {
+		t.Errorf("addenda was modified: %#v", a)
+	}
+}
+
+func TestBuilder__WEB(t *testing.T) {
+	file, err := NewFileBuilder("121042882", "231380104").
+		AddWEBBatch(builderCompany).
+		Credit(builderAccount, 100, "Jane Doe").
+		AddTELBatch(builderCompany).
+		Debit(builderAccount, 100, "Jane Doe").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, b := range file.Batches {
+		if v := b.GetEntries()[0].PaymentTypeField(); v != "S" {
+			t.Errorf("%s: PaymentType=%q", b.GetHeader().StandardEntryClassCode, v)
+		}
+	}
+}
+
+func TestBuilder__Prenote(t *testing.T) {
+	savings := Account{RoutingNumber: "231380104", Number: "12345678", Savings: true}
+	file, err := NewFileBuilder("121042882", "231380104").
+		AddPPDBatch(builderCompany).
+		Prenote(builderAccount, "Jane Doe", false).
+		Prenote(builderAccount, "Jane Doe", true).
+		Prenote(savings, "Jane Doe", false).
+		Prenote(savings, "Jane Doe", true).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := file.Batches[0].GetEntries()
+	for i, code := range []int{CheckingPrenoteCredit, CheckingPrenoteDebit, SavingsPrenoteCredit, SavingsPrenoteDebit} {
+		if entries[i].TransactionCode != code || entries[i].Amount != 0 {
+			t.Errorf("entry %d: TransactionCode=%d Amount=%d", i, entries[i].TransactionCode, entries[i].Amount)
+		}
+	}
+}
+
+// TestBuilder__SEC builds a batch of every SEC code supported by NewBatch
+func TestBuilder__SEC(t *testing.T) {
+	addenda02 := func(ed *EntryDetail) {
+		ed.Addenda02 = mockAddenda02()
+	}
+
+	cases := []struct {
+		sec    string
+		header func(*BatchHeader)
+		build  func(*BatchBuilder) *BatchBuilder
+	}{
+		{
+			sec: ACK,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 0, "Best Co").Entry(func(ed *EntryDetail) {
+					ed.TransactionCode = CheckingZeroDollarRemittanceCredit
+					ed.SetOriginalTraceNumber("031300010000001")
+				})
+			},
+		},
+		{
+			sec: ADV,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.AddADVEntry(mockADVEntryDetail())
+			},
+		},
+		{
+			sec: ARC,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 250000, "ABC Company").Entry(func(ed *EntryDetail) {
+					ed.SetCheckSerialNumber("123879654")
+				})
+			},
+		},
+		{
+			sec: ATX,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 0, "Best Co").Entry(func(ed *EntryDetail) {
+					ed.TransactionCode = CheckingZeroDollarRemittanceCredit
+					ed.SetOriginalTraceNumber("031300010000001")
+				}).Addenda("Credit account 1 for service")
+			},
+		},
+		{
+			sec: BOC,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 250000, "ABC Company").Entry(func(ed *EntryDetail) {
+					ed.SetCheckSerialNumber("123879654")
+				})
+			},
+		},
+		{
+			sec: CCD,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 500000, "Best Co")
+			},
+		},
+		{
+			sec: CIE,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 100000, "Best Co").Addenda("Credit Store Account")
+			},
+		},
+		{
+			sec: COR,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 0, "Best Co").Entry(func(ed *EntryDetail) {
+					ed.TransactionCode = CheckingReturnNOCCredit
+					ed.Addenda98 = mockAddenda98()
+					ed.Category = CategoryNOC
+				})
+			},
+		},
+		{
+			sec: CTX,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 100000, "Receiver Company").Addenda("Debit First Account")
+			},
+		},
+		{
+			sec:    DNE,
+			header: func(bh *BatchHeader) { bh.OriginatorStatusCode = 2 },
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 0, "Best Co").Entry(func(ed *EntryDetail) {
+					ed.TransactionCode = CheckingReturnNOCCredit
+					ed.SetOriginalTraceNumber("031300010000001")
+				}).Addenda(`    DATE OF DEATH*010218*CUSTOMERSSN*#########*AMOUNT*$$$$.cc\`)
+			},
+		},
+		{
+			sec:    ENR,
+			header: func(bh *BatchHeader) { bh.CompanyEntryDescription = "AUTOENROLL" },
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 0, "Best Co").Addenda(`22*12200004*3*123987654321*777777777*DOE*JOHN*1\`)
+			},
+		},
+		{
+			sec: MTE,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 10000, "JANE DOE").IdentificationNumber("#123456").Entry(addenda02)
+			},
+		},
+		{
+			sec: POP,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 250000, "Wade Arnold").Entry(func(ed *EntryDetail) {
+					ed.SetPOPCheckSerialNumber("123456")
+					ed.SetPOPTerminalCity("PHIL")
+					ed.SetPOPTerminalState("PA")
+				})
+			},
+		},
+		{
+			sec: POS,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 100000000, "Wade Arnold").IdentificationNumber("45689033").Entry(func(ed *EntryDetail) {
+					ed.DiscretionaryData = "01"
+				}).Entry(addenda02)
+			},
+		},
+		{
+			sec: PPD,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 100000, "Jane Doe")
+			},
+		},
+		{
+			sec:    RCK,
+			header: func(bh *BatchHeader) { bh.CompanyEntryDescription = "REDEPCHECK" },
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 2400, "Wade Arnold").Entry(func(ed *EntryDetail) {
+					ed.SetCheckSerialNumber("123123123")
+				})
+			},
+		},
+		{
+			sec: SHR,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 100000000, "").Entry(func(ed *EntryDetail) {
+					ed.SetSHRCardExpirationDate("0722")
+					ed.SetSHRDocumentReferenceNumber("12345678910")
+					ed.SetSHRIndividualCardAccountNumber("1234567891123456789")
+					ed.DiscretionaryData = "01"
+				}).Entry(addenda02)
+			},
+		},
+		{
+			sec: TEL,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 5000, "Wade Arnold")
+			},
+		},
+		{
+			sec:    TRC,
+			header: func(bh *BatchHeader) { bh.CompanyEntryDescription = "ACH TRC" },
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 250000, "").Entry(func(ed *EntryDetail) {
+					ed.SetCheckSerialNumber("123456789012345")
+					ed.SetProcessControlField("CHECK1")
+					ed.SetItemResearchNumber("1234567890123456")
+					ed.SetItemTypeIndicator("01")
+				})
+			},
+		},
+		{
+			sec:    TRX,
+			header: func(bh *BatchHeader) { bh.CompanyEntryDescription = "ACH TRX" },
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 250000, "Receiver Company").Entry(func(ed *EntryDetail) {
+					ed.SetItemTypeIndicator("01")
+				}).Addenda("Debit First Account")
+			},
+		},
+		{
+			sec: WEB,
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Credit(builderAccount, 10000, "John Doe")
+			},
+		},
+		{
+			sec: XCK,
+			header: func(bh *BatchHeader) {
+				bh.CompanyEntryDescription = "CHECK"
+			},
+			build: func(bb *BatchBuilder) *BatchBuilder {
+				return bb.Debit(builderAccount, 2400, "").Entry(func(ed *EntryDetail) {
+					ed.SetCheckSerialNumber("123123123")
+					ed.SetProcessControlField("CHECK1")
+					ed.SetItemResearchNumber("182726")
+				})
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.sec, func(t *testing.T) {
+			bb := NewFileBuilder("121042882", "231380104").AddBatch(tc.sec, builderCompany)
+			if tc.header != nil {
+				bb.Header(tc.header)
+			}
+			file, err := tc.build(bb).Build()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(file.Batches) != 1 {
+				t.Fatalf("got %d batches", len(file.Batches))
+			}
+			if sec := file.Batches[0].GetHeader().StandardEntryClassCode; sec != tc.sec {
+				t.Errorf("StandardEntryClassCode=%q", sec)
+			}
+			var buf bytes.Buffer
+			if err := NewWriter(&buf).Write(file); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestBuilder__errors(t *testing.T) {
+	if _, err := NewFileBuilder("121042882", "231380104").Build(); err == nil {
+		t.Error("expected error with no batches")
+	}
+	if _, err := NewFileBuilder("121042882", "231380104").AddPPDBatch(builderCompany).Build(); err == nil {
+		t.Error("expected error with no entries")
+	}
+	_, err := NewFileBuilder("121042882", "231380104").AddBatch("ZZZ", builderCompany).Credit(builderAccount, 100, "Jane Doe").Build()
+	if err == nil || !strings.Contains(err.Error(), "ZZZ") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	_, err = NewFileBuilder("121042882", "231380104").AddBatch(IAT, builderCompany).Credit(builderAccount, 100, "Jane Doe").Build()
+	if err == nil {
+		t.Error("expected error for IAT")
+	}
+	_, err = NewFileBuilder("121042882", "231380104").AddPPDBatch(builderCompany).Credit(builderAccount, -1, "Jane Doe").Build()
+	if err == nil || !strings.Contains(err.Error(), "negative amount") {
+		t.Errorf("unexpected error: %v", err)
+	}
+	_, err = NewFileBuilder("121042882", "231380104").AddPPDBatch(builderCompany).Addenda("info").Build()
+	if err == nil {
+		t.Error("expected error modifying missing entry")
+	}
+	// invalid routing number
+	_, err = NewFileBuilder("121042882", "231380104").AddPPDBatch(builderCompany).Credit(Account{RoutingNumber: "231380105", Number: "1"}, 100, "Jane Doe").Build()
+	if err == nil {
+		t.Error("expected error")
+	}
+}
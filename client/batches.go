@@ -0,0 +1,89 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/moov-io/ach"
+)
+
+// CreateBatch adds batch to the file with fileID and returns the batch ID, which is
+// the ID of its BatchHeader or generated by the server if empty.
+func (c *Client) CreateBatch(ctx context.Context, fileID string, batch ach.Batcher) (string, error) {
+	req, err := jsonRequest("POST", "/files/"+url.PathEscape(fileID)+"/batches", batch, false)
+	if err != nil {
+		return "", err
+	}
+	return c.fileID(ctx, req)
+}
+
+// GetBatches returns the batches of the file with fileID.
+func (c *Client) GetBatches(ctx context.Context, fileID string) ([]ach.Batcher, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/files/" + url.PathEscape(fileID) + "/batches", idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Batches []*ach.Batch `json:"batches"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	batches := make([]ach.Batcher, len(out.Batches))
+	for i := range out.Batches {
+		batches[i] = convertBatch(out.Batches[i])
+	}
+	return batches, nil
+}
+
+// GetBatch returns the batch with batchID from the file with fileID.
+func (c *Client) GetBatch(ctx context.Context, fileID, batchID string) (ach.Batcher, error) {
+	path := "/files/" + url.PathEscape(fileID) + "/batches/" + url.PathEscape(batchID)
+	resp, err := c.do(ctx, request{method: "GET", path: path, idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Batch *ach.Batch `json:"batch"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return convertBatch(out.Batch), nil
+}
+
+// DeleteBatch removes the batch with batchID from the file with fileID.
+func (c *Client) DeleteBatch(ctx context.Context, fileID, batchID string) error {
+	path := "/files/" + url.PathEscape(fileID) + "/batches/" + url.PathEscape(batchID)
+	resp, err := c.do(ctx, request{method: "DELETE", path: path, idempotent: true})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// convertBatch returns the Batcher for the SEC code of batch, with the ID of its header
+func convertBatch(batch *ach.Batch) ach.Batcher {
+	if batch == nil || batch.Header == nil {
+		return nil
+	}
+	batch.SetID(batch.Header.ID)
+	return ach.ConvertBatchType(*batch)
+}
@@ -0,0 +1,117 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func TestClient__Batches(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	file := readTestFile(t, "ppd-debit.ach")
+	fileID, err := c.CreateFile(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batches, err := c.GetBatches(ctx, fileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(batches) != 1 {
+		t.Fatalf("got %d batches", len(batches))
+	}
+	if _, ok := batches[0].(*ach.BatchPPD); !ok {
+		t.Errorf("unexpected batch: %T", batches[0])
+	}
+
+	// add a copy of the batch
+	bh := *file.Batches[0].GetHeader()
+	bh.ID = "second"
+	bh.BatchNumber = 2
+	batch := ach.NewBatchPPD(&bh)
+	for _, entry := range file.Batches[0].GetEntries() {
+		batch.AddEntry(entry)
+	}
+	if err := batch.Create(); err != nil {
+		t.Fatal(err)
+	}
+	batchID, err := c.CreateBatch(ctx, fileID, batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batchID != "second" {
+		t.Errorf("unexpected batch ID: %q", batchID)
+	}
+
+	got, err := c.GetBatch(ctx, fileID, batchID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ID() != batchID || got.GetHeader().BatchNumber != 2 || len(got.GetEntries()) != 1 {
+		t.Errorf("unexpected batch: %#v", got)
+	}
+
+	if err := c.DeleteBatch(ctx, fileID, batchID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetBatch(ctx, fileID, batchID); !IsNotFound(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient__BatchesIfMatch(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	file := readTestFile(t, "ppd-debit.ach")
+	fileID, err := c.CreateFile(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	etag, err := c.GetFileETag(ctx, fileID)
+	if err != nil || etag == "" {
+		t.Fatalf("etag=%q error=%v", etag, err)
+	}
+
+	bh := *file.Batches[0].GetHeader()
+	bh.BatchNumber = 2
+	batch := ach.NewBatchPPD(&bh)
+	for _, entry := range file.Batches[0].GetEntries() {
+		batch.AddEntry(entry)
+	}
+	if err := batch.Create(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateBatch(WithIfMatch(ctx, etag), fileID, batch); err != nil {
+		t.Fatal(err)
+	}
+
+	// the file has changed since etag
+	if err := c.DeleteFile(WithIfMatch(ctx, etag), fileID); !IsPreconditionFailed(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if batches, err := c.GetBatches(ctx, fileID); err != nil || len(batches) != 2 {
+		t.Errorf("got %d batches: %v", len(batches), err)
+	}
+}
@@ -0,0 +1,304 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package client is a Go client for the ACH HTTP server offered by the server package.
+//
+// Each route of the server has a typed method on Client. Errors returned by the server are
+// decoded into *Error, requests carry an X-Request-Id header (read from the context with
+// WithRequestID or generated) and idempotent requests are retried with exponential backoff.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// Defaults for retrying requests, see WithRetries.
+const (
+	DefaultRetries = 3
+	DefaultBackoff = 250 * time.Millisecond
+)
+
+// Client calls the ACH HTTP server at a base address (e.g. http://localhost:8080)
+type Client struct {
+	address    string
+	httpClient *http.Client
+
+	retries int
+	backoff time.Duration
+
+	// credentials for servers which authenticate requests
+	apiKey string
+	token  string
+}
+
+// Option changes how a Client makes requests.
+type Option func(*Client)
+
+// WithHTTPClient uses httpClient to make requests instead of http.DefaultClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithRetries sets how many times a failed idempotent request is retried. The delay between
+// attempts starts at backoff and doubles after each attempt. Zero retries disables retrying.
+func WithRetries(retries int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.retries = retries
+		c.backoff = backoff
+	}
+}
+
+// WithAPIKey sends key as the X-API-Key header of every request.
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithToken sends token (such as a JWT) as the "Authorization: Bearer" header of every request.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// New returns a Client for the ACH server at address.
+func New(address string, opts ...Option) *Client {
+	c := &Client{
+		address:    strings.TrimSuffix(address, "/"),
+		httpClient: http.DefaultClient,
+		retries:    DefaultRetries,
+		backoff:    DefaultBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Error is returned for non-2xx responses from the ACH server.
+type Error struct {
+	StatusCode int
+	Message    string
+	RequestID  string
+
+	// id is returned by routes which create a file even when it has errors
+	id string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("ach: %d %s: %s", e.StatusCode, http.StatusText(e.StatusCode), e.Message)
+}
+
+// IsNotFound returns true if err is an *Error from a 404 Not Found response.
+func IsNotFound(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusNotFound
+}
+
+// IsPreconditionFailed returns true if err is an *Error from a 412 Precondition Failed response,
+// which means the file was changed since the ETag given to WithIfMatch.
+func IsPreconditionFailed(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusPreconditionFailed
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context which sends requestID as the X-Request-Id header, so requests
+// can be traced across services. Requests without one are sent with a random ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+type idempotencyKeyKey struct{}
+
+// WithIdempotencyKey returns a context which sends key as the Idempotency-Key header of POST requests.
+// Servers which replay idempotent requests respond to retries with the original response, so requests
+// with a key are retried like other idempotent requests.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyKey{}, key)
+}
+
+func idempotencyKey(ctx context.Context) string {
+	v, _ := ctx.Value(idempotencyKeyKey{}).(string)
+	return v
+}
+
+type ifMatchKey struct{}
+
+// WithIfMatch returns a context which sends etag as the If-Match header, so changes to a file
+// fail with a 412 Precondition Failed error (see IsPreconditionFailed) if it has changed since
+// etag was read with GetFileETag.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	return context.WithValue(ctx, ifMatchKey{}, etag)
+}
+
+func requestID(ctx context.Context) string {
+	if v, ok := ctx.Value(requestIDKey{}).(string); ok && v != "" {
+		return v
+	}
+	return base.ID()
+}
+
+// request describes a call to the ACH server
+type request struct {
+	method      string
+	path        string
+	body        []byte
+	contentType string
+
+	// idempotent requests are retried after network errors and 5xx or 429 responses
+	idempotent bool
+}
+
+// jsonRequest returns a request with body encoded as JSON
+func jsonRequest(method, path string, body interface{}, idempotent bool) (request, error) {
+	req := request{
+		method:     method,
+		path:       path,
+		idempotent: idempotent,
+	}
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			return req, fmt.Errorf("problem encoding request: %v", err)
+		}
+		req.body = bs
+		req.contentType = "application/json"
+	}
+	return req, nil
+}
+
+// do sends req, retrying if allowed, and returns the successful response. Callers must close its body.
+func (c *Client) do(ctx context.Context, req request) (*http.Response, error) {
+	requestID := requestID(ctx)
+	backoff := c.backoff
+	if req.method == "POST" && idempotencyKey(ctx) != "" {
+		req.idempotent = true
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, req, requestID)
+		if err == nil && resp.StatusCode < 300 {
+			return resp, nil
+		}
+		if err == nil {
+			err = decodeError(resp, requestID)
+		}
+		if !req.idempotent || attempt >= c.retries || !retryable(err) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+			backoff *= 2
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, req request, requestID string) (*http.Response, error) {
+	var body io.Reader
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+	r, err := http.NewRequestWithContext(ctx, req.method, c.address+req.path, body)
+	if err != nil {
+		return nil, err
+	}
+	if req.contentType != "" {
+		r.Header.Set("Content-Type", req.contentType)
+	}
+	r.Header.Set("X-Request-Id", requestID)
+	if key := idempotencyKey(ctx); key != "" && req.method == "POST" {
+		r.Header.Set("Idempotency-Key", key)
+	}
+	if etag, _ := ctx.Value(ifMatchKey{}).(string); etag != "" && req.method != "GET" {
+		r.Header.Set("If-Match", etag)
+	}
+	if c.apiKey != "" {
+		r.Header.Set("X-API-Key", c.apiKey)
+	}
+	if c.token != "" {
+		r.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	return c.httpClient.Do(r)
+}
+
+// retryable returns true for network errors and responses which might succeed if sent again
+func retryable(err error) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.StatusCode >= 500 || e.StatusCode == http.StatusTooManyRequests
+	}
+	return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+}
+
+// decodeError reads the {"error": "..."} body the server writes with non-2xx responses
+func decodeError(resp *http.Response, requestID string) error {
+	defer resp.Body.Close()
+
+	bs, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	e := &Error{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(bs)),
+		RequestID:  requestID,
+	}
+	var body struct {
+		ID    string `json:"id"`
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(bs, &body); err == nil && body.Error != "" {
+		e.Message = body.Error
+		e.id = body.ID
+	}
+	return e
+}
+
+// decodeResponse reads a JSON body from a successful response into out
+func decodeResponse(resp *http.Response, out interface{}) error {
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("problem reading response: %v", err)
+	}
+	return nil
+}
+
+// Ping checks the ACH server is running.
+func (c *Client) Ping(ctx context.Context) error {
+	resp, err := c.do(ctx, request{method: "GET", path: "/ping", idempotent: true})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
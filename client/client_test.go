@@ -0,0 +1,190 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/moov-io/ach/audit"
+	"github.com/moov-io/ach/server"
+
+	"github.com/go-kit/kit/log"
+)
+
+// newTestServer returns an httptest.Server running the ACH HTTP routes. Requests
+// are passed to wrap (if non-nil) before the routes.
+func newTestServer(t *testing.T, wrap func(w http.ResponseWriter, r *http.Request, next http.Handler)) *httptest.Server {
+	t.Helper()
+
+	repo := server.NewRepositoryInMemory(0, nil)
+	handler := server.MakeHTTPHandler(server.NewService(repo), repo, log.NewNopLogger(), server.ReplayIdempotentRequests(time.Hour), server.RecordHistory(audit.NewStoreInMemory()))
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if wrap != nil {
+			wrap(w, r, handler)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newTestClient(t *testing.T) *Client {
+	t.Helper()
+	return New(newTestServer(t, nil).URL, WithRetries(0, 0))
+}
+
+func TestClient__Ping(t *testing.T) {
+	if err := newTestClient(t).Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	c := New("http://127.0.0.1:0", WithRetries(0, 0))
+	if err := c.Ping(context.Background()); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient__Error(t *testing.T) {
+	c := newTestClient(t)
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	_, err := c.GetFile(ctx, "missing")
+
+	var e *Error
+	if !errors.As(err, &e) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	if e.StatusCode != http.StatusNotFound || e.Message != "not found" || e.RequestID != "req-1" {
+		t.Errorf("unexpected error: %#v", e)
+	}
+	if !IsNotFound(err) {
+		t.Error("expected IsNotFound")
+	}
+	if err.Error() != "ach: 404 Not Found: not found" {
+		t.Errorf("unexpected message: %v", err)
+	}
+	if IsNotFound(errors.New("not found")) {
+		t.Error("only *Error is not found")
+	}
+}
+
+func TestClient__RequestID(t *testing.T) {
+	var requestIDs []string
+	ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+		next.ServeHTTP(w, r)
+	})
+	c := New(ts.URL)
+
+	if err := c.Ping(WithRequestID(context.Background(), "abc123")); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(requestIDs) != 2 || requestIDs[0] != "abc123" || requestIDs[1] == "" {
+		t.Errorf("unexpected request IDs: %v", requestIDs)
+	}
+}
+
+func TestClient__Credentials(t *testing.T) {
+	var apiKey, authorization string
+	ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		apiKey, authorization = r.Header.Get("X-API-Key"), r.Header.Get("Authorization")
+		next.ServeHTTP(w, r)
+	})
+
+	if err := New(ts.URL, WithAPIKey("key")).Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if apiKey != "key" || authorization != "" {
+		t.Errorf("X-API-Key=%q Authorization=%q", apiKey, authorization)
+	}
+	if err := New(ts.URL, WithToken("jwt")).Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if apiKey != "" || authorization != "Bearer jwt" {
+		t.Errorf("X-API-Key=%q Authorization=%q", apiKey, authorization)
+	}
+}
+
+func TestClient__Retries(t *testing.T) {
+	var attempts int32
+	var requestIDs []string
+	ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		requestIDs = append(requestIDs, r.Header.Get("X-Request-Id"))
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+
+	// idempotent requests are retried
+	c := New(ts.URL, WithRetries(2, time.Millisecond))
+	if err := c.Ping(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&attempts); n != 3 {
+		t.Errorf("got %d attempts", n)
+	}
+	if requestIDs[0] != requestIDs[1] || requestIDs[1] != requestIDs[2] {
+		t.Errorf("retries should share a request ID: %v", requestIDs)
+	}
+
+	// give up after the configured retries
+	atomic.StoreInt32(&attempts, 0)
+	c = New(ts.URL, WithRetries(1, time.Millisecond))
+	err := c.Ping(context.Background())
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusServiceUnavailable || e.Message != "try again" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// creating files isn't retried
+	atomic.StoreInt32(&attempts, 0)
+	if _, err := c.CreateFileFromContents(context.Background(), strings.NewReader("")); err == nil {
+		t.Error("expected error")
+	}
+	if n := atomic.LoadInt32(&attempts); n != 1 {
+		t.Errorf("got %d attempts", n)
+	}
+}
+
+func TestClient__RetriesCanceled(t *testing.T) {
+	ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	c := New(ts.URL, WithRetries(5, time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := c.Ping(ctx); err != context.DeadlineExceeded {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
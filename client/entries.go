@@ -0,0 +1,119 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/moov-io/ach"
+)
+
+func entriesPath(fileID, batchID string) string {
+	return "/files/" + url.PathEscape(fileID) + "/batches/" + url.PathEscape(batchID) + "/entries"
+}
+
+// GetEntries returns the entries of the batch with batchID.
+func (c *Client) GetEntries(ctx context.Context, fileID, batchID string) ([]*ach.EntryDetail, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: entriesPath(fileID, batchID), idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Entries []*ach.EntryDetail `json:"entries"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Entries, nil
+}
+
+// GetEntry returns the entry with entryID, including its addenda records.
+func (c *Client) GetEntry(ctx context.Context, fileID, batchID, entryID string) (*ach.EntryDetail, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: entriesPath(fileID, batchID) + "/" + url.PathEscape(entryID), idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Entry *ach.EntryDetail `json:"entry"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Entry, nil
+}
+
+// CreateEntry adds entry to the batch with batchID and returns the entry's ID, which is
+// generated by the server if empty. The server recomputes the batch's control record.
+func (c *Client) CreateEntry(ctx context.Context, fileID, batchID string, entry *ach.EntryDetail) (string, error) {
+	req, err := jsonRequest("POST", entriesPath(fileID, batchID), entry, false)
+	if err != nil {
+		return "", err
+	}
+	return c.fileID(ctx, req)
+}
+
+// UpdateEntry replaces the entry with entry.ID.
+func (c *Client) UpdateEntry(ctx context.Context, fileID, batchID string, entry *ach.EntryDetail) error {
+	req, err := jsonRequest("PUT", entriesPath(fileID, batchID)+"/"+url.PathEscape(entry.ID), entry, true)
+	if err != nil {
+		return err
+	}
+	_, err = c.fileID(ctx, req)
+	return err
+}
+
+// DeleteEntry removes the entry with entryID from its batch.
+func (c *Client) DeleteEntry(ctx context.Context, fileID, batchID, entryID string) error {
+	resp, err := c.do(ctx, request{method: "DELETE", path: entriesPath(fileID, batchID) + "/" + url.PathEscape(entryID), idempotent: true})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// CreateAddenda adds an *ach.Addenda02, *ach.Addenda05, *ach.Addenda98 or *ach.Addenda99 to the
+// entry with entryID and returns the addenda's ID. Addenda records are read with GetEntry.
+func (c *Client) CreateAddenda(ctx context.Context, fileID, batchID, entryID string, addenda interface{}) (string, error) {
+	req, err := jsonRequest("POST", entriesPath(fileID, batchID)+"/"+url.PathEscape(entryID)+"/addenda", addenda, false)
+	if err != nil {
+		return "", err
+	}
+	return c.fileID(ctx, req)
+}
+
+// UpdateAddenda replaces the addenda record with addendaID.
+func (c *Client) UpdateAddenda(ctx context.Context, fileID, batchID, entryID, addendaID string, addenda interface{}) error {
+	path := entriesPath(fileID, batchID) + "/" + url.PathEscape(entryID) + "/addenda/" + url.PathEscape(addendaID)
+	req, err := jsonRequest("PUT", path, addenda, true)
+	if err != nil {
+		return err
+	}
+	_, err = c.fileID(ctx, req)
+	return err
+}
+
+// DeleteAddenda removes the addenda record with addendaID from its entry.
+func (c *Client) DeleteAddenda(ctx context.Context, fileID, batchID, entryID, addendaID string) error {
+	path := entriesPath(fileID, batchID) + "/" + url.PathEscape(entryID) + "/addenda/" + url.PathEscape(addendaID)
+	resp, err := c.do(ctx, request{method: "DELETE", path: path, idempotent: true})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,105 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func TestClient__Entries(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	fileID, err := c.CreateFile(ctx, readTestFile(t, "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	batches, err := c.GetBatches(ctx, fileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batchID := batches[0].ID()
+
+	entry := ach.NewEntryDetail()
+	entry.TransactionCode = ach.CheckingDebit
+	entry.SetRDFI("231380104")
+	entry.DFIAccountNumber = "987654321"
+	entry.Amount = 2500
+	entry.IndividualName = "Jane Doe"
+	entry.SetTraceNumber("12104288", 2)
+	entryID, err := c.CreateEntry(ctx, fileID, batchID, entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entryID == "" {
+		t.Fatal("no entry ID returned")
+	}
+
+	entries, err := c.GetEntries(ctx, fileID, batchID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries", len(entries))
+	}
+
+	entry.ID = entryID
+	entry.Amount = 3000
+	if err := c.UpdateEntry(ctx, fileID, batchID, entry); err != nil {
+		t.Fatal(err)
+	}
+
+	addenda := ach.NewAddenda05()
+	addenda.PaymentRelatedInformation = "invoice 123"
+	addendaID, err := c.CreateAddenda(ctx, fileID, batchID, entryID, addenda)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addenda.PaymentRelatedInformation = "invoice 456"
+	if err := c.UpdateAddenda(ctx, fileID, batchID, entryID, addendaID, addenda); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := c.GetEntry(ctx, fileID, batchID, entryID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Amount != 3000 || len(got.Addenda05) != 1 || got.Addenda05[0].PaymentRelatedInformation != "invoice 456" {
+		t.Errorf("unexpected entry: %#v", got)
+	}
+
+	if err := c.DeleteAddenda(ctx, fileID, batchID, entryID, addendaID); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteEntry(ctx, fileID, batchID, entryID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetEntry(ctx, fileID, batchID, entryID); !IsNotFound(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// validation errors are returned
+	entry.ID = ""
+	entry.CheckDigit = "0"
+	if _, err := c.CreateEntry(ctx, fileID, batchID, entry); err == nil {
+		t.Error("expected error")
+	}
+}
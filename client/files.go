@@ -0,0 +1,416 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/audit"
+)
+
+// CreateFile uploads file as JSON and returns its ID. Files which fail validation are still
+// stored by the server, so their ID is returned along with the error.
+func (c *Client) CreateFile(ctx context.Context, file *ach.File) (string, error) {
+	req, err := jsonRequest("POST", "/files/create", file, false)
+	if err != nil {
+		return "", err
+	}
+	return c.createFile(ctx, req)
+}
+
+// CreateFileFromContents uploads a NACHA formatted file and returns its ID. Files which fail
+// parsing are still stored by the server, so their ID is returned along with the error.
+func (c *Client) CreateFileFromContents(ctx context.Context, r io.Reader) (string, error) {
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("problem reading file: %v", err)
+	}
+	return c.createFile(ctx, request{
+		method:      "POST",
+		path:        "/files/create",
+		body:        bs,
+		contentType: "text/plain",
+	})
+}
+
+func (c *Client) createFile(ctx context.Context, req request) (string, error) {
+	id, err := c.fileID(ctx, req)
+	if err != nil {
+		var e *Error
+		if errors.As(err, &e) {
+			return e.id, err
+		}
+	}
+	return id, err
+}
+
+// GetFiles returns every file stored on the server.
+func (c *Client) GetFiles(ctx context.Context) ([]*ach.File, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/files", idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Files []*ach.File `json:"files"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Files, nil
+}
+
+// FileQuery selects files returned by FindFiles. Empty fields match every file.
+type FileQuery struct {
+	ImmediateOrigin      string
+	ImmediateDestination string
+
+	// CreatedAfter and CreatedBefore bound the file's FileCreationDate (inclusive)
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// SECCode and Category match files with a batch of the SEC code or an entry of the category
+	SECCode  string
+	Category string
+
+	// Sort is one of creationDate (default), immediateOrigin, immediateDestination or id
+	Sort       string
+	Descending bool
+
+	// Cursor is returned by FindFiles for the next page, Limit of zero returns every file
+	Cursor string
+	Limit  int
+}
+
+func (q FileQuery) values() url.Values {
+	v := make(url.Values)
+	set := func(key, value string) {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	set("immediateOrigin", q.ImmediateOrigin)
+	set("immediateDestination", q.ImmediateDestination)
+	if !q.CreatedAfter.IsZero() {
+		v.Set("createdAfter", q.CreatedAfter.Format("2006-01-02"))
+	}
+	if !q.CreatedBefore.IsZero() {
+		v.Set("createdBefore", q.CreatedBefore.Format("2006-01-02"))
+	}
+	set("secCode", q.SECCode)
+	set("category", q.Category)
+	set("sort", q.Sort)
+	if q.Descending {
+		v.Set("order", "desc")
+	}
+	set("cursor", q.Cursor)
+	if q.Limit > 0 {
+		v.Set("limit", strconv.Itoa(q.Limit))
+	}
+	return v
+}
+
+// FindFiles returns the files selected by query and the cursor of the next page, which is
+// empty on the last page.
+func (c *Client) FindFiles(ctx context.Context, query FileQuery) ([]*ach.File, string, error) {
+	path := "/files"
+	if v := query.values(); len(v) > 0 {
+		path += "?" + v.Encode()
+	}
+	resp, err := c.do(ctx, request{method: "GET", path: path, idempotent: true})
+	if err != nil {
+		return nil, "", err
+	}
+	var out struct {
+		Files      []*ach.File `json:"files"`
+		NextCursor string      `json:"nextCursor"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, "", err
+	}
+	return out.Files, out.NextCursor, nil
+}
+
+// GetFile returns the file with fileID.
+func (c *Client) GetFile(ctx context.Context, fileID string) (*ach.File, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/files/" + url.PathEscape(fileID), idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		File *ach.File `json:"file"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.File, nil
+}
+
+// GetFileETag returns the current version of the file with fileID, to change it with WithIfMatch.
+func (c *Client) GetFileETag(ctx context.Context, fileID string) (string, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/files/" + url.PathEscape(fileID), idempotent: true})
+	if err != nil {
+		return "", err
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+// GetFileHistory returns the changes made to the file with fileID, oldest first. It's available
+// after the file is deleted, but only from servers recording history.
+func (c *Client) GetFileHistory(ctx context.Context, fileID string) ([]audit.Event, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/files/" + url.PathEscape(fileID) + "/history", idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		History []audit.Event `json:"history"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.History, nil
+}
+
+// GetFileContents returns the file with fileID in the NACHA format.
+func (c *Client) GetFileContents(ctx context.Context, fileID string) ([]byte, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/files/" + url.PathEscape(fileID) + "/contents", idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading response: %v", err)
+	}
+	return bs, nil
+}
+
+// ValidateFile checks the file with fileID is valid, with the default validation when opts is nil.
+func (c *Client) ValidateFile(ctx context.Context, fileID string, opts *ach.ValidateOpts) error {
+	path := "/files/" + url.PathEscape(fileID) + "/validate"
+	req := request{method: "GET", path: path, idempotent: true}
+	if opts != nil {
+		var err error
+		if req, err = jsonRequest("POST", path, opts, true); err != nil {
+			return err
+		}
+	}
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// DeleteFile removes the file with fileID.
+func (c *Client) DeleteFile(ctx context.Context, fileID string) error {
+	resp, err := c.do(ctx, request{method: "DELETE", path: "/files/" + url.PathEscape(fileID), idempotent: true})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// BalanceFile adds an offset entry to each batch of the file with fileID and returns the ID of the balanced file.
+func (c *Client) BalanceFile(ctx context.Context, fileID string, offset *ach.Offset) (string, error) {
+	req, err := jsonRequest("POST", "/files/"+url.PathEscape(fileID)+"/balance", offset, false)
+	if err != nil {
+		return "", err
+	}
+	return c.fileID(ctx, req)
+}
+
+// SegmentFile splits the file with fileID into a file of credits and a file of debits and returns their IDs.
+// Either ID is empty if the file had no entries of that kind.
+func (c *Client) SegmentFile(ctx context.Context, fileID string, opts *ach.SegmentFileConfiguration) (creditFileID string, debitFileID string, err error) {
+	if opts == nil {
+		opts = ach.NewSegmentFileConfiguration()
+	}
+	req, err := jsonRequest("POST", "/files/"+url.PathEscape(fileID)+"/segment", opts, false)
+	if err != nil {
+		return "", "", err
+	}
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return "", "", err
+	}
+	var out struct {
+		CreditFileID string `json:"creditFileID"`
+		DebitFileID  string `json:"debitFileID"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return "", "", err
+	}
+	return out.CreditFileID, out.DebitFileID, nil
+}
+
+// FlattenBatches combines batches with matching headers in the file with fileID and returns the ID of the new file.
+func (c *Client) FlattenBatches(ctx context.Context, fileID string) (string, error) {
+	return c.fileID(ctx, request{method: "POST", path: "/files/" + url.PathEscape(fileID) + "/flatten"})
+}
+
+// MergeFiles combines the files into as few files as conditions allow (see ach.MergeFilesWith)
+// and returns the IDs of the merged files. The files merged are left unchanged.
+func (c *Client) MergeFiles(ctx context.Context, fileIDs []string, conditions ach.Conditions) ([]string, error) {
+	req, err := jsonRequest("POST", "/files/merge", map[string]interface{}{
+		"fileIDs":    fileIDs,
+		"conditions": conditions,
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		FileIDs []string `json:"fileIDs"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.FileIDs, nil
+}
+
+// ImportResult is the outcome of storing one file from ImportFiles
+type ImportResult struct {
+	// Name is the file's path in the zip archive
+	Name string `json:"name"`
+	// ID of the stored file, empty when Error is set
+	ID    string `json:"id"`
+	Error string `json:"error"`
+}
+
+// ImportFiles uploads a zip archive of NACHA formatted or JSON files and returns the result of
+// storing each one. Files which fail parsing don't prevent others from being stored.
+func (c *Client) ImportFiles(ctx context.Context, archive io.Reader) ([]ImportResult, error) {
+	bs, err := ioutil.ReadAll(archive)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading archive: %v", err)
+	}
+	resp, err := c.do(ctx, request{
+		method:      "POST",
+		path:        "/files/import",
+		body:        bs,
+		contentType: "application/zip",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Files []ImportResult `json:"files"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Files, nil
+}
+
+// Finding is a problem ValidateContents found in a file, with the line it's on when known.
+type Finding struct {
+	Line    int    `json:"line"`
+	Record  string `json:"record"`
+	Message string `json:"message"`
+}
+
+// ValidateContents checks a NACHA formatted file without storing it and returns every problem
+// found, none when the file is valid. The default validation is used when opts is nil.
+func (c *Client) ValidateContents(ctx context.Context, r io.Reader, opts *ach.ValidateOpts) ([]Finding, error) {
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading file: %v", err)
+	}
+	path := "/validate"
+	if opts != nil {
+		q := url.Values{}
+		q.Set("requireABAOrigin", strconv.FormatBool(opts.RequireABAOrigin))
+		q.Set("bypassOriginValidation", strconv.FormatBool(opts.BypassOriginValidation))
+		q.Set("bypassDestinationValidation", strconv.FormatBool(opts.BypassDestinationValidation))
+		path += "?" + q.Encode()
+	}
+	resp, err := c.do(ctx, request{
+		method:      "POST",
+		path:        path,
+		body:        bs,
+		contentType: "text/plain",
+		idempotent:  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Findings []Finding `json:"findings"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Findings, nil
+}
+
+// ConvertFile converts a file from one format ("ach", "json", "csv" or "iso20022") to another ("ach",
+// "json", "xml", "pain.001" or "camt.054") without storing it.
+func (c *Client) ConvertFile(ctx context.Context, r io.Reader, from, to string) ([]byte, error) {
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading file: %v", err)
+	}
+	q := url.Values{}
+	q.Set("from", from)
+	q.Set("to", to)
+	resp, err := c.do(ctx, request{
+		method:     "POST",
+		path:       "/convert?" + q.Encode(),
+		body:       bs,
+		idempotent: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading response: %v", err)
+	}
+	return bs, nil
+}
+
+// fileID sends req and reads the {"id": "..."} response
+func (c *Client) fileID(ctx context.Context, req request) (string, error) {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	var out struct {
+		ID string `json:"id"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return "", err
+	}
+	return out.ID, nil
+}
@@ -0,0 +1,439 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/audit"
+)
+
+func readTestFile(t *testing.T, name string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &file
+}
+
+func TestClient__Files(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	file := readTestFile(t, "ppd-debit.ach")
+	file.ID = "ppd"
+	fileID, err := c.CreateFile(ctx, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fileID != "ppd" {
+		t.Errorf("unexpected file ID: %q", fileID)
+	}
+
+	got, err := c.GetFile(ctx, fileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Header.ImmediateOrigin != file.Header.ImmediateOrigin || len(got.Batches) != 1 {
+		t.Errorf("unexpected file: %#v", got)
+	}
+
+	files, err := c.GetFiles(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].ID != fileID {
+		t.Errorf("unexpected files: %#v", files)
+	}
+
+	contents, err := c.GetFileContents(ctx, fileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ach.NewReader(bytes.NewReader(contents)).Read(); err != nil {
+		t.Errorf("problem reading contents: %v\n%s", err, contents)
+	}
+
+	if err := c.ValidateFile(ctx, fileID, nil); err != nil {
+		t.Error(err)
+	}
+	if err := c.ValidateFile(ctx, fileID, &ach.ValidateOpts{RequireABAOrigin: true}); err != nil {
+		t.Error(err)
+	}
+
+	if err := c.DeleteFile(ctx, fileID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetFile(ctx, fileID); !IsNotFound(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient__FindFiles(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	for _, id := range []string{"a", "b", "c"} {
+		file := readTestFile(t, "ppd-debit.ach")
+		file.ID = id
+		if _, err := c.CreateFile(ctx, file); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	query := FileQuery{
+		ImmediateOrigin: "121042882",
+		CreatedAfter:    time.Date(2019, time.June, 1, 0, 0, 0, 0, time.UTC),
+		SECCode:         "PPD",
+		Sort:            "id",
+		Descending:      true,
+		Limit:           2,
+	}
+	files, cursor, err := c.FindFiles(ctx, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].ID != "c" || files[1].ID != "b" || cursor == "" {
+		t.Fatalf("files=%d cursor=%q", len(files), cursor)
+	}
+
+	query.Cursor = cursor
+	files, cursor, err = c.FindFiles(ctx, query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].ID != "a" || cursor != "" {
+		t.Fatalf("files=%d cursor=%q", len(files), cursor)
+	}
+
+	if _, _, err := c.FindFiles(ctx, FileQuery{Sort: "amount"}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient__CreateFileFromContents(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fileID, err := c.CreateFileFromContents(ctx, bytes.NewReader(bs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fileID == "" {
+		t.Error("empty file ID")
+	}
+
+	// invalid files are stored and their ID is returned with the error
+	fileID, err = c.CreateFileFromContents(ctx, bytes.NewReader(bs[:200]))
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusBadRequest {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fileID == "" {
+		t.Error("expected file ID with error")
+	}
+}
+
+func TestClient__BalanceFile(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	fileID, err := c.CreateFile(ctx, readTestFile(t, "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	balancedID, err := c.BalanceFile(ctx, fileID, &ach.Offset{
+		RoutingNumber: "987654320",
+		AccountNumber: "216112",
+		AccountType:   ach.OffsetChecking,
+		Description:   "OFFSET",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	balanced, err := c.GetFile(ctx, balancedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(balanced.Batches[0].GetEntries()); n != 2 {
+		t.Errorf("got %d entries", n)
+	}
+
+	if _, err := c.BalanceFile(ctx, fileID, &ach.Offset{}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient__SegmentFile(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	fileID, err := c.CreateFile(ctx, readTestFile(t, "ppd-mixedDebitCredit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	creditFileID, debitFileID, err := c.SegmentFile(ctx, fileID, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{creditFileID, debitFileID} {
+		if _, err := c.GetFile(ctx, id); err != nil {
+			t.Errorf("%s: %v", id, err)
+		}
+	}
+}
+
+func TestClient__FlattenBatches(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	fileID, err := c.CreateFile(ctx, readTestFile(t, "flattenBatchesMultipleBatchHeaders.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	flattenedID, err := c.FlattenBatches(ctx, fileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	flattened, err := c.GetFile(ctx, flattenedID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(flattened.Batches) == 0 {
+		t.Error("no batches")
+	}
+
+	if _, err := c.FlattenBatches(ctx, "missing"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient__MergeFiles(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	first := readTestFile(t, "ppd-debit.ach")
+	second := readTestFile(t, "web-debit.ach")
+	second.Header = first.Header
+
+	var fileIDs []string
+	for _, f := range []*ach.File{first, second} {
+		id, err := c.CreateFile(ctx, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fileIDs = append(fileIDs, id)
+	}
+
+	mergedIDs, err := c.MergeFiles(ctx, fileIDs, ach.Conditions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mergedIDs) != 1 {
+		t.Fatalf("mergedIDs=%v", mergedIDs)
+	}
+	merged, err := c.GetFile(ctx, mergedIDs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merged.Batches) != 4 {
+		t.Errorf("merged file has %d batches", len(merged.Batches))
+	}
+
+	if _, err := c.MergeFiles(ctx, []string{"missing"}, ach.Conditions{}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient__ImportFiles(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for _, name := range []string{"ppd-debit.ach", "web-debit.ach"} {
+		bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(bs)
+	}
+	w, _ := zw.Create("invalid.ach")
+	w.Write([]byte("invalid"))
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := c.ImportFiles(ctx, &archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 3 {
+		t.Fatalf("results=%#v", results)
+	}
+	for _, result := range results[:2] {
+		if _, err := c.GetFile(ctx, result.ID); err != nil {
+			t.Errorf("%s: %v", result.Name, err)
+		}
+	}
+	if results[2].ID != "" || results[2].Error == "" {
+		t.Errorf("expected error: %#v", results[2])
+	}
+
+	if _, err := c.ImportFiles(ctx, bytes.NewReader([]byte("not a zip"))); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestClient__IdempotencyKey(t *testing.T) {
+	var attempts int32
+	ts := newTestServer(t, func(w http.ResponseWriter, r *http.Request, next http.Handler) {
+		if r.Method == "POST" && atomic.AddInt32(&attempts, 1) == 1 {
+			// the file is created, but its response is lost
+			next.ServeHTTP(httptest.NewRecorder(), r)
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+	c := New(ts.URL, WithRetries(1, time.Millisecond))
+
+	ctx := WithIdempotencyKey(context.Background(), "create-ppd-debit")
+	fileID, err := c.CreateFile(ctx, readTestFile(t, "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&attempts); n != 2 {
+		t.Errorf("got %d attempts", n)
+	}
+	files, err := c.GetFiles(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].ID != fileID {
+		t.Errorf("retry created another file: %d files", len(files))
+	}
+}
+
+func TestClient__GetFileHistory(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	fileID, err := c.CreateFile(ctx, readTestFile(t, "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteFile(ctx, fileID); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := c.GetFileHistory(ctx, fileID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 || history[0].Operation != audit.FileCreated || history[1].Operation != audit.FileDeleted {
+		t.Fatalf("unexpected history: %#v", history)
+	}
+	if s := history[1].Before; s == nil || s.Batches != 1 || s.Entries != 1 {
+		t.Errorf("unexpected summary: %#v", s)
+	}
+
+	if _, err := c.GetFileHistory(ctx, "missing"); !IsNotFound(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestClient__ValidateContents(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	findings, err := c.ValidateContents(ctx, bytes.NewReader(bs), nil)
+	if err != nil || len(findings) != 0 {
+		t.Fatalf("findings=%#v error=%v", findings, err)
+	}
+
+	invalid := bytes.Replace(bs, []byte("0100000000"), []byte("0100000099"), 1)
+	findings, err = c.ValidateContents(ctx, bytes.NewReader(invalid), &ach.ValidateOpts{BypassOriginValidation: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(findings) != 1 || findings[0].Line != 4 {
+		t.Errorf("unexpected findings: %#v", findings)
+	}
+}
+
+func TestClient__ConvertFile(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := c.ConvertFile(ctx, bytes.NewReader(bs), "ach", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := ach.FileFromJSON(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Batches) != 1 {
+		t.Errorf("unexpected file: %#v", file)
+	}
+
+	if _, err := c.ConvertFile(ctx, bytes.NewReader(bs), "ach", "yaml"); err == nil {
+		t.Error("expected error")
+	}
+	files, err := c.GetFiles(ctx)
+	if err != nil || len(files) != 0 {
+		t.Errorf("stored %d files: %v", len(files), err)
+	}
+}
@@ -0,0 +1,98 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/moov-io/ach/webhook"
+)
+
+// CreateWebhook subscribes sub.URL to webhooks and returns the stored subscription. Its secret,
+// which is generated by the server when empty, is only returned from this method.
+func (c *Client) CreateWebhook(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	req, err := jsonRequest("POST", "/webhooks", sub, false)
+	if err != nil {
+		return nil, err
+	}
+	return c.webhook(ctx, req)
+}
+
+// GetWebhooks returns every webhook subscription, without their secrets.
+func (c *Client) GetWebhooks(ctx context.Context) ([]*webhook.Subscription, error) {
+	resp, err := c.do(ctx, request{method: "GET", path: "/webhooks", idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Subscriptions []*webhook.Subscription `json:"subscriptions"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Subscriptions, nil
+}
+
+// GetWebhook returns the webhook subscription with subscriptionID, without its secret.
+func (c *Client) GetWebhook(ctx context.Context, subscriptionID string) (*webhook.Subscription, error) {
+	return c.webhook(ctx, request{method: "GET", path: "/webhooks/" + url.PathEscape(subscriptionID), idempotent: true})
+}
+
+// DeleteWebhook removes the webhook subscription with subscriptionID.
+func (c *Client) DeleteWebhook(ctx context.Context, subscriptionID string) error {
+	resp, err := c.do(ctx, request{method: "DELETE", path: "/webhooks/" + url.PathEscape(subscriptionID), idempotent: true})
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// GetWebhookDeliveries returns the deliveries to subscriptionID, or to every subscription when
+// empty, newest first.
+func (c *Client) GetWebhookDeliveries(ctx context.Context, subscriptionID string) ([]*webhook.Delivery, error) {
+	path := "/webhooks/deliveries"
+	if subscriptionID != "" {
+		path = "/webhooks/" + url.PathEscape(subscriptionID) + "/deliveries"
+	}
+	resp, err := c.do(ctx, request{method: "GET", path: path, idempotent: true})
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Deliveries []*webhook.Delivery `json:"deliveries"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Deliveries, nil
+}
+
+func (c *Client) webhook(ctx context.Context, req request) (*webhook.Subscription, error) {
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Subscription *webhook.Subscription `json:"subscription"`
+	}
+	if err := decodeResponse(resp, &out); err != nil {
+		return nil, err
+	}
+	return out.Subscription, nil
+}
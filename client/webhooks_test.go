@@ -0,0 +1,83 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/moov-io/ach/server"
+	"github.com/moov-io/ach/webhook"
+
+	"github.com/go-kit/kit/log"
+)
+
+func TestClient__Webhooks(t *testing.T) {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer receiver.Close()
+
+	repo := server.NewRepositoryInMemory(0, nil)
+	hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(10), webhook.Retries(1, time.Millisecond))
+	ts := httptest.NewServer(server.MakeHTTPHandler(server.NewService(repo), repo, log.NewNopLogger(), server.SendWebhooks(hooks)))
+	defer ts.Close()
+
+	c := New(ts.URL, WithRetries(0, 0))
+	ctx := context.Background()
+
+	sub, err := c.CreateWebhook(ctx, webhook.Subscription{URL: receiver.URL, Secret: "secret", Events: []string{webhook.FileCreated}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.ID == "" || sub.Secret != "secret" {
+		t.Fatalf("unexpected subscription: %#v", sub)
+	}
+	if _, err := c.CreateWebhook(ctx, webhook.Subscription{URL: "invalid"}); err == nil {
+		t.Error("expected error")
+	}
+
+	subs, err := c.GetWebhooks(ctx)
+	if err != nil || len(subs) != 1 || subs[0].Secret != "" {
+		t.Fatalf("subscriptions=%#v error=%v", subs, err)
+	}
+	if found, err := c.GetWebhook(ctx, sub.ID); err != nil || found.URL != receiver.URL {
+		t.Fatalf("subscription=%#v error=%v", found, err)
+	}
+
+	if _, err := c.CreateFile(ctx, readTestFile(t, "ppd-debit.ach")); err != nil {
+		t.Fatal(err)
+	}
+	hooks.Wait()
+
+	deliveries, err := c.GetWebhookDeliveries(ctx, sub.ID)
+	if err != nil || len(deliveries) != 1 || deliveries[0].Status != webhook.StatusDelivered {
+		t.Fatalf("deliveries=%#v error=%v", deliveries, err)
+	}
+	if deliveries, err := c.GetWebhookDeliveries(ctx, ""); err != nil || len(deliveries) != 1 {
+		t.Fatalf("deliveries=%#v error=%v", deliveries, err)
+	}
+
+	if err := c.DeleteWebhook(ctx, sub.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetWebhook(ctx, sub.ID); err == nil {
+		t.Error("expected error")
+	}
+}
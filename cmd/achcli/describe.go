@@ -5,13 +5,13 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/moov-io/ach"
-	"github.com/moov-io/customers/pkg/secrets/mask"
 )
 
 func dumpFiles(paths []string) error {
@@ -56,6 +56,9 @@ func dumpFile(file *ach.File) {
 	if file == nil {
 		return
 	}
+	if *flagMask {
+		file = file.Mask(ach.DefaultMaskingPolicy())
+	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	defer w.Flush()
@@ -83,11 +86,7 @@ func dumpFile(file *ach.File) {
 			fmt.Fprintln(w, "\n    TransactionCode\tRDFIIdentification\tAccountNumber\tAmount\tName\tTraceNumber\tCategory")
 
 			e := entries[j]
-			accountNumber := e.DFIAccountNumber
-			if *flagMask {
-				accountNumber = mask.AccountNumber(strings.TrimSpace(accountNumber))
-			}
-			fmt.Fprintf(w, "    %d\t%s\t%s\t%d\t%s\t%s\t%s\n", e.TransactionCode, e.RDFIIdentification, accountNumber, e.Amount, e.IndividualName, e.TraceNumber, e.Category)
+			fmt.Fprintf(w, "    %d\t%s\t%s\t%d\t%s\t%s\t%s\n", e.TransactionCode, e.RDFIIdentification, strings.TrimSpace(e.DFIAccountNumber), e.Amount, e.IndividualName, e.TraceNumber, e.Category)
 
 			dumpAddenda02(w, e.Addenda02)
 			for i := range e.Addenda05 {
@@ -148,12 +147,10 @@ func dumpAddenda99(w *tabwriter.Writer, a *ach.Addenda99) {
 }
 
 func readACHFile(path string) (*ach.File, error) {
-	fd, err := os.Open(path)
+	bs, err := readInput(path)
 	if err != nil {
-		return nil, fmt.Errorf("problem opening %s: %v", path, err)
+		return nil, err
 	}
-	defer fd.Close()
-
-	f, err := ach.NewReader(fd).Read()
+	f, err := ach.NewReader(bytes.NewReader(bs)).Read()
 	return &f, err
 }
@@ -0,0 +1,63 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/csvimport"
+)
+
+// importCSV creates ACH files from the rows of a CSV file. A single file is written to stdout,
+// otherwise each file is written into the current directory.
+func importCSV(mappingPath string, path string, as string) error {
+	mapping, err := csvimport.ReadMappingFile(mappingPath)
+	if err != nil {
+		return err
+	}
+	bs, err := readInput(path)
+	if err != nil {
+		return err
+	}
+
+	files, err := csvimport.Import(bytes.NewReader(bs), mapping)
+	if err != nil {
+		return fmt.Errorf("problem importing %s: %v", path, err)
+	}
+	if as == "" {
+		as = "ach"
+	}
+	for i := range files {
+		if *flagMask {
+			files[i] = files[i].Mask(ach.DefaultMaskingPolicy())
+		}
+	}
+	if len(files) == 1 {
+		return writeFile(os.Stdout, files[0], as)
+	}
+
+	base := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	for i := range files {
+		name := fmt.Sprintf("%s-%d.%s", base, i+1, as)
+		out, err := os.Create(name)
+		if err != nil {
+			return err
+		}
+		if err := writeFile(out, files[i], as); err != nil {
+			out.Close()
+			return err
+		}
+		if err := out.Close(); err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "wrote %s\n", name)
+	}
+	return nil
+}
@@ -20,8 +20,13 @@ var (
 	flagDiff     = flag.Bool("diff", false, "Compare two files against each other")
 	flagMerge    = flag.Bool("merge", false, "Merge files before describing")
 	flagReformat = flag.String("reformat", "", "Reformat an incoming ACH file to another format")
+	flagCSV      = flag.String("csv", "", "Create ACH files from a CSV file with the given JSON column mapping")
 
-	flagMask = flag.Bool("mask", false, "Mask/hide full account numbers")
+	flagMask = flag.Bool("mask", false, "Mask/hide account numbers, names, identification numbers and payment information")
+
+	flagPGPPublic  = flag.String("pgp.public", "", "Keyring of public keys to encrypt for and verify signatures with")
+	flagPGPPrivate = flag.String("pgp.private", "", "Keyring of private keys to decrypt and sign with (passphrase read from ACH_PGP_PASSPHRASE)")
+	flagEncrypt    = flag.Bool("encrypt", false, "Encrypt output of -reformat and -csv with -pgp.public")
 )
 
 func init() {
@@ -33,7 +38,11 @@ func init() {
 		fmt.Println("  ach -diff first.ach second.ach")
 		fmt.Println("    Show the difference between two ACH files")
 		fmt.Println("  ach -reforamt=json first.ach")
-		fmt.Println("    Convert an incoming ACH file into another format (options: ach, json)")
+		fmt.Println("    Convert an incoming ACH file into another format (options: ach, json, xml, csv, jsonl, pain.001, camt.054)")
+		fmt.Println("  ach -reformat=ach -encrypt -pgp.public=odfi.asc -pgp.private=us.asc first.ach")
+		fmt.Println("    Encrypt and sign an ACH file, encrypted input files are decrypted with -pgp.private")
+		fmt.Println("  ach -csv=mapping.json payouts.csv")
+		fmt.Println("    Create ACH files from rows of a CSV file, use -reformat to choose the output format")
 		fmt.Println("  ach 20060102.ach")
 		fmt.Println("    Summarize an ACH file for human readability")
 		fmt.Println("")
@@ -62,6 +71,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := loadPGPKeys(*flagPGPPublic, *flagPGPPrivate); err != nil {
+		fmt.Printf("ERROR: problem reading PGP keys: %v\n", err)
+		os.Exit(1)
+	}
+	if err := checkEncryptFlags(); err != nil {
+		fmt.Printf("ERROR: %v\n", err)
+		os.Exit(1)
+	}
+
 	// minor debugging
 	if *flagVerbose {
 		fmt.Printf("found %d ACH files to describe: %s\n", len(args), strings.Join(args, ", "))
@@ -75,6 +93,12 @@ func main() {
 			os.Exit(1)
 		}
 
+	case *flagCSV != "" && len(args) == 1:
+		if err := importCSV(*flagCSV, args[0], *flagReformat); err != nil {
+			fmt.Printf("ERROR: %v\n", err)
+			os.Exit(1)
+		}
+
 	case *flagReformat != "" && len(args) == 1:
 		if err := reformat(*flagReformat, args[0]); err != nil {
 			fmt.Printf("ERROR: %v\n", err)
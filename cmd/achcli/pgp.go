@@ -0,0 +1,57 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/moov-io/ach/pgp"
+)
+
+// pgpKeys is set when -pgp.public or -pgp.private are used
+var pgpKeys *pgp.Config
+
+func loadPGPKeys(publicKeyring, privateKeyring string) error {
+	if publicKeyring == "" && privateKeyring == "" {
+		return nil
+	}
+	keys, err := pgp.LoadConfig(publicKeyring, privateKeyring, []byte(os.Getenv("ACH_PGP_PASSPHRASE")))
+	if err != nil {
+		return err
+	}
+	keys.Armor = true
+	pgpKeys = keys
+	return nil
+}
+
+// readInput returns the contents of path, decrypting OpenPGP messages with pgpKeys
+func readInput(path string) ([]byte, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading %s: %v", path, err)
+	}
+	if !pgp.IsEncrypted(bs) {
+		return bs, nil
+	}
+	if pgpKeys == nil {
+		return nil, fmt.Errorf("%s is encrypted, use -pgp.private to decrypt it", path)
+	}
+	bs, err = pgpKeys.Decrypt(bytes.NewReader(bs))
+	if err != nil {
+		return nil, fmt.Errorf("problem decrypting %s: %v", path, err)
+	}
+	return bs, nil
+}
+
+func checkEncryptFlags() error {
+	if *flagEncrypt && (pgpKeys == nil || len(pgpKeys.PublicKeys) == 0) {
+		return errors.New("-encrypt requires -pgp.public")
+	}
+	return nil
+}
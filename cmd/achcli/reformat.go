@@ -5,12 +5,16 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"os"
 
 	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/export"
+	"github.com/moov-io/ach/iso20022"
 )
 
 func reformat(as string, filepath string) error {
@@ -22,19 +26,72 @@ func reformat(as string, filepath string) error {
 	if err != nil {
 		return err
 	}
+	if *flagMask {
+		file = file.Mask(ach.DefaultMaskingPolicy())
+	}
+	return writeFile(os.Stdout, file, as)
+}
 
+// writeFile writes file in the format as, encrypted when -encrypt is used
+func writeFile(w io.Writer, file *ach.File, as string) error {
+	if !*flagEncrypt {
+		return writeFormat(w, file, as)
+	}
+	enc, err := pgpKeys.Encrypt(w)
+	if err != nil {
+		return err
+	}
+	if err := writeFormat(enc, file, as); err != nil {
+		enc.Close()
+		return err
+	}
+	return enc.Close()
+}
+
+func writeFormat(w io.Writer, file *ach.File, as string) error {
 	switch as {
 	case "ach":
-		w := ach.NewWriter(os.Stdout)
-		if err := w.Write(file); err != nil {
+		if err := ach.NewWriter(w).Write(file); err != nil {
 			return err
 		}
 
 	case "json":
-		if err := json.NewEncoder(os.Stdout).Encode(file); err != nil {
+		if err := json.NewEncoder(w).Encode(file); err != nil {
 			return err
 		}
 
+	case "xml":
+		enc := xml.NewEncoder(w)
+		enc.Indent("", "  ")
+		if err := enc.Encode(file); err != nil {
+			return err
+		}
+		fmt.Fprintln(w)
+
+	case "csv":
+		if err := export.WriteCSV(w, file); err != nil {
+			return err
+		}
+
+	case "jsonl":
+		if err := export.WriteJSONLines(w, file); err != nil {
+			return err
+		}
+
+	case "pain.001":
+		report, err := iso20022.WritePain001(w, file)
+		if err != nil {
+			return err
+		}
+		printUnmapped(report)
+
+	case "camt.054":
+		report, err := iso20022.WriteCamt054(w, file)
+		if err != nil {
+			return err
+		}
+		printUnmapped(report)
+
 	default:
 		return fmt.Errorf("unknown format %s", as)
 	}
@@ -48,20 +105,51 @@ func readIncomingFile(path string) (*ach.File, error) {
 	if file, err := readACHFile(path); file != nil && err == nil {
 		return file, nil
 	}
+	if file, err := readXMLFile(path); file != nil && err == nil {
+		return file, nil
+	}
+	if file, err := readISO20022File(path); file != nil && err == nil {
+		return file, nil
+	}
 	return nil, fmt.Errorf("unable to read %s", path)
 }
 
 func readJsonFile(path string) (*ach.File, error) {
-	fd, err := os.Open(path)
+	bs, err := readInput(path)
+	if err != nil {
+		return nil, err
+	}
+	return ach.FileFromJSON(bs)
+}
+
+func readXMLFile(path string) (*ach.File, error) {
+	bs, err := readInput(path)
 	if err != nil {
-		return nil, fmt.Errorf("problem opening %s: %v", path, err)
+		return nil, err
 	}
-	defer fd.Close()
+	return ach.FileFromXML(bs)
+}
 
-	bs, err := ioutil.ReadAll(fd)
+// readISO20022File converts a pain.001 or pacs.008 message into an ACH file.
+func readISO20022File(path string) (*ach.File, error) {
+	bs, err := readInput(path)
 	if err != nil {
-		return nil, fmt.Errorf("problem reading %s: %v", path, err)
+		return nil, err
 	}
 
-	return ach.FileFromJSON(bs)
+	file, report, err := iso20022.Read(bytes.NewReader(bs), nil)
+	if err != nil {
+		return nil, err
+	}
+	printUnmapped(report)
+	return file, nil
+}
+
+// printUnmapped writes fields which were dropped or changed in an ISO 20022 conversion to stderr.
+func printUnmapped(report *iso20022.Report) {
+	if report.Empty() {
+		return
+	}
+	fmt.Fprintln(os.Stderr, "unmapped fields:")
+	fmt.Fprint(os.Stderr, report.String())
 }
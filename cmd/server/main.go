@@ -22,23 +22,35 @@ import (
 	"crypto/tls"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/audit"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/ach/csvimport"
+	"github.com/moov-io/ach/dedupe"
+	"github.com/moov-io/ach/limits"
+	"github.com/moov-io/ach/pgp"
 	"github.com/moov-io/ach/server"
+	"github.com/moov-io/ach/webhook"
 	"github.com/moov-io/base/admin"
 	"github.com/moov-io/base/http/bind"
 
 	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc"
 )
 
 var (
 	httpAddr  = flag.String("http.addr", bind.HTTP("ach"), "HTTP listen address")
 	adminAddr = flag.String("admin.addr", bind.Admin("ach"), "Admin HTTP listen address")
+	grpcAddr  = flag.String("grpc.addr", ":8090", "gRPC listen address")
 
 	flagLogFormat = flag.String("log.format", "", "Format for log lines (Options: json, plain")
 
@@ -73,11 +85,168 @@ func main() {
 			logger.Log("main", fmt.Sprintf("Using %v as ach.File TTL", achFileTTL))
 		}
 	}
-	r := server.NewRepositoryInMemory(achFileTTL, logger)
+	r, err := setupRepository(achFileTTL, logger)
+	if err != nil {
+		logger.Log("main", fmt.Sprintf("problem creating repository: %v", err))
+		os.Exit(1)
+	}
 	svc = server.NewService(r)
 
+	// Optionally reject files (and entries) we've seen before
+	var handlerOpts []server.HandlerOption
+	if v := strings.ToLower(os.Getenv("ACH_REJECT_DUPLICATES")); v == "true" || v == "yes" {
+		var store dedupe.Store = dedupe.NewStoreInMemory()
+		if path := os.Getenv("ACH_DUPLICATES_PATH"); path != "" {
+			s, err := dedupe.NewStoreOnDisk(path)
+			if err != nil {
+				logger.Log("main", fmt.Sprintf("problem opening duplicates store: %v", err))
+				os.Exit(1)
+			}
+			defer s.Close()
+			store = s
+		}
+		// entries are only checked when asked for, since they can legitimately repeat
+		var opts dedupe.Options
+		if v := strings.ToLower(os.Getenv("ACH_DUPLICATE_TRACE_NUMBERS")); v == "true" || v == "yes" {
+			opts.TraceNumbers = true
+		}
+		if v := strings.ToLower(os.Getenv("ACH_DUPLICATE_ENTRIES")); v == "true" || v == "yes" {
+			opts.Entries = true
+		}
+		detector := dedupe.NewDetector(store, &opts)
+		handlerOpts = append(handlerOpts, server.RejectDuplicateFiles(detector))
+		svc = server.WithDuplicateDetector(svc, detector) // check gRPC calls too
+		logger.Log("main", "rejecting duplicate ACH files")
+	}
+
+	// Optionally enforce originator exposure limits
+	if v := strings.ToLower(os.Getenv("ACH_EXPOSURE_LIMITS")); v == "true" || v == "yes" {
+		handlerOpts = append(handlerOpts, server.CheckLimits(limits.NewChecker(limits.NewStoreInMemory())))
+		logger.Log("main", "enabled originator exposure limits")
+	}
+
+	// Optionally send webhooks as files change
+	if v := strings.ToLower(os.Getenv("ACH_WEBHOOKS")); v == "true" || v == "yes" {
+		var opts []webhook.Option
+		if v := os.Getenv("ACH_WEBHOOK_MAX_ATTEMPTS"); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil || n < 1 {
+				logger.Log("main", fmt.Sprintf("invalid ACH_WEBHOOK_MAX_ATTEMPTS=%q", v))
+				os.Exit(1)
+			}
+			opts = append(opts, webhook.Retries(n, time.Second))
+		}
+		hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(1000), opts...)
+		if u := os.Getenv("ACH_WEBHOOK_URL"); u != "" {
+			sub := &webhook.Subscription{
+				ID:     "default",
+				URL:    u,
+				Secret: os.Getenv("ACH_WEBHOOK_SECRET"),
+			}
+			if v := os.Getenv("ACH_WEBHOOK_EVENTS"); v != "" {
+				sub.Events = strings.Split(v, ",")
+			}
+			if err := hooks.Store().SaveSubscription(sub); err != nil {
+				logger.Log("main", fmt.Sprintf("problem subscribing ACH_WEBHOOK_URL: %v", err))
+				os.Exit(1)
+			}
+		}
+		handlerOpts = append(handlerOpts, server.SendWebhooks(hooks))
+		svc = server.WithWebhooks(svc, hooks, logger) // send events for gRPC calls too
+		logger.Log("main", "enabled webhooks")
+	}
+
+	// Optionally record the history of changes to each file
+	if v := strings.ToLower(os.Getenv("ACH_HISTORY")); v == "true" || v == "yes" {
+		var store audit.Store = audit.NewStoreInMemory()
+		if path := os.Getenv("ACH_HISTORY_PATH"); path != "" {
+			s, err := audit.NewStoreOnDisk(path)
+			if err != nil {
+				logger.Log("main", fmt.Sprintf("problem opening history store: %v", err))
+				os.Exit(1)
+			}
+			defer s.Close()
+			store = s
+		}
+		handlerOpts = append(handlerOpts, server.RecordHistory(store))
+		svc = server.WithHistory(svc, store, logger) // record gRPC calls too
+		logger.Log("main", "recording file history")
+	}
+
+	// Optionally reject JSON files with unknown keys or mistyped values
+	if v := strings.ToLower(os.Getenv("ACH_STRICT_JSON")); v == "true" || v == "yes" {
+		handlerOpts = append(handlerOpts, server.StrictJSON())
+		logger.Log("main", "enabled strict JSON decoding")
+	}
+
+	// Optionally replay responses to POST requests retried with the same Idempotency-Key
+	if v := os.Getenv("ACH_IDEMPOTENCY_TTL"); v != "" {
+		ttl, err := time.ParseDuration(v)
+		if err != nil || ttl <= 0 {
+			logger.Log("main", fmt.Sprintf("invalid ACH_IDEMPOTENCY_TTL=%q", v))
+			os.Exit(1)
+		}
+		handlerOpts = append(handlerOpts, server.ReplayIdempotentRequests(ttl))
+		logger.Log("main", fmt.Sprintf("replaying idempotent requests for %v", ttl))
+	}
+
+	// Optionally accept encrypted files and serve encrypted contents
+	if pub, priv := os.Getenv("ACH_PGP_PUBLIC_KEYRING"), os.Getenv("ACH_PGP_PRIVATE_KEYRING"); pub != "" || priv != "" {
+		keys, err := pgp.LoadConfig(pub, priv, []byte(os.Getenv("ACH_PGP_PASSPHRASE")))
+		if err != nil {
+			logger.Log("main", fmt.Sprintf("problem reading PGP keys: %v", err))
+			os.Exit(1)
+		}
+		keys.Armor = true
+		if v := strings.ToLower(os.Getenv("ACH_PGP_REQUIRE_SIGNATURE")); v == "true" || v == "yes" {
+			keys.RequireSignature = true
+		}
+		handlerOpts = append(handlerOpts, server.EncryptFiles(keys))
+		logger.Log("main", "enabled PGP encrypted files")
+	}
+
+	// Read the column mapping used to create files from CSV
+	if path := os.Getenv("ACH_CSV_MAPPING"); path != "" {
+		mapping, err := csvimport.ReadMappingFile(path)
+		if err != nil {
+			logger.Log("main", fmt.Sprintf("problem reading CSV mapping: %v", err))
+			os.Exit(1)
+		}
+		handlerOpts = append(handlerOpts, server.ImportCSV(mapping))
+	}
+
+	// Optionally authenticate requests with API keys or JWTs and scope files to each caller's tenant
+	var authenticators []auth.Authenticator
+	if path := os.Getenv("ACH_API_KEYS_PATH"); path != "" {
+		keys, err := auth.ReadAPIKeysFile(path)
+		if err != nil {
+			logger.Log("main", fmt.Sprintf("problem reading API keys: %v", err))
+			os.Exit(1)
+		}
+		authenticators = append(authenticators, keys)
+	}
+	if path := os.Getenv("ACH_JWKS_PATH"); path != "" {
+		jwts, err := auth.ReadJWKSFile(path, auth.JWTConfig{
+			Issuer:      os.Getenv("ACH_JWT_ISSUER"),
+			Audience:    os.Getenv("ACH_JWT_AUDIENCE"),
+			TenantClaim: os.Getenv("ACH_JWT_TENANT_CLAIM"),
+		})
+		if err != nil {
+			logger.Log("main", fmt.Sprintf("problem reading JWKS: %v", err))
+			os.Exit(1)
+		}
+		authenticators = append(authenticators, jwts)
+	}
+	var grpcOpts []grpc.ServerOption
+	if len(authenticators) > 0 {
+		authenticator := auth.Chain(authenticators...)
+		handlerOpts = append(handlerOpts, server.Authenticate(authenticator))
+		grpcOpts = append(grpcOpts, server.GRPCAuthentication(authenticator)...)
+		logger.Log("main", "enabled authentication")
+	}
+
 	// Create HTTP server
-	handler = server.MakeHTTPHandler(svc, r, log.With(logger, "component", "HTTP"))
+	handler = server.MakeHTTPHandler(svc, r, log.With(logger, "component", "HTTP"), handlerOpts...)
 
 	// Listen for application termination.
 	errs := make(chan error)
@@ -149,7 +318,28 @@ func main() {
 		}
 	}()
 
+	// Check to see if our -grpc.addr flag has been overridden
+	if v := os.Getenv("GRPC_BIND_ADDRESS"); v != "" {
+		*grpcAddr = v
+	}
+
+	// Start gRPC server
+	grpcServer := server.NewGRPCServer(svc, r, log.With(logger, "component", "gRPC"), grpcOpts...)
+	go func() {
+		logger.Log("startup", fmt.Sprintf("binding to %s for gRPC server", *grpcAddr))
+		lis, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			errs <- fmt.Errorf("problem starting gRPC server: %v", err)
+			return
+		}
+		if err := grpcServer.Serve(lis); err != nil {
+			errs <- err
+			logger.Log("exit", err)
+		}
+	}()
+
 	if err := <-errs; err != nil {
+		grpcServer.GracefulStop()
 		shutdownServer()
 		logger.Log("exit", err)
 	}
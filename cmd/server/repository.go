@@ -0,0 +1,80 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/moov-io/ach/server"
+
+	"github.com/go-kit/kit/log"
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// setupRepository returns the Repository chosen with ACH_REPOSITORY, which defaults to storing files in memory.
+func setupRepository(ttl time.Duration, logger log.Logger) (server.Repository, error) {
+	switch v := strings.ToLower(os.Getenv("ACH_REPOSITORY")); v {
+	case "", "memory":
+		return server.NewRepositoryInMemory(ttl, logger), nil
+
+	case "filesystem":
+		path := os.Getenv("ACH_REPOSITORY_PATH")
+		if path == "" {
+			return nil, errors.New("ACH_REPOSITORY_PATH is required to store files on the filesystem")
+		}
+		logger.Log("main", fmt.Sprintf("storing ACH files in %s", path))
+		return server.NewRepositoryOnDisk(path, ttl, logger)
+
+	case "sqlite":
+		path := os.Getenv("ACH_REPOSITORY_PATH")
+		if path == "" {
+			return nil, errors.New("ACH_REPOSITORY_PATH is required to store files in SQLite")
+		}
+		db, err := sql.Open("sqlite", path)
+		if err != nil {
+			return nil, err
+		}
+		db.SetMaxOpenConns(1) // SQLite only allows one writer
+		logger.Log("main", fmt.Sprintf("storing ACH files in SQLite database %s", path))
+		return server.NewRepositorySQL(db, ttl, logger)
+
+	case "postgres":
+		url := os.Getenv("ACH_DATABASE_URL")
+		if url == "" {
+			return nil, errors.New("ACH_DATABASE_URL is required to store files in Postgres")
+		}
+		db, err := sql.Open("postgres", url)
+		if err != nil {
+			return nil, err
+		}
+		if err := db.Ping(); err != nil {
+			return nil, fmt.Errorf("problem connecting to Postgres: %v", err)
+		}
+		logger.Log("main", "storing ACH files in Postgres")
+		return server.NewRepositorySQL(db, ttl, logger)
+
+	default:
+		return nil, fmt.Errorf("unknown ACH_REPOSITORY: %s", v)
+	}
+}
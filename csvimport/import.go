@@ -0,0 +1,344 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package csvimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+)
+
+// Import reads a CSV file, whose first row names each column, and creates Files from its rows
+// according to m.
+//
+// Files are created from every row which could be read. Problems with individual rows are returned
+// as a base.ErrorList of *base.ParseError values with the row's line number and column name.
+func Import(r io.Reader, m *Mapping) ([]*ach.File, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	rd := csv.NewReader(r)
+	rd.TrimLeadingSpace = true
+	if m.Delimiter != "" {
+		rd.Comma = []rune(m.Delimiter)[0]
+	}
+	header, err := rd.Read()
+	if err != nil {
+		return nil, fmt.Errorf("problem reading CSV header: %v", err)
+	}
+	imp, err := newImporter(m, header)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs base.ErrorList
+	for line := 2; ; line++ {
+		record, err := rd.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			errs.Add(&base.ParseError{Line: line, Err: err})
+			continue
+		}
+		if err := imp.add(record); err != nil {
+			if pe, ok := err.(*base.ParseError); ok {
+				pe.Line = line
+			}
+			errs.Add(err)
+		}
+	}
+
+	var files []*ach.File
+	for i := range imp.files {
+		file, err := imp.files[i].builder.Build()
+		if err != nil {
+			errs.Add(fmt.Errorf("file from %s to %s: %v", imp.files[i].origin, imp.files[i].destination, err))
+			continue
+		}
+		files = append(files, file)
+	}
+	if errs.Empty() {
+		return files, nil
+	}
+	return files, errs
+}
+
+type importer struct {
+	mapping *Mapping
+	columns map[string]int
+
+	files []*fileGroup
+}
+
+type fileGroup struct {
+	origin, destination string
+
+	builder *ach.FileBuilder
+	batches map[batchKey]*ach.BatchBuilder
+}
+
+type batchKey struct {
+	sec     string
+	company ach.Company
+}
+
+func newImporter(m *Mapping, header []string) (*importer, error) {
+	imp := &importer{
+		mapping: m,
+		columns: make(map[string]int),
+	}
+	for i := range header {
+		name := strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")) // strip a UTF-8 BOM from spreadsheet exports
+		imp.columns[name] = i
+	}
+	c := m.Columns
+	for _, name := range []string{
+		c.Origin, c.Destination, c.StandardEntryClassCode, c.CompanyName, c.CompanyIdentification,
+		c.CompanyEntryDescription, c.EffectiveEntryDate, c.RoutingNumber, c.AccountNumber, c.AccountType,
+		c.Amount, c.Direction, c.TransactionCode, c.Name, c.IdentificationNumber, c.DiscretionaryData, c.Addenda,
+	} {
+		if _, exists := imp.columns[name]; name != "" && !exists {
+			return nil, fmt.Errorf("column %q not found in CSV header", name)
+		}
+	}
+	return imp, nil
+}
+
+// get returns the trimmed value of column from record, or the default if the column isn't mapped or is empty.
+func (imp *importer) get(record []string, column string, def string) string {
+	if column == "" {
+		return def
+	}
+	if v := strings.TrimSpace(record[imp.columns[column]]); v != "" {
+		return v
+	}
+	return def
+}
+
+func rowError(column string, err error) error {
+	return &base.ParseError{Record: column, Err: err}
+}
+
+// add reads an EntryDetail from record and adds it to the batch and file it belongs in.
+func (imp *importer) add(record []string) error {
+	m, c := imp.mapping, imp.mapping.Columns
+
+	origin := imp.get(record, c.Origin, m.Origin)
+	destination := imp.get(record, c.Destination, m.Destination)
+	if origin == "" {
+		return rowError(c.Origin, errors.New("missing origin"))
+	}
+	if destination == "" {
+		return rowError(c.Destination, errors.New("missing destination"))
+	}
+
+	// Batch
+	key := batchKey{
+		sec: strings.ToUpper(imp.get(record, c.StandardEntryClassCode, m.StandardEntryClassCode)),
+		company: ach.Company{
+			Name:              imp.get(record, c.CompanyName, m.CompanyName),
+			Identification:    imp.get(record, c.CompanyIdentification, m.CompanyIdentification),
+			EntryDescription:  imp.get(record, c.CompanyEntryDescription, m.CompanyEntryDescription),
+			DiscretionaryData: m.CompanyDiscretionaryData,
+		},
+	}
+	if key.sec == "" {
+		key.sec = ach.PPD
+	}
+	if _, err := ach.NewBatch(&ach.BatchHeader{StandardEntryClassCode: key.sec}); err != nil {
+		return rowError(c.StandardEntryClassCode, err)
+	}
+	if v := imp.get(record, c.EffectiveEntryDate, m.EffectiveEntryDate); v != "" {
+		layout := m.DateFormat
+		if layout == "" {
+			layout = "2006-01-02"
+		}
+		t, err := time.Parse(layout, v)
+		if err != nil {
+			return rowError(c.EffectiveEntryDate, err)
+		}
+		key.company.EffectiveEntryDate = t
+	}
+
+	// EntryDetail
+	ed, err := imp.entry(record, key.sec)
+	if err != nil {
+		return err
+	}
+	odfi := m.ODFIIdentification
+	if odfi == "" {
+		odfi = origin
+	}
+	ed.SetTraceNumber(odfi, 1)
+	if err := ed.Validate(); err != nil {
+		return rowError("", err)
+	}
+	ed.TraceNumber = "" // assigned by the FileBuilder
+	switch key.sec {
+	case ach.CTX, ach.ATX, ach.TRX:
+		name := ed.IndividualName
+		ed.IndividualName = ""
+		ed.SetCATXAddendaRecords(len(ed.Addenda05))
+		ed.SetCATXReceivingCompany(name)
+	}
+
+	group := imp.fileGroup(origin, destination)
+	bb, exists := group.batches[key]
+	if !exists {
+		bb = group.builder.AddBatch(key.sec, key.company)
+		group.batches[key] = bb
+	}
+	bb.AddEntry(ed)
+	return nil
+}
+
+func (imp *importer) entry(record []string, sec string) (*ach.EntryDetail, error) {
+	c := imp.mapping.Columns
+
+	routingNumber := imp.get(record, c.RoutingNumber, "")
+	if err := ach.CheckRoutingNumber(routingNumber); err != nil {
+		return nil, rowError(c.RoutingNumber, err)
+	}
+	accountNumber := imp.get(record, c.AccountNumber, "")
+	if accountNumber == "" {
+		return nil, rowError(c.AccountNumber, errors.New("missing account number"))
+	}
+	amount, err := parseAmount(imp.get(record, c.Amount, ""), imp.mapping.AmountFormat)
+	if err != nil {
+		return nil, rowError(c.Amount, err)
+	}
+
+	debit := amount < 0
+	if c.Direction != "" {
+		switch strings.ToLower(imp.get(record, c.Direction, "credit")) {
+		case "c", "cr", "credit":
+			debit = false
+		case "d", "dr", "debit":
+			debit = true
+		default:
+			return nil, rowError(c.Direction, fmt.Errorf("unknown direction %q", imp.get(record, c.Direction, "")))
+		}
+	}
+	savings := false
+	switch strings.ToLower(imp.get(record, c.AccountType, "checking")) {
+	case "c", "checking":
+	case "s", "savings":
+		savings = true
+	default:
+		return nil, rowError(c.AccountType, fmt.Errorf("unknown account type %q", imp.get(record, c.AccountType, "")))
+	}
+
+	ed := ach.NewEntryDetail()
+	switch {
+	case debit && savings:
+		ed.TransactionCode = ach.SavingsDebit
+	case debit:
+		ed.TransactionCode = ach.CheckingDebit
+	case savings:
+		ed.TransactionCode = ach.SavingsCredit
+	default:
+		ed.TransactionCode = ach.CheckingCredit
+	}
+	if v := imp.get(record, c.TransactionCode, ""); v != "" {
+		code, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, rowError(c.TransactionCode, err)
+		}
+		ed.TransactionCode = code
+	}
+	ed.SetRDFI(routingNumber)
+	ed.DFIAccountNumber = accountNumber
+	if amount < 0 {
+		amount = -amount
+	}
+	ed.Amount = amount
+	ed.IndividualName = imp.get(record, c.Name, "")
+	ed.IdentificationNumber = imp.get(record, c.IdentificationNumber, "")
+	ed.DiscretionaryData = imp.get(record, c.DiscretionaryData, "")
+	ed.Category = ach.CategoryForward
+	switch sec {
+	case ach.WEB, ach.TEL:
+		ed.SetPaymentType(ed.DiscretionaryData)
+	}
+	if info := imp.get(record, c.Addenda, ""); info != "" {
+		addenda := ach.NewAddenda05()
+		addenda.PaymentRelatedInformation = info
+		ed.AddAddenda05(addenda)
+		ed.AddendaRecordIndicator = 1
+	}
+	return ed, nil
+}
+
+func (imp *importer) fileGroup(origin, destination string) *fileGroup {
+	for i := range imp.files {
+		if imp.files[i].origin == origin && imp.files[i].destination == destination {
+			return imp.files[i]
+		}
+	}
+	m := imp.mapping
+	fb := ach.NewFileBuilder(origin, destination).
+		OriginName(m.OriginName).
+		DestinationName(m.DestinationName)
+	if m.ODFIIdentification != "" {
+		fb.ODFI(m.ODFIIdentification)
+	}
+	group := &fileGroup{
+		origin:      origin,
+		destination: destination,
+		builder:     fb,
+		batches:     make(map[batchKey]*ach.BatchBuilder),
+	}
+	imp.files = append(imp.files, group)
+	return group
+}
+
+// parseAmount returns the amount in cents. Currency symbols and thousands separators are ignored.
+func parseAmount(s string, format string) (int, error) {
+	if s == "" {
+		return 0, errors.New("missing amount")
+	}
+	v := strings.NewReplacer("$", "", ",", "", " ", "").Replace(s)
+	if strings.HasPrefix(v, "(") && strings.HasSuffix(v, ")") {
+		v = "-" + strings.Trim(v, "()") // accounting notation for negative amounts
+	}
+	if strings.EqualFold(format, AmountCents) {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %q", s)
+		}
+		return n, nil
+	}
+	if idx := strings.Index(v, "."); idx >= 0 && len(v)-idx-1 > 2 {
+		return 0, fmt.Errorf("invalid amount %q: more than two decimal places", s)
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	return int(math.Round(f * 100)), nil
+}
@@ -0,0 +1,259 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+package csvimport
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+)
+
+func TestImport(t *testing.T) {
+	m, err := ReadMappingFile(filepath.Join("..", "test", "testdata", "payouts-mapping.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "payouts.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	files, err := Import(fd, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("got %d files", len(files))
+	}
+	file := files[0]
+	if file.Header.ImmediateOrigin != "121042882" || file.Header.ImmediateDestinationName != "Federal Reserve Bank" {
+		t.Errorf("unexpected FileHeader: %#v", file.Header)
+	}
+	if len(file.Batches) != 1 {
+		t.Fatalf("got %d batches", len(file.Batches))
+	}
+	bh := file.Batches[0].GetHeader()
+	if bh.StandardEntryClassCode != ach.PPD || bh.EffectiveEntryDate != "200615" || bh.ServiceClassCode != ach.MixedDebitsAndCredits {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+
+	entries := file.Batches[0].GetEntries()
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries", len(entries))
+	}
+	expected := []struct {
+		code   int
+		amount int
+		name   string
+	}{
+		{ach.CheckingCredit, 125000, "Jane Doe"},
+		{ach.SavingsCredit, 98055, "John Smith"},
+		{ach.CheckingDebit, 2500, "Jane Doe"},
+	}
+	for i := range expected {
+		ed := entries[i]
+		if ed.TransactionCode != expected[i].code || ed.Amount != expected[i].amount || ed.IndividualName != expected[i].name {
+			t.Errorf("entry %d: TransactionCode=%d Amount=%d IndividualName=%q", i, ed.TransactionCode, ed.Amount, ed.IndividualName)
+		}
+		if len(ed.Addenda05) != 1 || ed.AddendaRecordIndicator != 1 {
+			t.Errorf("entry %d: expected Addenda05", i)
+		}
+	}
+	if entries[2].Addenda05[0].PaymentRelatedInformation != "Parking" {
+		t.Errorf("PaymentRelatedInformation=%q", entries[2].Addenda05[0].PaymentRelatedInformation)
+	}
+}
+
+func TestImport__grouping(t *testing.T) {
+	input := strings.Join([]string{
+		"origin,destination,companyName,companyIdentification,companyEntryDescription,routingNumber,accountNumber,amount,name,sec",
+		"121042882,231380104,Company One,121042882,PAYROLL,231380104,123456,10.00,Jane Doe,PPD",
+		"121042882,231380104,Company Two,121042882,VENDOR,231380104,123456,-10.00,Acme Corp,CCD",
+		"121042882,231380104,Company One,121042882,PAYROLL,231380104,654321,20.00,John Doe,PPD",
+		"231380104,121042882,Company One,121042882,PAYROLL,121042882,123456,30.00,Jane Doe,WEB",
+	}, "\n")
+
+	m := DefaultMapping()
+	m.Columns.StandardEntryClassCode = "sec"
+	files, err := Import(strings.NewReader(input), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("got %d files", len(files))
+	}
+	if n := len(files[0].Batches); n != 2 {
+		t.Fatalf("got %d batches", n)
+	}
+	if n := len(files[0].Batches[0].GetEntries()); n != 2 {
+		t.Errorf("got %d entries", n)
+	}
+	if bh := files[0].Batches[1].GetHeader(); bh.StandardEntryClassCode != ach.CCD || bh.ServiceClassCode != ach.DebitsOnly {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+	ed := files[1].Batches[0].GetEntries()[0]
+	if files[1].Header.ImmediateOrigin != "231380104" || ed.PaymentTypeField() != "S" {
+		t.Errorf("unexpected file: %#v", files[1].Header)
+	}
+	if ed.TraceNumber != "231380100000001" {
+		t.Errorf("TraceNumber=%q", ed.TraceNumber)
+	}
+}
+
+func TestImport__rowErrors(t *testing.T) {
+	input := strings.Join([]string{
+		"routing,account,amount,direction,name",
+		"231380104,123456,10.00,credit,Jane Doe",
+		"231380105,123456,10.00,credit,Jane Doe",
+		"231380104,,10.00,credit,Jane Doe",
+		"231380104,123456,ten,credit,Jane Doe",
+		"231380104,123456,10.00,sideways,Jane Doe",
+		"231380104,123456,10.001,debit,Jane Doe",
+		"231380104,123456",
+	}, "\n")
+	m := &Mapping{
+		Origin:                  "121042882",
+		Destination:             "231380104",
+		CompanyName:             "My Company",
+		CompanyIdentification:   "121042882",
+		CompanyEntryDescription: "PAYROLL",
+		Columns: Columns{
+			RoutingNumber: "routing",
+			AccountNumber: "account",
+			Amount:        "amount",
+			Direction:     "direction",
+			Name:          "name",
+		},
+	}
+	files, err := Import(strings.NewReader(input), m)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if len(files) != 1 || len(files[0].Batches[0].GetEntries()) != 1 {
+		t.Errorf("expected one file with the valid row")
+	}
+
+	el, ok := err.(base.ErrorList)
+	if !ok {
+		t.Fatalf("unexpected error: %T %v", err, err)
+	}
+	expected := []struct {
+		line   int
+		column string
+	}{
+		{3, "routing"},
+		{4, "account"},
+		{5, "amount"},
+		{6, "direction"},
+		{7, "amount"},
+		{8, ""},
+	}
+	if len(el) != len(expected) {
+		t.Fatalf("got %d errors: %v", len(el), el)
+	}
+	for i := range expected {
+		pe, ok := el[i].(*base.ParseError)
+		if !ok {
+			t.Fatalf("%d: unexpected error: %T %v", i, el[i], el[i])
+		}
+		if pe.Line != expected[i].line || pe.Record != expected[i].column {
+			t.Errorf("%d: line=%d column=%q: %v", i, pe.Line, pe.Record, pe.Err)
+		}
+	}
+}
+
+func TestImport__CTX(t *testing.T) {
+	input := "routingNumber,accountNumber,amount,name,addenda\n231380104,123456,10.00,Acme Corp,INVOICE 1\n"
+	m := DefaultMapping()
+	m.Origin, m.Destination = "121042882", "231380104"
+	m.CompanyName, m.CompanyIdentification, m.CompanyEntryDescription = "My Company", "121042882", "VENDOR"
+	m.StandardEntryClassCode = "ctx"
+	m.Columns = Columns{RoutingNumber: "routingNumber", AccountNumber: "accountNumber", Amount: "amount", Name: "name", Addenda: "addenda"}
+
+	files, err := Import(strings.NewReader(input), m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed := files[0].Batches[0].GetEntries()[0]
+	if ed.CATXAddendaRecordsField() != "0001" || ed.CATXReceivingCompanyField() != "Acme Corp" {
+		t.Errorf("IndividualName=%q", ed.IndividualName)
+	}
+}
+
+func TestImport__batchErrors(t *testing.T) {
+	// CIE entries must be credits
+	input := "routingNumber,accountNumber,amount,name\n231380104,123456,-10.00,Acme Corp\n"
+	m := DefaultMapping()
+	m.Origin, m.Destination = "121042882", "231380104"
+	m.CompanyName, m.CompanyIdentification, m.CompanyEntryDescription = "My Company", "121042882", "VENDOR"
+	m.StandardEntryClassCode = ach.CIE
+	m.Columns = Columns{RoutingNumber: "routingNumber", AccountNumber: "accountNumber", Amount: "amount", Name: "name"}
+
+	files, err := Import(strings.NewReader(input), m)
+	if len(files) != 0 || err == nil {
+		t.Fatalf("expected error: %v", err)
+	}
+	if !strings.Contains(err.Error(), "file from 121042882 to 231380104") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestImport__missingColumn(t *testing.T) {
+	_, err := Import(strings.NewReader("origin,destination\n"), DefaultMapping())
+	if err == nil || !strings.Contains(err.Error(), `column "companyName" not found`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	cases := []struct {
+		input, format string
+		expected      int
+	}{
+		{"12.34", "", 1234},
+		{"$1,234.5", AmountDollars, 123450},
+		{"-0.07", "", -7},
+		{"(10.00)", "", -1000},
+		{"100", "", 10000},
+		{"1234", AmountCents, 1234},
+		{"-55", "CENTS", -55},
+	}
+	for i := range cases {
+		n, err := parseAmount(cases[i].input, cases[i].format)
+		if err != nil {
+			t.Fatalf("%s: %v", cases[i].input, err)
+		}
+		if n != cases[i].expected {
+			t.Errorf("%s: got %d", cases[i].input, n)
+		}
+	}
+	for _, input := range []string{"", "abc", "1.234", "12.5c"} {
+		if _, err := parseAmount(input, ""); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+	if _, err := parseAmount("12.50", AmountCents); err == nil {
+		t.Error("expected error")
+	}
+}
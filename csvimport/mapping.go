@@ -0,0 +1,189 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package csvimport
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/moov-io/ach"
+)
+
+const (
+	// AmountDollars reads amounts as decimal dollars (e.g. 1,234.56)
+	AmountDollars = "dollars"
+	// AmountCents reads amounts as a whole number of cents (e.g. 123456)
+	AmountCents = "cents"
+)
+
+// Mapping describes how columns of a CSV file are converted into ACH entries.
+//
+// File and batch values (Origin, CompanyName, etc) are used for every row unless a
+// column for them is mapped, in which case each row's value is used instead. Rows
+// are grouped into Files by origin and destination and into batches by SEC code,
+// company and effective entry date.
+type Mapping struct {
+	Origin          string `json:"origin"`
+	OriginName      string `json:"originName"`
+	Destination     string `json:"destination"`
+	DestinationName string `json:"destinationName"`
+
+	// ODFIIdentification defaults to the first eight digits of Origin
+	ODFIIdentification string `json:"ODFIIdentification"`
+
+	// StandardEntryClassCode defaults to PPD
+	StandardEntryClassCode   string `json:"standardEntryClassCode"`
+	CompanyName              string `json:"companyName"`
+	CompanyIdentification    string `json:"companyIdentification"`
+	CompanyEntryDescription  string `json:"companyEntryDescription"`
+	CompanyDiscretionaryData string `json:"companyDiscretionaryData"`
+
+	// EffectiveEntryDate is formatted with DateFormat and defaults to the next day
+	EffectiveEntryDate string `json:"effectiveEntryDate"`
+
+	// DateFormat is a Go time layout used to read dates, defaults to 2006-01-02
+	DateFormat string `json:"dateFormat"`
+
+	// AmountFormat is either "dollars" (the default) or "cents"
+	AmountFormat string `json:"amountFormat"`
+
+	// Delimiter separates fields in each row and defaults to a comma
+	Delimiter string `json:"delimiter"`
+
+	// Columns maps entry fields to column names in the CSV header
+	Columns Columns `json:"columns"`
+}
+
+// Columns are names of columns from the header row of a CSV file. Empty names are not read.
+type Columns struct {
+	Origin      string `json:"origin"`
+	Destination string `json:"destination"`
+
+	StandardEntryClassCode  string `json:"standardEntryClassCode"`
+	CompanyName             string `json:"companyName"`
+	CompanyIdentification   string `json:"companyIdentification"`
+	CompanyEntryDescription string `json:"companyEntryDescription"`
+	EffectiveEntryDate      string `json:"effectiveEntryDate"`
+
+	RoutingNumber string `json:"routingNumber"`
+	AccountNumber string `json:"accountNumber"`
+
+	// AccountType values are checking (the default) or savings
+	AccountType string `json:"accountType"`
+
+	// Amount values which are negative are debits unless Direction is mapped
+	Amount string `json:"amount"`
+
+	// Direction values are credit (the default) or debit
+	Direction string `json:"direction"`
+
+	// TransactionCode overrides AccountType and Direction when mapped
+	TransactionCode string `json:"transactionCode"`
+
+	Name                 string `json:"name"`
+	IdentificationNumber string `json:"identificationNumber"`
+	DiscretionaryData    string `json:"discretionaryData"`
+
+	// Addenda is written as the PaymentRelatedInformation of an Addenda05 record
+	Addenda string `json:"addenda"`
+}
+
+// DefaultMapping reads every file, batch and entry value from columns named after their fields
+// (e.g. origin, companyName, routingNumber and amount).
+func DefaultMapping() *Mapping {
+	return &Mapping{
+		Columns: Columns{
+			Origin:                  "origin",
+			Destination:             "destination",
+			CompanyName:             "companyName",
+			CompanyIdentification:   "companyIdentification",
+			CompanyEntryDescription: "companyEntryDescription",
+			RoutingNumber:           "routingNumber",
+			AccountNumber:           "accountNumber",
+			Amount:                  "amount",
+			Name:                    "name",
+		},
+	}
+}
+
+// ReadMapping decodes a JSON Mapping from r.
+func ReadMapping(r io.Reader) (*Mapping, error) {
+	var m Mapping
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("problem reading mapping: %v", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// ReadMappingFile decodes a JSON Mapping from the file at path.
+func ReadMappingFile(path string) (*Mapping, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+	return ReadMapping(fd)
+}
+
+// Validate checks that every required value has a constant or column.
+func (m *Mapping) Validate() error {
+	if m == nil {
+		return errors.New("nil Mapping")
+	}
+	required := []struct {
+		name, value, column string
+	}{
+		{"origin", m.Origin, m.Columns.Origin},
+		{"destination", m.Destination, m.Columns.Destination},
+		{"companyName", m.CompanyName, m.Columns.CompanyName},
+		{"companyIdentification", m.CompanyIdentification, m.Columns.CompanyIdentification},
+		{"companyEntryDescription", m.CompanyEntryDescription, m.Columns.CompanyEntryDescription},
+		{"routingNumber", "", m.Columns.RoutingNumber},
+		{"accountNumber", "", m.Columns.AccountNumber},
+		{"amount", "", m.Columns.Amount},
+		{"name", "", m.Columns.Name},
+	}
+	for i := range required {
+		if required[i].value == "" && required[i].column == "" {
+			return fmt.Errorf("mapping: missing %s", required[i].name)
+		}
+	}
+	switch strings.ToLower(m.AmountFormat) {
+	case "", AmountDollars, AmountCents:
+	default:
+		return fmt.Errorf("mapping: unknown amountFormat %q", m.AmountFormat)
+	}
+	if len([]rune(m.Delimiter)) > 1 {
+		return fmt.Errorf("mapping: delimiter %q must be one character", m.Delimiter)
+	}
+	if sec := m.StandardEntryClassCode; sec != "" {
+		if _, err := ach.NewBatch(&ach.BatchHeader{StandardEntryClassCode: strings.ToUpper(sec)}); err != nil {
+			return fmt.Errorf("mapping: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+package csvimport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapping__Read(t *testing.T) {
+	m, err := ReadMapping(strings.NewReader(`{"origin": "121042882", "destination": "231380104", "columns": {"companyName": "company", "companyIdentification": "id", "companyEntryDescription": "desc", "routingNumber": "rtn", "accountNumber": "acct", "amount": "amt", "name": "name"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Origin != "121042882" || m.Columns.RoutingNumber != "rtn" {
+		t.Errorf("unexpected Mapping: %#v", m)
+	}
+
+	if _, err := ReadMapping(strings.NewReader(`{"other": "field"}`)); err == nil {
+		t.Error("expected error on unknown field")
+	}
+	if _, err := ReadMapping(strings.NewReader(`{"origin": "121042882"}`)); err == nil {
+		t.Error("expected error on missing values")
+	}
+}
+
+func TestMapping__Validate(t *testing.T) {
+	if err := DefaultMapping().Validate(); err != nil {
+		t.Fatal(err)
+	}
+	var m *Mapping
+	if err := m.Validate(); err == nil {
+		t.Error("expected error")
+	}
+
+	m = DefaultMapping()
+	m.AmountFormat = "euros"
+	if err := m.Validate(); err == nil {
+		t.Error("expected error")
+	}
+
+	m = DefaultMapping()
+	m.Delimiter = "::"
+	if err := m.Validate(); err == nil {
+		t.Error("expected error")
+	}
+
+	m = DefaultMapping()
+	m.StandardEntryClassCode = "ZZZ"
+	if err := m.Validate(); err == nil {
+		t.Error("expected error")
+	}
+
+	m = DefaultMapping()
+	m.Columns.RoutingNumber = ""
+	if err := m.Validate(); err == nil || !strings.Contains(err.Error(), "routingNumber") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
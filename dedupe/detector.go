@@ -0,0 +1,267 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package dedupe detects ACH files and entries which have already been seen.
+//
+// Files are indexed by their ImmediateOrigin, FileCreationDate and FileIDModifier
+// which NACHA uses to uniquely identify a file from an originator. Entries can also be
+// indexed by TraceNumber and by the combination of account number, amount and effective
+// date (see Options).
+//
+// A Detector records what it has seen in a Store. Stores are pluggable, with in-memory
+// and on-disk implementations provided.
+package dedupe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/ach"
+)
+
+// Kinds of duplicates a Detector can find.
+const (
+	// KindFile is a file whose ImmediateOrigin, FileCreationDate and FileIDModifier have been seen
+	KindFile = "file"
+	// KindTraceNumber is an entry whose TraceNumber has been seen
+	KindTraceNumber = "traceNumber"
+	// KindEntry is an entry whose account number, amount and effective date have been seen
+	KindEntry = "entry"
+)
+
+// Duplicate describes a file or entry which has been seen before.
+type Duplicate struct {
+	// Kind is one of KindFile, KindTraceNumber or KindEntry
+	Kind string `json:"kind"`
+	// Key is the index value which matched
+	Key string `json:"key"`
+	// FileID is the ID of the file this key was first recorded with
+	FileID string `json:"fileID"`
+	// TraceNumber is the TraceNumber of the matching entry, empty for KindFile
+	TraceNumber string `json:"traceNumber,omitempty"`
+}
+
+// DuplicateError is returned when a file contains duplicates of previously recorded files or entries.
+type DuplicateError struct {
+	Duplicates []Duplicate
+}
+
+func (e *DuplicateError) Error() string {
+	if len(e.Duplicates) == 0 {
+		return "duplicate file"
+	}
+	d := e.Duplicates[0]
+	msg := fmt.Sprintf("duplicate %s %s previously seen in file %s", d.Kind, d.Key, d.FileID)
+	if n := len(e.Duplicates) - 1; n > 0 {
+		msg += fmt.Sprintf(" (and %d more)", n)
+	}
+	return msg
+}
+
+// Options controls which indexes a Detector checks. Only files are checked by default, as
+// entries legitimately repeat: TraceNumbers restart in each file and recurring payments (such
+// as payroll) send the same amount to the same account. Recorded entries never expire.
+type Options struct {
+	// SkipFiles disables matching on ImmediateOrigin, FileCreationDate and FileIDModifier
+	SkipFiles bool `json:"skipFiles"`
+	// TraceNumbers enables matching entries on their TraceNumber
+	TraceNumbers bool `json:"traceNumbers"`
+	// Entries enables matching entries on account number, amount and effective date
+	Entries bool `json:"entries"`
+}
+
+// Detector checks ACH files against those it has previously recorded.
+type Detector struct {
+	mu        *sync.Mutex
+	store     Store
+	opts      Options
+	namespace string
+}
+
+// NewDetector returns a Detector backed by store. A nil opts only checks files.
+func NewDetector(store Store, opts *Options) *Detector {
+	d := &Detector{mu: new(sync.Mutex), store: store}
+	if opts != nil {
+		d.opts = *opts
+	}
+	return d
+}
+
+// Namespace returns a Detector sharing the store of d whose files are only checked against
+// others recorded in the same namespace, such as those of one customer.
+func (d *Detector) Namespace(namespace string) *Detector {
+	return &Detector{
+		mu:        d.mu,
+		store:     d.store,
+		opts:      d.opts,
+		namespace: d.namespace + namespace + ":",
+	}
+}
+
+// Check returns the Duplicates found in file without recording it.
+func (d *Detector) Check(file *ach.File) ([]Duplicate, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.check(file)
+}
+
+// Record saves every index of file so later files can be checked against it.
+func (d *Detector) Record(file *ach.File) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.record(file)
+}
+
+// CheckAndRecord checks file for duplicates and records it when none are found.
+// A *DuplicateError is returned when duplicates are found.
+//
+// The check and record happen atomically so concurrent submissions of the same file
+// only have one accepted.
+func (d *Detector) CheckAndRecord(file *ach.File) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dups, err := d.check(file)
+	if err != nil {
+		return err
+	}
+	if len(dups) > 0 {
+		return &DuplicateError{Duplicates: dups}
+	}
+	return d.record(file)
+}
+
+// CheckAndStore checks file for duplicates and, when none are found, calls store and records
+// file once store succeeds. A *DuplicateError is returned when duplicates are found, otherwise
+// the error from store.
+//
+// Like CheckAndRecord this happens atomically, and a file which fails to be stored isn't
+// recorded so it can be submitted again.
+func (d *Detector) CheckAndStore(file *ach.File, store func(*ach.File) error) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	dups, err := d.check(file)
+	if err != nil {
+		return err
+	}
+	if len(dups) > 0 {
+		return &DuplicateError{Duplicates: dups}
+	}
+	if err := store(file); err != nil {
+		return err
+	}
+	return d.record(file)
+}
+
+func (d *Detector) check(file *ach.File) ([]Duplicate, error) {
+	if file == nil {
+		return nil, nil
+	}
+	var out []Duplicate
+	for _, k := range d.keys(file) {
+		fileID, found, err := d.store.Lookup(d.namespace + k.key)
+		if err != nil {
+			return nil, fmt.Errorf("dedupe: lookup of %s failed: %v", k.key, err)
+		}
+		if found {
+			out = append(out, Duplicate{
+				Kind:        k.kind,
+				Key:         k.key,
+				FileID:      fileID,
+				TraceNumber: k.traceNumber,
+			})
+		}
+	}
+	return out, nil
+}
+
+func (d *Detector) record(file *ach.File) error {
+	if file == nil {
+		return nil
+	}
+	for _, k := range d.keys(file) {
+		if err := d.store.Save(d.namespace+k.key, file.ID); err != nil {
+			return fmt.Errorf("dedupe: saving %s failed: %v", k.key, err)
+		}
+	}
+	return nil
+}
+
+type key struct {
+	kind        string
+	key         string
+	traceNumber string
+}
+
+// keys returns every index value of file which is enabled in the Detector's Options.
+func (d *Detector) keys(file *ach.File) []key {
+	var out []key
+	if !d.opts.SkipFiles {
+		out = append(out, key{kind: KindFile, key: FileKey(file.Header)})
+	}
+	addEntry := func(traceNumber, account string, amount int, effectiveDate string) {
+		if d.opts.TraceNumbers && traceNumber != "" {
+			out = append(out, key{kind: KindTraceNumber, key: TraceNumberKey(traceNumber), traceNumber: traceNumber})
+		}
+		if d.opts.Entries {
+			out = append(out, key{kind: KindEntry, key: EntryKey(account, amount, effectiveDate), traceNumber: traceNumber})
+		}
+	}
+	for _, b := range file.Batches {
+		bh := b.GetHeader()
+		for _, ed := range b.GetEntries() {
+			addEntry(ed.TraceNumber, ed.DFIAccountNumber, ed.Amount, bh.EffectiveEntryDate)
+		}
+	}
+	for _, b := range file.IATBatches {
+		bh := b.GetHeader()
+		for _, ed := range b.GetEntries() {
+			addEntry(ed.TraceNumber, ed.DFIAccountNumber, ed.Amount, bh.EffectiveEntryDate)
+		}
+	}
+	return out
+}
+
+// FileKey returns the index value of a file based on its ImmediateOrigin, FileCreationDate and FileIDModifier.
+func FileKey(fh ach.FileHeader) string {
+	return strings.Join([]string{
+		KindFile,
+		strings.TrimSpace(fh.ImmediateOrigin),
+		strings.TrimSpace(fh.FileCreationDate),
+		strings.ToUpper(strings.TrimSpace(fh.FileIDModifier)),
+	}, ":")
+}
+
+// TraceNumberKey returns the index value of an entry based on its TraceNumber.
+func TraceNumberKey(traceNumber string) string {
+	return KindTraceNumber + ":" + strings.TrimSpace(traceNumber)
+}
+
+// EntryKey returns the index value of an entry based on its account number, amount and effective date.
+func EntryKey(account string, amount int, effectiveDate string) string {
+	return strings.Join([]string{
+		KindEntry,
+		strings.TrimSpace(account),
+		strconv.Itoa(amount),
+		strings.TrimSpace(effectiveDate),
+	}, ":")
+}
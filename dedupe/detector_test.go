@@ -0,0 +1,238 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func readFile(t *testing.T, name string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &file
+}
+
+// allKeys checks every index
+var allKeys = &Options{TraceNumbers: true, Entries: true}
+
+func TestDetector(t *testing.T) {
+	det := NewDetector(NewStoreInMemory(), allKeys)
+
+	file := readFile(t, "ppd-debit.ach")
+	file.ID = "first"
+
+	dups, err := det.Check(file)
+	if err != nil || len(dups) != 0 {
+		t.Fatalf("dups=%#v error=%v", dups, err)
+	}
+	if err := det.CheckAndRecord(file); err != nil {
+		t.Fatal(err)
+	}
+
+	// read the same file again
+	again := readFile(t, "ppd-debit.ach")
+	again.ID = "second"
+	dups, err = det.Check(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dups) != 3 { // file, trace number and entry
+		t.Fatalf("got %d duplicates: %#v", len(dups), dups)
+	}
+	for i := range dups {
+		if dups[i].FileID != "first" {
+			t.Errorf("unexpected FileID: %#v", dups[i])
+		}
+	}
+	if dups[0].Kind != KindFile || dups[1].Kind != KindTraceNumber || dups[2].Kind != KindEntry {
+		t.Errorf("unexpected kinds: %#v", dups)
+	}
+
+	err = det.CheckAndRecord(again)
+	if e, ok := err.(*DuplicateError); !ok || len(e.Duplicates) != 3 {
+		t.Fatalf("unexpected error: %T %v", err, err)
+	}
+	if err.Error() == "" {
+		t.Error("empty error message")
+	}
+}
+
+func TestDetector__CheckAndStore(t *testing.T) {
+	det := NewDetector(NewStoreInMemory(), nil)
+
+	// files which fail to be stored aren't recorded
+	file := readFile(t, "ppd-debit.ach")
+	file.ID = "first"
+	failure := errors.New("disk full")
+	if err := det.CheckAndStore(file, func(*ach.File) error { return failure }); err != failure {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dups, err := det.Check(file); err != nil || len(dups) != 0 {
+		t.Fatalf("dups=%#v error=%v", dups, err)
+	}
+
+	stored := 0
+	store := func(*ach.File) error {
+		stored++
+		return nil
+	}
+	if err := det.CheckAndStore(file, store); err != nil {
+		t.Fatal(err)
+	}
+	again := readFile(t, "ppd-debit.ach")
+	again.ID = "second"
+	if err := det.CheckAndStore(again, store); err == nil {
+		t.Error("expected duplicate")
+	}
+	if stored != 1 {
+		t.Errorf("stored %d files", stored)
+	}
+}
+
+func TestDetector__Namespace(t *testing.T) {
+	det := NewDetector(NewStoreInMemory(), nil)
+	acme, other := det.Namespace("acme"), det.Namespace("other")
+
+	file := readFile(t, "ppd-debit.ach")
+	file.ID = "acme-file"
+	if err := acme.CheckAndRecord(file); err != nil {
+		t.Fatal(err)
+	}
+
+	// other namespaces (and the Detector itself) haven't seen the file
+	for _, d := range []*Detector{other, det} {
+		if dups, err := d.Check(file); err != nil || len(dups) != 0 {
+			t.Errorf("dups=%#v error=%v", dups, err)
+		}
+	}
+	dups, err := det.Namespace("acme").Check(file)
+	if err != nil || len(dups) == 0 {
+		t.Fatalf("dups=%#v error=%v", dups, err)
+	}
+	if d := dups[0]; d.FileID != "acme-file" || d.Key != FileKey(file.Header) {
+		t.Errorf("unexpected duplicate: %#v", d)
+	}
+}
+
+func TestDetector__modifiedFile(t *testing.T) {
+	det := NewDetector(NewStoreInMemory(), allKeys)
+
+	file := readFile(t, "ppd-debit.ach")
+	if err := det.Record(file); err != nil {
+		t.Fatal(err)
+	}
+
+	// a new FileIDModifier and TraceNumber leaves only the entry matching
+	other := readFile(t, "ppd-debit.ach")
+	other.Header.FileIDModifier = "B"
+	other.Batches[0].GetEntries()[0].TraceNumber = "121042880000099"
+	dups, err := det.Check(other)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dups) != 1 || dups[0].Kind != KindEntry {
+		t.Errorf("unexpected duplicates: %#v", dups)
+	}
+
+	// a different amount means no duplicates
+	other.Batches[0].GetEntries()[0].Amount++
+	dups, err = det.Check(other)
+	if err != nil || len(dups) != 0 {
+		t.Errorf("dups=%#v error=%v", dups, err)
+	}
+}
+
+func TestDetector__options(t *testing.T) {
+	// only files are checked by default
+	det := NewDetector(NewStoreInMemory(), nil)
+
+	file := readFile(t, "ppd-debit.ach")
+	if err := det.Record(file); err != nil {
+		t.Fatal(err)
+	}
+	dups, err := det.Check(readFile(t, "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dups) != 1 || dups[0].Kind != KindFile {
+		t.Errorf("unexpected duplicates: %#v", dups)
+	}
+
+	// so a new file with the same entries isn't a duplicate
+	other := readFile(t, "ppd-debit.ach")
+	other.Header.FileIDModifier = "B"
+	if dups, err := det.Check(other); err != nil || len(dups) != 0 {
+		t.Errorf("dups=%#v error=%v", dups, err)
+	}
+
+	// unless entries are checked
+	det = NewDetector(NewStoreInMemory(), &Options{SkipFiles: true, TraceNumbers: true})
+	if err := det.Record(file); err != nil {
+		t.Fatal(err)
+	}
+	if dups, err := det.Check(other); err != nil || len(dups) != 1 || dups[0].Kind != KindTraceNumber {
+		t.Errorf("dups=%#v error=%v", dups, err)
+	}
+}
+
+func TestDetector__IAT(t *testing.T) {
+	det := NewDetector(NewStoreInMemory(), &Options{SkipFiles: true, Entries: true})
+
+	file := readFile(t, "iat-mixedDebitCredit.ach")
+	if err := det.Record(file); err != nil {
+		t.Fatal(err)
+	}
+	dups, err := det.Check(readFile(t, "iat-mixedDebitCredit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dups) == 0 {
+		t.Error("expected IAT entries to be duplicates")
+	}
+}
+
+func TestKeys(t *testing.T) {
+	fh := ach.NewFileHeader()
+	fh.ImmediateOrigin = " 121042882"
+	fh.FileCreationDate = "190816"
+	fh.FileIDModifier = "a"
+	if v := FileKey(fh); v != "file:121042882:190816:A" {
+		t.Errorf("FileKey=%q", v)
+	}
+	if v := TraceNumberKey("121042880000001 "); v != "traceNumber:121042880000001" {
+		t.Errorf("TraceNumberKey=%q", v)
+	}
+	if v := EntryKey("12345 ", 100, "190816"); v != "entry:12345:100:190816" {
+		t.Errorf("EntryKey=%q", v)
+	}
+}
@@ -0,0 +1,132 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Store persists the index values a Detector has seen along with the ID of the
+// file they were first seen in.
+type Store interface {
+	// Lookup returns the file ID recorded for key and if key has been seen.
+	Lookup(key string) (fileID string, found bool, err error)
+	// Save records key as seen in fileID. Saving an existing key keeps the original file ID.
+	Save(key string, fileID string) error
+}
+
+type storeInMemory struct {
+	mu   sync.RWMutex
+	keys map[string]string
+}
+
+// NewStoreInMemory returns a Store which keeps index values in memory.
+func NewStoreInMemory() Store {
+	return &storeInMemory{
+		keys: make(map[string]string),
+	}
+}
+
+func (s *storeInMemory) Lookup(key string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	fileID, found := s.keys[key]
+	return fileID, found, nil
+}
+
+func (s *storeInMemory) Save(key string, fileID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.keys[key]; !exists {
+		s.keys[key] = fileID
+	}
+	return nil
+}
+
+// StoreOnDisk keeps every key in memory and appends new keys to a file so they
+// survive restarts. Each line of the file is a key and file ID separated by a tab.
+type StoreOnDisk struct {
+	mem *storeInMemory
+
+	mu sync.Mutex
+	fd *os.File
+}
+
+// NewStoreOnDisk returns a Store which appends index values to the file at path. Existing
+// values in path are loaded, so a Detector keeps its history across restarts.
+//
+// Callers should Close the returned store when finished.
+func NewStoreOnDisk(path string) (*StoreOnDisk, error) {
+	if path == "" {
+		return nil, errors.New("dedupe: empty path")
+	}
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("dedupe: opening %s: %v", path, err)
+	}
+	mem := &storeInMemory{keys: make(map[string]string)}
+	scanner := bufio.NewScanner(fd)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "\t", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			continue // skip partially written lines
+		}
+		mem.Save(parts[0], parts[1])
+	}
+	if err := scanner.Err(); err != nil {
+		fd.Close()
+		return nil, fmt.Errorf("dedupe: reading %s: %v", path, err)
+	}
+	return &StoreOnDisk{mem: mem, fd: fd}, nil
+}
+
+func (s *StoreOnDisk) Lookup(key string) (string, bool, error) {
+	return s.mem.Lookup(key)
+}
+
+func (s *StoreOnDisk) Save(key string, fileID string) error {
+	if strings.ContainsAny(key, "\t\n") || strings.ContainsAny(fileID, "\t\n") {
+		return fmt.Errorf("dedupe: invalid characters in key %q", key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found, _ := s.mem.Lookup(key); found {
+		return nil
+	}
+	if _, err := fmt.Fprintf(s.fd, "%s\t%s\n", key, fileID); err != nil {
+		return err
+	}
+	if err := s.fd.Sync(); err != nil {
+		return err
+	}
+	return s.mem.Save(key, fileID)
+}
+
+// Close releases the underlying file.
+func (s *StoreOnDisk) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fd.Close()
+}
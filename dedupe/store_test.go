@@ -0,0 +1,84 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dedupe
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testStore(t *testing.T, store Store) {
+	t.Helper()
+
+	if id, found, err := store.Lookup("key"); id != "" || found || err != nil {
+		t.Fatalf("id=%q found=%v error=%v", id, found, err)
+	}
+	if err := store.Save("key", "first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Save("key", "second"); err != nil {
+		t.Fatal(err)
+	}
+	if id, found, err := store.Lookup("key"); id != "first" || !found || err != nil {
+		t.Fatalf("id=%q found=%v error=%v", id, found, err)
+	}
+}
+
+func TestStoreInMemory(t *testing.T) {
+	testStore(t, NewStoreInMemory())
+}
+
+func TestStoreOnDisk(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dedupe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "keys")
+	store, err := NewStoreOnDisk(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, store)
+	if err := store.Save("bad\tkey", "id"); err == nil {
+		t.Error("expected error")
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// re-open and find our key again
+	store, err = NewStoreOnDisk(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	if id, found, err := store.Lookup("key"); id != "first" || !found || err != nil {
+		t.Fatalf("id=%q found=%v error=%v", id, found, err)
+	}
+
+	if _, err := NewStoreOnDisk(""); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := NewStoreOnDisk(filepath.Join(dir, "missing", "keys")); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -30,26 +30,26 @@ import (
 // institution, the account number (left justify,no zero fill), name, and dollar amount.
 type EntryDetail struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. 6
 	recordType string
 	// TransactionCode if the receivers account is checking, savings, general ledger (GL) or loan.
-	TransactionCode int `json:"transactionCode"`
+	TransactionCode int `json:"transactionCode" xml:"transactionCode"`
 	// RDFIIdentification is the RDFI's routing number without the last digit.
 	// Receiving Depository Financial Institution
-	RDFIIdentification string `json:"RDFIIdentification"`
+	RDFIIdentification string `json:"RDFIIdentification" xml:"RDFIIdentification"`
 	// CheckDigit the last digit of the RDFI's routing number
-	CheckDigit string `json:"checkDigit"`
+	CheckDigit string `json:"checkDigit" xml:"checkDigit"`
 	// DFIAccountNumber is the receiver's bank account number you are crediting/debiting.
 	// It important to note that this is an alphanumeric field, so its space padded, no zero padded
-	DFIAccountNumber string `json:"DFIAccountNumber"`
+	DFIAccountNumber string `json:"DFIAccountNumber" xml:"DFIAccountNumber"`
 	// Amount Number of cents you are debiting/crediting this account
-	Amount int `json:"amount"`
+	Amount int `json:"amount" xml:"amount"`
 	// IdentificationNumber an internal identification (alphanumeric) that
 	// you use to uniquely identify this Entry Detail Record
-	IdentificationNumber string `json:"identificationNumber,omitempty"`
+	IdentificationNumber string `json:"identificationNumber,omitempty" xml:"identificationNumber,omitempty"`
 	// IndividualName The name of the receiver, usually the name on the bank account
-	IndividualName string `json:"individualName"`
+	IndividualName string `json:"individualName" xml:"individualName"`
 	// DiscretionaryData allows ODFIs to include codes, of significance only to them,
 	// to enable specialized handling of the entry. There will be no
 	// standardized interpretation for the value of this field. It can either
@@ -58,11 +58,11 @@ type EntryDetail struct {
 	// field must be returned intact for any returned entry.
 	//
 	// WEB and TEL batches use the Discretionary Data Field as the Payment Type Code
-	DiscretionaryData string `json:"discretionaryData,omitempty"`
+	DiscretionaryData string `json:"discretionaryData,omitempty" xml:"discretionaryData,omitempty"`
 	// AddendaRecordIndicator indicates the existence of an Addenda Record.
 	// A value of "1" indicates that one ore more addenda records follow,
 	// and "0" means no such record is present.
-	AddendaRecordIndicator int `json:"addendaRecordIndicator,omitempty"`
+	AddendaRecordIndicator int `json:"addendaRecordIndicator,omitempty" xml:"addendaRecordIndicator,omitempty"`
 	// TraceNumber assigned by the ODFI in ascending sequence, is included in each
 	// Entry Detail Record, Corporate Entry Detail Record, and addenda Record.
 	// Trace Numbers uniquely identify each entry within a batch in an ACH input file.
@@ -73,17 +73,17 @@ type EntryDetail struct {
 	// with an entry or item rather than a physical record.
 	//
 	// Use TraceNumberField() for a properly formatted string representation.
-	TraceNumber string `json:"traceNumber,omitempty"`
+	TraceNumber string `json:"traceNumber,omitempty" xml:"traceNumber,omitempty"`
 	// Addenda02 for use with StandardEntryClassCode MTE, POS, and SHR
-	Addenda02 *Addenda02 `json:"addenda02,omitempty"`
+	Addenda02 *Addenda02 `json:"addenda02,omitempty" xml:"addenda02,omitempty"`
 	// Addenda05 for use with StandardEntryClassCode: ACK, ATX, CCD, CIE, CTX, DNE, ENR, WEB, PPD, TRX.
-	Addenda05 []*Addenda05 `json:"addenda05,omitempty"`
+	Addenda05 []*Addenda05 `json:"addenda05,omitempty" xml:"addenda05,omitempty"`
 	// Addenda98 for user with NOC
-	Addenda98 *Addenda98 `json:"addenda98,omitempty"`
+	Addenda98 *Addenda98 `json:"addenda98,omitempty" xml:"addenda98,omitempty"`
 	// Addenda99 for use with Returns
-	Addenda99 *Addenda99 `json:"addenda99,omitempty"`
+	Addenda99 *Addenda99 `json:"addenda99,omitempty" xml:"addenda99,omitempty"`
 	// Category defines if the entry is a Forward, Return, or NOC
-	Category string `json:"category,omitempty"`
+	Category string `json:"category,omitempty" xml:"category,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to golang Converters
@@ -0,0 +1,99 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/moov-io/ach"
+)
+
+// columns are written in order as CSV, their names match the JSON fields of Row.
+var columns = []struct {
+	name  string
+	value func(r *Row) string
+}{
+	{"fileID", func(r *Row) string { return r.FileID }},
+	{"immediateOrigin", func(r *Row) string { return r.ImmediateOrigin }},
+	{"immediateOriginName", func(r *Row) string { return r.ImmediateOriginName }},
+	{"immediateDestination", func(r *Row) string { return r.ImmediateDestination }},
+	{"immediateDestinationName", func(r *Row) string { return r.ImmediateDestinationName }},
+	{"fileCreationDate", func(r *Row) string { return r.FileCreationDate }},
+	{"fileCreationTime", func(r *Row) string { return r.FileCreationTime }},
+	{"fileIDModifier", func(r *Row) string { return r.FileIDModifier }},
+	{"batchNumber", func(r *Row) string { return strconv.Itoa(r.BatchNumber) }},
+	{"serviceClassCode", func(r *Row) string { return strconv.Itoa(r.ServiceClassCode) }},
+	{"standardEntryClassCode", func(r *Row) string { return r.StandardEntryClassCode }},
+	{"companyName", func(r *Row) string { return r.CompanyName }},
+	{"companyIdentification", func(r *Row) string { return r.CompanyIdentification }},
+	{"companyEntryDescription", func(r *Row) string { return r.CompanyEntryDescription }},
+	{"companyDescriptiveDate", func(r *Row) string { return r.CompanyDescriptiveDate }},
+	{"effectiveEntryDate", func(r *Row) string { return r.EffectiveEntryDate }},
+	{"ODFIIdentification", func(r *Row) string { return r.ODFIIdentification }},
+	{"category", func(r *Row) string { return r.Category }},
+	{"transactionCode", func(r *Row) string { return strconv.Itoa(r.TransactionCode) }},
+	{"creditOrDebit", func(r *Row) string { return r.CreditOrDebit }},
+	{"RDFIIdentification", func(r *Row) string { return r.RDFIIdentification }},
+	{"checkDigit", func(r *Row) string { return r.CheckDigit }},
+	{"DFIAccountNumber", func(r *Row) string { return r.DFIAccountNumber }},
+	{"amount", func(r *Row) string { return strconv.Itoa(r.Amount) }},
+	{"identificationNumber", func(r *Row) string { return r.IdentificationNumber }},
+	{"individualName", func(r *Row) string { return r.IndividualName }},
+	{"discretionaryData", func(r *Row) string { return r.DiscretionaryData }},
+	{"addendaRecordIndicator", func(r *Row) string { return strconv.Itoa(r.AddendaRecordIndicator) }},
+	{"traceNumber", func(r *Row) string { return r.TraceNumber }},
+	{"paymentRelatedInformation", func(r *Row) string { return r.PaymentRelatedInformation }},
+	{"returnCode", func(r *Row) string { return r.ReturnCode }},
+	{"changeCode", func(r *Row) string { return r.ChangeCode }},
+	{"correctedData", func(r *Row) string { return r.CorrectedData }},
+	{"originalTrace", func(r *Row) string { return r.OriginalTrace }},
+	{"originalDFI", func(r *Row) string { return r.OriginalDFI }},
+}
+
+// CSVHeader returns the column names written by WriteCSV.
+func CSVHeader() []string {
+	out := make([]string, len(columns))
+	for i := range columns {
+		out[i] = columns[i].name
+	}
+	return out
+}
+
+// WriteCSV writes a header row followed by one row per entry in files.
+func WriteCSV(w io.Writer, files ...*ach.File) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(CSVHeader()); err != nil {
+		return err
+	}
+	record := make([]string, len(columns))
+	for _, file := range files {
+		rows := Rows(file)
+		for i := range rows {
+			for j := range columns {
+				record[j] = columns[j].value(&rows[i])
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
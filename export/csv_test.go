@@ -0,0 +1,93 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCSVHeader(t *testing.T) {
+	// every JSON field of Row is a column
+	typ := reflect.TypeOf(Row{})
+	header := CSVHeader()
+	if typ.NumField() != len(header) {
+		t.Fatalf("Row has %d fields, but %d columns", typ.NumField(), len(header))
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		if tag := typ.Field(i).Tag.Get("json"); tag != header[i] {
+			t.Errorf("column %d: %s != %s", i, header[i], tag)
+		}
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	ppd := readACHFile(t, filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	ret := readACHFile(t, filepath.Join("..", "test", "testdata", "return-WEB.ach"))
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, ppd, ret); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := 1 + len(Rows(ppd)) + len(Rows(ret)); len(records) != n {
+		t.Fatalf("got %d records, expected %d", len(records), n)
+	}
+
+	values := func(record []string) map[string]string {
+		out := make(map[string]string)
+		for i, name := range records[0] {
+			out[name] = record[i]
+		}
+		return out
+	}
+	if v := values(records[1]); v["amount"] != "100000000" || v["traceNumber"] != "121042880000001" || v["creditOrDebit"] != "debit" {
+		t.Errorf("unexpected values: %v", v)
+	}
+	if v := values(records[len(records)-1]); v["returnCode"] != "R03" || v["category"] != "Return" {
+		t.Errorf("unexpected return row: %v", v)
+	}
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	file := readACHFile(t, filepath.Join("..", "test", "testdata", "return-WEB.ach"))
+
+	var buf bytes.Buffer
+	if err := WriteJSONLines(&buf, file); err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != len(Rows(file)) {
+		t.Fatalf("got %d lines", len(lines))
+	}
+	var row Row
+	if err := json.Unmarshal(lines[0], &row); err != nil {
+		t.Fatal(err)
+	}
+	if row.ReturnCode != "R01" || row.Category != "Return" {
+		t.Errorf("unexpected row: %#v", row)
+	}
+}
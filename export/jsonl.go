@@ -0,0 +1,41 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/moov-io/ach"
+)
+
+// WriteJSONLines writes each entry in files as a JSON encoded Row on its own line.
+//
+// See https://jsonlines.org
+func WriteJSONLines(w io.Writer, files ...*ach.File) error {
+	enc := json.NewEncoder(w)
+	for _, file := range files {
+		rows := Rows(file)
+		for i := range rows {
+			if err := enc.Encode(rows[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
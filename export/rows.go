@@ -0,0 +1,256 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package export flattens ACH files into one row per entry for loading into spreadsheets,
+// databases and data warehouses.
+//
+// Each Row includes (denormalized) values from the FileHeader and BatchHeader alongside
+// the entry and its key addenda fields.
+package export
+
+import (
+	"strings"
+
+	"github.com/moov-io/ach"
+)
+
+// Row is a flattened EntryDetail, IATEntryDetail or ADVEntryDetail.
+type Row struct {
+	// File Header
+	FileID                   string `json:"fileID"`
+	ImmediateOrigin          string `json:"immediateOrigin"`
+	ImmediateOriginName      string `json:"immediateOriginName"`
+	ImmediateDestination     string `json:"immediateDestination"`
+	ImmediateDestinationName string `json:"immediateDestinationName"`
+	FileCreationDate         string `json:"fileCreationDate"`
+	FileCreationTime         string `json:"fileCreationTime"`
+	FileIDModifier           string `json:"fileIDModifier"`
+
+	// Batch Header
+	BatchNumber             int    `json:"batchNumber"`
+	ServiceClassCode        int    `json:"serviceClassCode"`
+	StandardEntryClassCode  string `json:"standardEntryClassCode"`
+	CompanyName             string `json:"companyName"`
+	CompanyIdentification   string `json:"companyIdentification"`
+	CompanyEntryDescription string `json:"companyEntryDescription"`
+	CompanyDescriptiveDate  string `json:"companyDescriptiveDate"`
+	EffectiveEntryDate      string `json:"effectiveEntryDate"`
+	ODFIIdentification      string `json:"ODFIIdentification"`
+
+	// Entry
+	Category               string `json:"category"`
+	TransactionCode        int    `json:"transactionCode"`
+	CreditOrDebit          string `json:"creditOrDebit"`
+	RDFIIdentification     string `json:"RDFIIdentification"`
+	CheckDigit             string `json:"checkDigit"`
+	DFIAccountNumber       string `json:"DFIAccountNumber"`
+	Amount                 int    `json:"amount"`
+	IdentificationNumber   string `json:"identificationNumber"`
+	IndividualName         string `json:"individualName"`
+	DiscretionaryData      string `json:"discretionaryData"`
+	AddendaRecordIndicator int    `json:"addendaRecordIndicator"`
+	TraceNumber            string `json:"traceNumber"`
+
+	// Addenda
+	PaymentRelatedInformation string `json:"paymentRelatedInformation"`
+	ReturnCode                string `json:"returnCode"`
+	ChangeCode                string `json:"changeCode"`
+	CorrectedData             string `json:"correctedData"`
+	OriginalTrace             string `json:"originalTrace"`
+	OriginalDFI               string `json:"originalDFI"`
+}
+
+// Rows flattens every entry in file, in the order of its batches.
+func Rows(file *ach.File) []Row {
+	if file == nil {
+		return nil
+	}
+	var rows []Row
+	for _, batch := range file.Batches {
+		bh := batch.GetHeader()
+		if bh == nil {
+			continue
+		}
+		for _, ed := range batch.GetEntries() {
+			rows = append(rows, entryRow(file, bh, ed))
+		}
+		for _, ed := range batch.GetADVEntries() {
+			rows = append(rows, advEntryRow(file, bh, ed))
+		}
+	}
+	for i := range file.IATBatches {
+		bh := file.IATBatches[i].GetHeader()
+		if bh == nil {
+			continue
+		}
+		for _, ed := range file.IATBatches[i].GetEntries() {
+			rows = append(rows, iatEntryRow(file, bh, ed))
+		}
+	}
+	return rows
+}
+
+func fileRow(file *ach.File) Row {
+	fh := file.Header
+	return Row{
+		FileID:                   file.ID,
+		ImmediateOrigin:          fh.ImmediateOrigin,
+		ImmediateOriginName:      fh.ImmediateOriginName,
+		ImmediateDestination:     fh.ImmediateDestination,
+		ImmediateDestinationName: fh.ImmediateDestinationName,
+		FileCreationDate:         fh.FileCreationDate,
+		FileCreationTime:         fh.FileCreationTime,
+		FileIDModifier:           fh.FileIDModifier,
+	}
+}
+
+func batchRow(file *ach.File, bh *ach.BatchHeader) Row {
+	row := fileRow(file)
+	row.BatchNumber = bh.BatchNumber
+	row.ServiceClassCode = bh.ServiceClassCode
+	row.StandardEntryClassCode = bh.StandardEntryClassCode
+	row.CompanyName = strings.TrimSpace(bh.CompanyName)
+	row.CompanyIdentification = strings.TrimSpace(bh.CompanyIdentification)
+	row.CompanyEntryDescription = strings.TrimSpace(bh.CompanyEntryDescription)
+	row.CompanyDescriptiveDate = strings.TrimSpace(bh.CompanyDescriptiveDate)
+	row.EffectiveEntryDate = bh.EffectiveEntryDate
+	row.ODFIIdentification = bh.ODFIIdentification
+	return row
+}
+
+func entryRow(file *ach.File, bh *ach.BatchHeader, ed *ach.EntryDetail) Row {
+	row := batchRow(file, bh)
+	row.Category = category(ed.Category, ed.Addenda98, ed.Addenda99)
+	row.TransactionCode = ed.TransactionCode
+	row.CreditOrDebit = creditOrDebit(ed.CreditOrDebit())
+	row.RDFIIdentification = ed.RDFIIdentification
+	row.CheckDigit = ed.CheckDigit
+	row.DFIAccountNumber = strings.TrimSpace(ed.DFIAccountNumber)
+	row.Amount = ed.Amount
+	row.IdentificationNumber = strings.TrimSpace(ed.IdentificationNumber)
+	row.IndividualName = strings.TrimSpace(ed.IndividualName)
+	switch bh.StandardEntryClassCode {
+	case ach.CTX, ach.ATX, ach.TRX:
+		if len(ed.IndividualName) >= 20 {
+			row.IndividualName = ed.CATXReceivingCompanyField() // skip the count of addenda records
+		}
+	}
+	row.DiscretionaryData = strings.TrimSpace(ed.DiscretionaryData)
+	row.AddendaRecordIndicator = ed.AddendaRecordIndicator
+	row.TraceNumber = ed.TraceNumber
+
+	var info []string
+	for _, a := range ed.Addenda05 {
+		info = append(info, strings.TrimSpace(a.PaymentRelatedInformation))
+	}
+	row.PaymentRelatedInformation = strings.Join(info, " ")
+	addReturnAndChange(&row, ed.Addenda98, ed.Addenda99)
+	return row
+}
+
+func iatEntryRow(file *ach.File, bh *ach.IATBatchHeader, ed *ach.IATEntryDetail) Row {
+	row := fileRow(file)
+	row.BatchNumber = bh.BatchNumber
+	row.ServiceClassCode = bh.ServiceClassCode
+	row.StandardEntryClassCode = bh.StandardEntryClassCode
+	row.CompanyIdentification = strings.TrimSpace(bh.OriginatorIdentification)
+	row.CompanyEntryDescription = strings.TrimSpace(bh.CompanyEntryDescription)
+	row.EffectiveEntryDate = bh.EffectiveEntryDate
+	row.ODFIIdentification = bh.ODFIIdentification
+
+	row.Category = category(ed.Category, ed.Addenda98, ed.Addenda99)
+	row.TransactionCode = ed.TransactionCode
+	row.CreditOrDebit = creditOrDebit((&ach.EntryDetail{TransactionCode: ed.TransactionCode}).CreditOrDebit())
+	row.RDFIIdentification = ed.RDFIIdentification
+	row.CheckDigit = ed.CheckDigit
+	row.DFIAccountNumber = strings.TrimSpace(ed.DFIAccountNumber)
+	row.Amount = ed.Amount
+	row.AddendaRecordIndicator = ed.AddendaRecordIndicator
+	row.TraceNumber = ed.TraceNumber
+	if ed.Addenda10 != nil {
+		row.IndividualName = strings.TrimSpace(ed.Addenda10.Name)
+	}
+	if ed.Addenda11 != nil {
+		row.CompanyName = strings.TrimSpace(ed.Addenda11.OriginatorName)
+	}
+
+	var info []string
+	for _, a := range ed.Addenda17 {
+		info = append(info, strings.TrimSpace(a.PaymentRelatedInformation))
+	}
+	row.PaymentRelatedInformation = strings.Join(info, " ")
+	addReturnAndChange(&row, ed.Addenda98, ed.Addenda99)
+	return row
+}
+
+func advEntryRow(file *ach.File, bh *ach.BatchHeader, ed *ach.ADVEntryDetail) Row {
+	row := batchRow(file, bh)
+	row.Category = category(ed.Category, nil, nil)
+	row.TransactionCode = ed.TransactionCode
+	switch ed.TransactionCode {
+	case ach.CreditForDebitsOriginated, ach.CreditForCreditsReceived, ach.CreditForCreditsRejected, ach.CreditSummary:
+		row.CreditOrDebit = "credit"
+	default:
+		row.CreditOrDebit = "debit"
+	}
+	row.RDFIIdentification = ed.RDFIIdentification
+	row.CheckDigit = ed.CheckDigit
+	row.DFIAccountNumber = strings.TrimSpace(ed.DFIAccountNumber)
+	row.Amount = ed.Amount
+	row.IndividualName = strings.TrimSpace(ed.IndividualName)
+	row.DiscretionaryData = strings.TrimSpace(ed.DiscretionaryData)
+	row.AddendaRecordIndicator = ed.AddendaRecordIndicator
+	return row
+}
+
+// category returns the entry's Category, falling back to what its addenda records imply.
+func category(cat string, addenda98 *ach.Addenda98, addenda99 *ach.Addenda99) string {
+	switch {
+	case cat != "":
+		return cat
+	case addenda99 != nil:
+		return ach.CategoryReturn
+	case addenda98 != nil:
+		return ach.CategoryNOC
+	default:
+		return ach.CategoryForward
+	}
+}
+
+func creditOrDebit(s string) string {
+	switch s {
+	case "C":
+		return "credit"
+	case "D":
+		return "debit"
+	}
+	return ""
+}
+
+func addReturnAndChange(row *Row, addenda98 *ach.Addenda98, addenda99 *ach.Addenda99) {
+	if addenda98 != nil {
+		row.ChangeCode = addenda98.ChangeCode
+		row.CorrectedData = strings.TrimSpace(addenda98.CorrectedData)
+		row.OriginalTrace = addenda98.OriginalTrace
+		row.OriginalDFI = addenda98.OriginalDFI
+	}
+	if addenda99 != nil {
+		row.ReturnCode = addenda99.ReturnCode
+		row.OriginalTrace = addenda99.OriginalTrace
+		row.OriginalDFI = addenda99.OriginalDFI
+	}
+}
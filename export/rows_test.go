@@ -0,0 +1,149 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+
+package export
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func readACHFile(t *testing.T, path string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &file
+}
+
+func TestRows__PPD(t *testing.T) {
+	file := readACHFile(t, filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	rows := Rows(file)
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	row := rows[0]
+	if row.ImmediateOrigin != "121042882" || row.ImmediateDestination != "231380104" || row.ImmediateOriginName != "My Bank Name" {
+		t.Errorf("unexpected file header values: %#v", row)
+	}
+	if row.BatchNumber != 1 || row.StandardEntryClassCode != ach.PPD || row.CompanyName != "Name on Account" {
+		t.Errorf("unexpected batch header values: %#v", row)
+	}
+	if row.Category != ach.CategoryForward || row.CreditOrDebit != "debit" || row.Amount != 100000000 {
+		t.Errorf("unexpected entry values: %#v", row)
+	}
+	if row.TraceNumber != "121042880000001" || row.DFIAccountNumber != "12345678" || row.IndividualName != "Receiver Account Name" {
+		t.Errorf("unexpected entry values: %#v", row)
+	}
+}
+
+func TestRows__Addenda05(t *testing.T) {
+	file, err := ach.NewFileBuilder("121042882", "231380104").
+		AddCTXBatch(ach.Company{Name: "My Company", Identification: "121042882", EntryDescription: "VENDOR"}).
+		Credit(ach.Account{RoutingNumber: "231380104", Number: "12345678"}, 100, "Acme Corp").
+		Addenda("INVOICE 1").
+		Addenda("INVOICE 2").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := Rows(file)
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	if v := rows[0].PaymentRelatedInformation; v != "INVOICE 1 INVOICE 2" {
+		t.Errorf("PaymentRelatedInformation=%q", v)
+	}
+	if rows[0].CreditOrDebit != "credit" || rows[0].AddendaRecordIndicator != 1 || rows[0].IndividualName != "Acme Corp" {
+		t.Errorf("unexpected row: %#v", rows[0])
+	}
+}
+
+func TestRows__Return(t *testing.T) {
+	file := readACHFile(t, filepath.Join("..", "test", "testdata", "return-WEB.ach"))
+	rows := Rows(file)
+	if len(rows) == 0 {
+		t.Fatal("no rows")
+	}
+	row := rows[0]
+	if row.Category != ach.CategoryReturn || row.ReturnCode != "R01" || row.OriginalTrace != "091400600000001" {
+		t.Errorf("unexpected return: %#v", row)
+	}
+	if row.CreditOrDebit != "debit" || row.IndividualName != "Paul Jones" {
+		t.Errorf("unexpected entry: %#v", row)
+	}
+}
+
+func TestRows__NOC(t *testing.T) {
+	file := readACHFile(t, filepath.Join("..", "test", "testdata", "cor-example.ach"))
+	rows := Rows(file)
+	if len(rows) == 0 {
+		t.Fatal("no rows")
+	}
+	row := rows[0]
+	if row.Category != ach.CategoryNOC || row.ChangeCode != "C01" || row.CorrectedData != "1918171614" {
+		t.Errorf("unexpected NOC: %#v", row)
+	}
+	if row.OriginalTrace != "121042880000001" || row.OriginalDFI != "12104288" {
+		t.Errorf("unexpected NOC: %#v", row)
+	}
+}
+
+func TestRows__IAT(t *testing.T) {
+	file := readACHFile(t, filepath.Join("..", "test", "testdata", "iat-debit.ach"))
+	rows := Rows(file)
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows", len(rows))
+	}
+	row := rows[0]
+	if row.StandardEntryClassCode != ach.IAT || row.CompanyName != "BEK Solutions" || row.IndividualName != "BEK Enterprises" {
+		t.Errorf("unexpected IAT: %#v", row)
+	}
+	if row.CreditOrDebit != "debit" || row.Amount != 100000 || row.TraceNumber != "231380100000001" {
+		t.Errorf("unexpected IAT: %#v", row)
+	}
+}
+
+func TestRows__ADV(t *testing.T) {
+	file := readACHFile(t, filepath.Join("..", "test", "ach-adv-read", "adv-read.ach"))
+	rows := Rows(file)
+	if len(rows) == 0 {
+		t.Fatal("no rows")
+	}
+	row := rows[0]
+	if row.StandardEntryClassCode != ach.ADV || row.CreditOrDebit != "credit" || row.Amount != 50000 || row.IndividualName != "Name" {
+		t.Errorf("unexpected ADV: %#v", row)
+	}
+}
+
+func TestRows__nil(t *testing.T) {
+	if rows := Rows(nil); rows != nil {
+		t.Errorf("unexpected rows: %v", rows)
+	}
+}
@@ -0,0 +1,253 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package fedach reads the FedACH participant directory published by the Federal Reserve
+// and checks ACH files against it.
+//
+// The directory is a fixed-width text file (often named FedACHdir.txt) with one 155 character
+// line per routing number. ach.CheckRoutingNumber only verifies the check digit of a routing
+// number, whereas a Directory knows which financial institutions actually receive ACH entries.
+package fedach
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// lineLength is the length of each line in a FedACH directory file, the last five
+// characters are filler which is sometimes trimmed from copies of the file.
+const (
+	lineLength    = 155
+	minLineLength = 150
+)
+
+// Record type codes of a Participant
+const (
+	// RecordTypeFederalReserve is used for Federal Reserve Banks
+	RecordTypeFederalReserve = "0"
+	// RecordTypeCustomer means entries are sent to the participant's RoutingNumber
+	RecordTypeCustomer = "1"
+	// RecordTypeNewRoutingNumber means entries must be sent to the participant's NewRoutingNumber,
+	// often because the financial institution has merged with another.
+	RecordTypeNewRoutingNumber = "2"
+)
+
+// Participant is a financial institution from the FedACH directory
+type Participant struct {
+	// RoutingNumber is the 9 digit ABA routing number, including its check digit
+	RoutingNumber string `json:"routingNumber"`
+	// OfficeCode is "O" for a main office and "B" for a branch
+	OfficeCode string `json:"officeCode"`
+	// ServicingFRBNumber is the routing number of the Federal Reserve Bank servicing the participant
+	ServicingFRBNumber string `json:"servicingFRBNumber"`
+	// RecordTypeCode is one of RecordTypeFederalReserve, RecordTypeCustomer or RecordTypeNewRoutingNumber
+	RecordTypeCode string `json:"recordTypeCode"`
+	// Revised is the date (MMDDYY) of the participant's last change
+	Revised string `json:"revised"`
+	// NewRoutingNumber is where entries are sent when RecordTypeCode is RecordTypeNewRoutingNumber
+	NewRoutingNumber string `json:"newRoutingNumber"`
+	// CustomerName is the financial institution's name
+	CustomerName string `json:"customerName"`
+
+	Location Location `json:"location"`
+
+	// PhoneNumber is the 10 digit phone number of the financial institution
+	PhoneNumber string `json:"phoneNumber"`
+	// StatusCode is "1" for institutions which receive government and commercial entries
+	StatusCode string `json:"statusCode"`
+	// ViewCode is the data view code of the record
+	ViewCode string `json:"viewCode"`
+}
+
+// Location is the address of a Participant
+type Location struct {
+	Address             string `json:"address"`
+	City                string `json:"city"`
+	State               string `json:"state"`
+	PostalCode          string `json:"postalCode"`
+	PostalCodeExtension string `json:"postalCodeExtension"`
+}
+
+// Active returns true if entries can be sent to the participant's RoutingNumber.
+// Participants which merged into another institution return false, see NewRoutingNumber.
+func (p *Participant) Active() bool {
+	return p != nil && p.RecordTypeCode != RecordTypeNewRoutingNumber
+}
+
+// RevisedDate parses Revised as a time.Time
+func (p *Participant) RevisedDate() (time.Time, error) {
+	return time.Parse("010206", p.Revised)
+}
+
+// Directory holds the participants of a FedACH directory file
+type Directory struct {
+	participants []*Participant
+	byRouting    map[string]*Participant
+}
+
+// NewDirectory returns a Directory of participants. Later participants replace
+// earlier ones with the same routing number.
+func NewDirectory(participants []*Participant) *Directory {
+	d := &Directory{
+		byRouting: make(map[string]*Participant, len(participants)),
+	}
+	index := make(map[string]int, len(participants))
+	for _, p := range participants {
+		if i, exists := index[p.RoutingNumber]; exists {
+			d.participants[i] = p
+		} else {
+			index[p.RoutingNumber] = len(d.participants)
+			d.participants = append(d.participants, p)
+		}
+		d.byRouting[p.RoutingNumber] = p
+	}
+	return d
+}
+
+// Read parses a FedACH directory file from r.
+func Read(r io.Reader) (*Directory, error) {
+	var participants []*Participant
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		p, err := parseParticipant(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNum, err)
+		}
+		participants = append(participants, p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("problem reading FedACH directory: %v", err)
+	}
+	return NewDirectory(participants), nil
+}
+
+// ReadFile parses the FedACH directory file at path.
+func ReadFile(path string) (*Directory, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	return Read(fd)
+}
+
+func parseParticipant(line string) (*Participant, error) {
+	if n := len(line); n < minLineLength || n > lineLength {
+		return nil, fmt.Errorf("invalid line length of %d", n)
+	}
+	line += strings.Repeat(" ", lineLength-len(line))
+
+	field := func(start, end int) string {
+		return strings.TrimSpace(line[start-1 : end])
+	}
+	p := &Participant{
+		RoutingNumber:      field(1, 9),
+		OfficeCode:         field(10, 10),
+		ServicingFRBNumber: field(11, 19),
+		RecordTypeCode:     field(20, 20),
+		Revised:            field(21, 26),
+		NewRoutingNumber:   field(27, 35),
+		CustomerName:       field(36, 71),
+		Location: Location{
+			Address:             field(72, 107),
+			City:                field(108, 127),
+			State:               field(128, 129),
+			PostalCode:          field(130, 134),
+			PostalCodeExtension: field(135, 138),
+		},
+		PhoneNumber: field(139, 148),
+		StatusCode:  field(149, 149),
+		ViewCode:    field(150, 150),
+	}
+	if !isDigits(p.RoutingNumber) || len(p.RoutingNumber) != 9 {
+		return nil, fmt.Errorf("invalid routing number %q", p.RoutingNumber)
+	}
+	switch p.RecordTypeCode {
+	case RecordTypeFederalReserve, RecordTypeCustomer:
+		if strings.Trim(p.NewRoutingNumber, "0") == "" {
+			p.NewRoutingNumber = ""
+		}
+	case RecordTypeNewRoutingNumber:
+		if !isDigits(p.NewRoutingNumber) || len(p.NewRoutingNumber) != 9 || strings.Trim(p.NewRoutingNumber, "0") == "" {
+			return nil, fmt.Errorf("invalid new routing number %q", p.NewRoutingNumber)
+		}
+	default:
+		return nil, fmt.Errorf("invalid record type code %q", p.RecordTypeCode)
+	}
+	return p, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// Len returns how many participants are in the directory
+func (d *Directory) Len() int {
+	return len(d.participants)
+}
+
+// Participants returns every participant in the order they were read
+func (d *Directory) Participants() []*Participant {
+	return d.participants
+}
+
+// Lookup returns the participant with routingNumber, or nil if the directory doesn't contain it.
+func (d *Directory) Lookup(routingNumber string) *Participant {
+	return d.byRouting[strings.TrimSpace(routingNumber)]
+}
+
+// Search returns participants whose CustomerName contains name and are located in city and state,
+// ignoring case. Empty arguments match every participant. Results are sorted by routing number.
+func (d *Directory) Search(name, city, state string) []*Participant {
+	name, city, state = strings.ToUpper(name), strings.ToUpper(city), strings.ToUpper(state)
+
+	var out []*Participant
+	for _, p := range d.participants {
+		if name != "" && !strings.Contains(strings.ToUpper(p.CustomerName), name) {
+			continue
+		}
+		if city != "" && !strings.EqualFold(p.Location.City, city) {
+			continue
+		}
+		if state != "" && !strings.EqualFold(p.Location.State, state) {
+			continue
+		}
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].RoutingNumber < out[j].RoutingNumber
+	})
+	return out
+}
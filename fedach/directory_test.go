@@ -0,0 +1,131 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fedach
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readTestDirectory(t *testing.T) *Directory {
+	t.Helper()
+
+	d, err := ReadFile(filepath.Join("..", "test", "testdata", "FedACHdir.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestDirectory__Read(t *testing.T) {
+	d := readTestDirectory(t)
+	if n := d.Len(); n != 5 {
+		t.Fatalf("got %d participants", n)
+	}
+
+	p := d.Lookup("121042882")
+	if p == nil {
+		t.Fatal("participant not found")
+	}
+	if p.CustomerName != "WELLS FARGO BANK NA" || p.OfficeCode != "O" || p.ServicingFRBNumber != "121000374" {
+		t.Errorf("unexpected participant: %#v", p)
+	}
+	if p.Location.City != "MINNEAPOLIS" || p.Location.State != "MN" || p.Location.PostalCode != "55479" {
+		t.Errorf("unexpected location: %#v", p.Location)
+	}
+	if p.PhoneNumber != "8004320444" || p.StatusCode != "1" || p.NewRoutingNumber != "" {
+		t.Errorf("unexpected participant: %#v", p)
+	}
+	if !p.Active() {
+		t.Error("expected active participant")
+	}
+	if when, err := p.RevisedDate(); err != nil || when.Format("2006-01-02") != "2020-07-09" {
+		t.Errorf("unexpected revised date: %v (%v)", when, err)
+	}
+
+	// merged institution
+	p = d.Lookup("091400606")
+	if p.Active() || p.NewRoutingNumber != "091300023" {
+		t.Errorf("unexpected participant: %#v", p)
+	}
+
+	if p := d.Lookup("987654320"); p != nil || p.Active() {
+		t.Errorf("unexpected participant: %#v", p)
+	}
+}
+
+func TestDirectory__ReadErrors(t *testing.T) {
+	line := "121042882O1210003741070920000000000WELLS FARGO BANK NA                 255 2ND AVE SOUTH                   MINNEAPOLIS         MN554790000800432044411"
+
+	// trimmed filler, CRLF line endings and blank lines are allowed
+	d, err := Read(strings.NewReader(line + "\r\n\r\n" + line + "     \r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != 1 {
+		t.Errorf("got %d participants", d.Len())
+	}
+
+	cases := map[string]string{
+		"short":       line[:100],
+		"routing":     "12104288XO" + line[10:],
+		"record type": line[:19] + "7" + line[20:],
+		"new routing": line[:19] + "2" + line[20:],
+	}
+	for name, input := range cases {
+		if _, err := Read(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if _, err := Read(strings.NewReader(line + "\n" + line[:20])); err == nil || !strings.HasPrefix(err.Error(), "line 2:") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if _, err := ReadFile(filepath.Join("testdata", "missing.txt")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestDirectory__Search(t *testing.T) {
+	d := readTestDirectory(t)
+
+	if found := d.Search("credit union", "", ""); len(found) != 2 || found[0].RoutingNumber != "231380104" || found[1].RoutingNumber != "273976369" {
+		t.Errorf("unexpected participants: %#v", found)
+	}
+	if found := d.Search("", "waterloo", "ia"); len(found) != 1 || found[0].CustomerName != "VERIDIAN CREDIT UNION" {
+		t.Errorf("unexpected participants: %#v", found)
+	}
+	if found := d.Search("credit union", "", "CA"); len(found) != 0 {
+		t.Errorf("unexpected participants: %#v", found)
+	}
+	if found := d.Search("", "", ""); len(found) != d.Len() {
+		t.Errorf("got %d participants", len(found))
+	}
+}
+
+func TestDirectory__duplicates(t *testing.T) {
+	d := NewDirectory([]*Participant{
+		{RoutingNumber: "121042882", CustomerName: "first"},
+		{RoutingNumber: "231380104"},
+		{RoutingNumber: "121042882", CustomerName: "second"},
+	})
+	if d.Len() != 2 || d.Participants()[0].CustomerName != "second" || d.Lookup("121042882").CustomerName != "second" {
+		t.Errorf("unexpected participants: %#v", d.Participants())
+	}
+}
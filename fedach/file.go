@@ -0,0 +1,104 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fedach
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+)
+
+// ParticipantError is returned for an entry whose RDFI isn't an active ACH participant
+type ParticipantError struct {
+	BatchNumber   int
+	TraceNumber   string
+	RoutingNumber string
+
+	// NewRoutingNumber is set when entries for RoutingNumber must be sent elsewhere
+	NewRoutingNumber string
+}
+
+func (e *ParticipantError) Error() string {
+	if e.NewRoutingNumber != "" {
+		return fmt.Sprintf("batch #%d entry %s: RDFI %s has moved to routing number %s", e.BatchNumber, e.TraceNumber, e.RoutingNumber, e.NewRoutingNumber)
+	}
+	return fmt.Sprintf("batch #%d entry %s: RDFI %s is not an ACH participant", e.BatchNumber, e.TraceNumber, e.RoutingNumber)
+}
+
+// ValidateFile checks the RDFI of every entry in file is an active participant of the directory.
+// A base.ErrorList of *ParticipantError is returned when any are not.
+func (d *Directory) ValidateFile(file *ach.File) error {
+	var errs base.ErrorList
+	check := func(batchNumber int, traceNumber, routingNumber string) {
+		p := d.Lookup(routingNumber)
+		if p.Active() {
+			return
+		}
+		err := &ParticipantError{
+			BatchNumber:   batchNumber,
+			TraceNumber:   traceNumber,
+			RoutingNumber: routingNumber,
+		}
+		if p != nil {
+			err.NewRoutingNumber = p.NewRoutingNumber
+		}
+		errs.Add(err)
+	}
+
+	for _, batches := range [][]ach.Batcher{file.Batches, file.NotificationOfChange, file.ReturnEntries} {
+		for _, b := range batches {
+			bh := b.GetHeader()
+			for _, ed := range b.GetEntries() {
+				check(bh.BatchNumber, ed.TraceNumber, ed.RDFIIdentification+ed.CheckDigit)
+			}
+			for _, ed := range b.GetADVEntries() {
+				check(bh.BatchNumber, ed.SequenceNumberField(), ed.RDFIIdentification+ed.CheckDigit)
+			}
+		}
+	}
+	for _, b := range file.IATBatches {
+		for _, ed := range b.GetEntries() {
+			check(b.GetHeader().BatchNumber, ed.TraceNumber, ed.RDFIIdentification+ed.CheckDigit)
+		}
+	}
+
+	if errs.Empty() {
+		return nil
+	}
+	return errs
+}
+
+// FillDestinationName sets an empty ImmediateDestinationName of the file header to the name of
+// the participant with its ImmediateDestination. It returns true if the name was set.
+func (d *Directory) FillDestinationName(file *ach.File) bool {
+	if file == nil || strings.TrimSpace(file.Header.ImmediateDestinationName) != "" {
+		return false
+	}
+	p := d.Lookup(file.Header.ImmediateDestination)
+	if p == nil {
+		return false
+	}
+	name := p.CustomerName
+	if len(name) > 23 {
+		name = strings.TrimSpace(name[:23])
+	}
+	file.Header.ImmediateDestinationName = name
+	return true
+}
@@ -0,0 +1,128 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fedach
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+)
+
+func readACHFile(t *testing.T, name string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &file
+}
+
+func TestDirectory__ValidateFile(t *testing.T) {
+	d := readTestDirectory(t)
+
+	file := readACHFile(t, "ppd-debit.ach")
+	if err := d.ValidateFile(file); err != nil {
+		t.Fatal(err)
+	}
+
+	// unknown RDFI
+	entries := file.Batches[0].GetEntries()
+	entries[0].SetRDFI("987654320")
+	err := d.ValidateFile(file)
+	var el base.ErrorList
+	if !errors.As(err, &el) || len(el) != 1 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var pe *ParticipantError
+	if !errors.As(el[0], &pe) || pe.RoutingNumber != "987654320" || pe.BatchNumber != 1 || pe.NewRoutingNumber != "" {
+		t.Errorf("unexpected error: %#v", el[0])
+	}
+	if msg := pe.Error(); msg != "batch #1 entry 121042880000001: RDFI 987654320 is not an ACH participant" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+
+	// merged RDFI
+	entries[0].SetRDFI("091400606")
+	err = d.ValidateFile(file)
+	if !errors.As(err, &el) || !errors.As(el[0], &pe) || pe.NewRoutingNumber != "091300023" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg := pe.Error(); msg != "batch #1 entry 121042880000001: RDFI 091400606 has moved to routing number 091300023" {
+		t.Errorf("unexpected message: %s", msg)
+	}
+}
+
+func TestDirectory__ValidateFileIAT(t *testing.T) {
+	d := readTestDirectory(t)
+
+	file := readACHFile(t, "iat-debit.ach")
+	if err := d.ValidateFile(file); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := file.IATBatches[0].GetEntries()
+	entries[0].SetRDFI("987654320")
+	err := d.ValidateFile(file)
+	var el base.ErrorList
+	if !errors.As(err, &el) || len(el) != 1 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pe, ok := el[0].(*ParticipantError); !ok || pe.TraceNumber != entries[0].TraceNumber {
+		t.Errorf("unexpected error: %#v", el[0])
+	}
+}
+
+func TestDirectory__FillDestinationName(t *testing.T) {
+	d := readTestDirectory(t)
+
+	file := readACHFile(t, "ppd-debit.ach")
+	if d.FillDestinationName(file) {
+		t.Error("existing name was replaced")
+	}
+	if name := file.Header.ImmediateDestinationName; name != "Federal Reserve Bank" {
+		t.Errorf("unexpected name: %q", name)
+	}
+
+	file.Header.ImmediateDestinationName = ""
+	if !d.FillDestinationName(file) {
+		t.Fatal("name wasn't filled")
+	}
+	if name := file.Header.ImmediateDestinationName; name != "CITADEL FEDERAL CREDIT" {
+		t.Errorf("unexpected name: %q", name)
+	}
+
+	file.Header.ImmediateDestinationName = ""
+	file.Header.ImmediateDestination = "987654320"
+	if d.FillDestinationName(file) || file.Header.ImmediateDestinationName != "" {
+		t.Error("unknown destination filled a name")
+	}
+	if d.FillDestinationName(nil) {
+		t.Error("nil file")
+	}
+}
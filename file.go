@@ -95,6 +95,10 @@ type advFileControl struct {
 	ADVControl ADVFileControl `json:"advFileControl"`
 }
 
+type fileADVControl struct {
+	ADVControl ADVFileControl `json:"fileADVControl"`
+}
+
 // FileFromJSON attempts to return a *File object assuming the input is valid JSON.
 //
 // Callers should always check for a nil-error before using the returned file.
@@ -151,7 +155,14 @@ func FileFromJSON(bs []byte) (*File, error) {
 		if err := json.NewDecoder(bytes.NewReader(bs)).Decode(&advControl); err != nil {
 			return nil, fmt.Errorf("problem reading ADVFileControl: %v", err)
 		}
-		file.ADVControl = advControl.ADVControl
+		// Files are written with "fileADVControl", which is preferred over "advFileControl"
+		control := fileADVControl{
+			ADVControl: advControl.ADVControl,
+		}
+		if err := json.NewDecoder(bytes.NewReader(bs)).Decode(&control); err != nil {
+			return nil, fmt.Errorf("problem reading ADVFileControl: %v", err)
+		}
+		file.ADVControl = control.ADVControl
 	}
 
 	if !file.IsADV() {
@@ -26,23 +26,23 @@ import (
 // totals accumulated from each batch control record in the file.
 type FileControl struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. fileControlPos 9
 	recordType string
 	// BatchCount total number of batches (i.e., '5' records) in the file
-	BatchCount int `json:"batchCount"`
+	BatchCount int `json:"batchCount" xml:"batchCount"`
 	// BlockCount total number of records in the file (include all headers and trailer) divided
 	// by 10 (This number must be evenly divisible by 10. If not, additional records consisting of all 9's are added to the file after the initial '9' record to fill out the block 10.)
-	BlockCount int `json:"blockCount,omitempty"`
+	BlockCount int `json:"blockCount,omitempty" xml:"blockCount,omitempty"`
 	// EntryAddendaCount is a tally of each Entry Detail Record and each Addenda
 	// Record processed, within either the batch or file as appropriate.
-	EntryAddendaCount int `json:"entryAddendaCount"`
+	EntryAddendaCount int `json:"entryAddendaCount" xml:"entryAddendaCount"`
 	// EntryHash calculated in the same manner as the batch has total but includes total from entire file
-	EntryHash int `json:"entryHash"`
+	EntryHash int `json:"entryHash" xml:"entryHash"`
 	// TotalDebitEntryDollarAmountInFile contains accumulated Batch debit totals within the file.
-	TotalDebitEntryDollarAmountInFile int `json:"totalDebit"`
+	TotalDebitEntryDollarAmountInFile int `json:"totalDebit" xml:"totalDebit"`
 	// TotalCreditEntryDollarAmountInFile contains accumulated Batch credit totals within the file.
-	TotalCreditEntryDollarAmountInFile int `json:"totalCredit"`
+	TotalCreditEntryDollarAmountInFile int `json:"totalCredit" xml:"totalCredit"`
 	// Reserved should be blank.
 	reserved string
 	// validator is composed for data validation
@@ -31,7 +31,7 @@ import (
 // fields which can be used to uniquely identify a file.
 type FileHeader struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. headerPos
 	recordType string
 	// PriorityCode consists of the numerals 01
@@ -43,7 +43,7 @@ type FileHeader struct {
 	// Federal Reserve Routing Symbol, the four digit ABA Institution Identifier, and the Check
 	// Digit (bTTTTAAAAC). ImmediateDestinationField() will append the blank space to the
 	// routing number.
-	ImmediateDestination string `json:"immediateDestination"`
+	ImmediateDestination string `json:"immediateDestination" xml:"immediateDestination"`
 
 	// ImmediateOrigin contains the Routing Number of the ACH Operator or sending
 	// point that is sending the file. The ach file format specifies a 10 character
@@ -51,25 +51,25 @@ type FileHeader struct {
 	// Federal Reserve Routing Symbol, the four digit ABA Institution Identifier, and the Check
 	// Digit (bTTTTAAAAC). ImmediateOriginField() will append the blank space to the
 	// routing number.
-	ImmediateOrigin string `json:"immediateOrigin"`
+	ImmediateOrigin string `json:"immediateOrigin" xml:"immediateOrigin"`
 
 	// FileCreationDate is the date on which the file is prepared by an ODFI (ACH input files)
 	// or the date (exchange date) on which a file is transmitted from ACH Operator
 	// to ACH Operator, or from ACH Operator to RDFIs (ACH output files).
 	//
 	// The format is: YYMMDD. Y=Year, M=Month, D=Day
-	FileCreationDate string `json:"fileCreationDate"`
+	FileCreationDate string `json:"fileCreationDate" xml:"fileCreationDate"`
 
 	// FileCreationTime is the system time when the ACH file was created.
 	//
 	// The format is: HHmm. H=Hour, m=Minute
-	FileCreationTime string `json:"fileCreationTime"`
+	FileCreationTime string `json:"fileCreationTime" xml:"fileCreationTime"`
 
 	// This field should start at zero and increment by 1 (up to 9) and then go to
 	// letters starting at A through Z for each subsequent file that is created for
 	// a single system date. (34-34) 1 numeric 0-9 or uppercase alpha A-Z.
 	// I have yet to see this ID not A
-	FileIDModifier string `json:"fileIDModifier,omitempty"`
+	FileIDModifier string `json:"fileIDModifier,omitempty" xml:"fileIDModifier"`
 
 	// RecordSize indicates the number of characters contained in each
 	// record. At this time, the value "094" must be used.
@@ -88,14 +88,14 @@ type FileHeader struct {
 
 	// ImmediateDestinationName us the name of the ACH or receiving point for which that
 	// file is destined. Name corresponding to the ImmediateDestination
-	ImmediateDestinationName string `json:"immediateDestinationName"`
+	ImmediateDestinationName string `json:"immediateDestinationName" xml:"immediateDestinationName"`
 
 	// ImmediateOriginName is the name of the ACH operator or sending point that is
 	// sending the file. Name corresponding to the ImmediateOrigin
-	ImmediateOriginName string `json:"immediateOriginName"`
+	ImmediateOriginName string `json:"immediateOriginName" xml:"immediateOriginName"`
 
 	// ReferenceCode is reserved for information pertinent to the Originator.
-	ReferenceCode string `json:"referenceCode,omitempty"`
+	ReferenceCode string `json:"referenceCode,omitempty" xml:"referenceCode,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to GoLang Converters
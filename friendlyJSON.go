@@ -0,0 +1,345 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// MarshalFriendlyJSON writes f as JSON for APIs and people rather than mirroring NACHA records.
+// The layout and keys match json.Marshal but
+//
+//   - amounts are decimal strings of dollars ("12.34" instead of 1234)
+//   - dates are ISO 8601 ("2018-10-08" instead of "181008") and times are "15:04"
+//   - transaction codes are named (e.g. "checkingCredit" for 22)
+//   - service class codes are named (e.g. "creditsOnly" for 220)
+//   - SEC codes are named (e.g. "prearrangedPaymentAndDeposit" for PPD)
+//
+// Values which don't have a friendly form (unknown codes, invalid dates) are written unchanged.
+// FileFromFriendlyJSON reads the output back into the same File.
+func (f *File) MarshalFriendlyJSON() ([]byte, error) {
+	bs, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+	v, err := decodeJSONValue(bs)
+	if err != nil {
+		return nil, err
+	}
+	var errs base.ErrorList
+	v = convertFriendlyJSON(v, "", toFriendly, &errs)
+	if !errs.Empty() {
+		return nil, errs
+	}
+	return json.Marshal(v)
+}
+
+// FileFromFriendlyJSON attempts to return a *File object from JSON written by MarshalFriendlyJSON.
+// Values in their NACHA form (amounts as numbers of cents, YYMMDD dates or numeric codes)
+// are also accepted. Invalid amounts and unknown
+// names are returned as a base.ErrorList of *JSONError values.
+//
+// Callers should always check for a nil-error before using the returned file.
+func FileFromFriendlyJSON(bs []byte) (*File, error) {
+	if len(bs) == 0 {
+		return nil, errors.New("no JSON data provided")
+	}
+	v, err := decodeJSONValue(bs)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+	var errs base.ErrorList
+	v = convertFriendlyJSON(v, "", fromFriendly, &errs)
+	if !errs.Empty() {
+		return nil, errs
+	}
+	bs, err = json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return FileFromJSON(bs)
+}
+
+func decodeJSONValue(bs []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+const (
+	toFriendly   = true
+	fromFriendly = false
+)
+
+// friendlyConverter converts the value of a key between its NACHA and friendly form.
+type friendlyConverter func(v interface{}, direction bool) (interface{}, error)
+
+// friendlyKeys are converted wherever they appear in a file as record keys are
+// consistent across record types.
+var friendlyKeys = map[string]friendlyConverter{
+	"amount":                 convertFriendlyAmount,
+	"foreignPaymentAmount":   convertFriendlyAmount,
+	"totalCredit":            convertFriendlyAmount,
+	"totalDebit":             convertFriendlyAmount,
+	"fileCreationDate":       convertFriendlyDate,
+	"effectiveEntryDate":     convertFriendlyDate,
+	"dateOfDeath":            convertFriendlyDate,
+	"fileCreationTime":       convertFriendlyTime,
+	"transactionCode":        convertFriendlyTransactionCode,
+	"serviceClassCode":       convertFriendlyServiceClassCode,
+	"standardEntryClassCode": convertFriendlySECCode,
+}
+
+func convertFriendlyJSON(v interface{}, path string, direction bool, errs *base.ErrorList) interface{} {
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(vv))
+		for k := range vv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := joinJSONPath(path, k)
+			if convert, exists := friendlyKeys[k]; exists && vv[k] != nil {
+				out, err := convert(vv[k], direction)
+				if err != nil {
+					errs.Add(&JSONError{Path: p, Msg: err.Error()})
+					continue
+				}
+				vv[k] = out
+				continue
+			}
+			vv[k] = convertFriendlyJSON(vv[k], p, direction, errs)
+		}
+	case []interface{}:
+		for i := range vv {
+			vv[i] = convertFriendlyJSON(vv[i], fmt.Sprintf("%s[%d]", path, i), direction, errs)
+		}
+	}
+	return v
+}
+
+func convertFriendlyAmount(v interface{}, direction bool) (interface{}, error) {
+	if direction == toFriendly {
+		n, ok := v.(json.Number)
+		if !ok {
+			return v, nil
+		}
+		cents, err := n.Int64()
+		if err != nil {
+			return v, nil
+		}
+		sign := ""
+		if cents < 0 {
+			sign, cents = "-", -cents
+		}
+		return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100), nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return v, nil // numbers are cents
+	}
+	cents, err := parseFriendlyAmount(strings.TrimSpace(s))
+	if err != nil {
+		return nil, err
+	}
+	return json.Number(strconv.FormatInt(cents, 10)), nil
+}
+
+// parseFriendlyAmount reads a decimal amount of dollars (e.g. "12.34") as cents.
+func parseFriendlyAmount(amount string) (int64, error) {
+	negative := strings.HasPrefix(amount, "-")
+	whole, fraction := strings.TrimPrefix(amount, "-"), ""
+	if i := strings.Index(whole, "."); i >= 0 {
+		whole, fraction = whole[:i], whole[i+1:]
+	}
+	if whole == "" || len(fraction) > 2 || strings.Trim(whole+fraction, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	cents, err := strconv.ParseInt(whole+(fraction + "00")[:2], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", amount)
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+func convertFriendlyDate(v interface{}, direction bool) (interface{}, error) {
+	return convertFriendlyTimestamp(v, direction, "060102", "2006-01-02")
+}
+
+func convertFriendlyTime(v interface{}, direction bool) (interface{}, error) {
+	return convertFriendlyTimestamp(v, direction, "1504", "15:04")
+}
+
+// convertFriendlyTimestamp rewrites strings between their NACHA and friendly layout,
+// values which don't parse are left as-is for FileFromJSON to handle.
+func convertFriendlyTimestamp(v interface{}, direction bool, nacha, friendly string) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	from, to := friendly, nacha
+	if direction == toFriendly {
+		from, to = nacha, friendly
+	}
+	if t, err := time.Parse(from, s); err == nil {
+		return t.Format(to), nil
+	}
+	return v, nil
+}
+
+func convertFriendlyTransactionCode(v interface{}, direction bool) (interface{}, error) {
+	return convertFriendlyCode(v, direction, "transaction code", transactionCodeNames)
+}
+
+func convertFriendlyServiceClassCode(v interface{}, direction bool) (interface{}, error) {
+	return convertFriendlyCode(v, direction, "service class code", serviceClassCodeNames)
+}
+
+// convertFriendlyCode swaps numeric codes with their name in names.
+func convertFriendlyCode(v interface{}, direction bool, kind string, names map[int]string) (interface{}, error) {
+	if direction == toFriendly {
+		if n, ok := v.(json.Number); ok {
+			if code, err := n.Int64(); err == nil && names[int(code)] != "" {
+				return names[int(code)], nil
+			}
+		}
+		return v, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	for code, name := range names {
+		if strings.EqualFold(name, s) {
+			return json.Number(strconv.Itoa(code)), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown %s %q", kind, s)
+}
+
+func convertFriendlySECCode(v interface{}, direction bool) (interface{}, error) {
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+	if direction == toFriendly {
+		if name, exists := secCodeNames[s]; exists {
+			return name, nil
+		}
+		return v, nil
+	}
+	for code, name := range secCodeNames {
+		if strings.EqualFold(name, s) {
+			return code, nil
+		}
+	}
+	return v, nil // SEC codes are also accepted
+}
+
+var transactionCodeNames = map[int]string{
+	CheckingReturnNOCCredit:            "checkingReturnNOCCredit",
+	CheckingCredit:                     "checkingCredit",
+	CheckingPrenoteCredit:              "checkingPrenoteCredit",
+	CheckingZeroDollarRemittanceCredit: "checkingZeroDollarRemittanceCredit",
+	CheckingReturnNOCDebit:             "checkingReturnNOCDebit",
+	CheckingDebit:                      "checkingDebit",
+	CheckingPrenoteDebit:               "checkingPrenoteDebit",
+	CheckingZeroDollarRemittanceDebit:  "checkingZeroDollarRemittanceDebit",
+	SavingsReturnNOCCredit:             "savingsReturnNOCCredit",
+	SavingsCredit:                      "savingsCredit",
+	SavingsPrenoteCredit:               "savingsPrenoteCredit",
+	SavingsZeroDollarRemittanceCredit:  "savingsZeroDollarRemittanceCredit",
+	SavingsReturnNOCDebit:              "savingsReturnNOCDebit",
+	SavingsDebit:                       "savingsDebit",
+	SavingsPrenoteDebit:                "savingsPrenoteDebit",
+	SavingsZeroDollarRemittanceDebit:   "savingsZeroDollarRemittanceDebit",
+	GLReturnNOCCredit:                  "glReturnNOCCredit",
+	GLCredit:                           "glCredit",
+	GLPrenoteCredit:                    "glPrenoteCredit",
+	GLZeroDollarRemittanceCredit:       "glZeroDollarRemittanceCredit",
+	GLReturnNOCDebit:                   "glReturnNOCDebit",
+	GLDebit:                            "glDebit",
+	GLPrenoteDebit:                     "glPrenoteDebit",
+	GLZeroDollarRemittanceDebit:        "glZeroDollarRemittanceDebit",
+	LoanReturnNOCCredit:                "loanReturnNOCCredit",
+	LoanCredit:                         "loanCredit",
+	LoanPrenoteCredit:                  "loanPrenoteCredit",
+	LoanZeroDollarRemittanceCredit:     "loanZeroDollarRemittanceCredit",
+	LoanDebit:                          "loanDebit",
+	LoanReturnNOCDebit:                 "loanReturnNOCDebit",
+	CreditForDebitsOriginated:          "creditForDebitsOriginated",
+	DebitForCreditsOriginated:          "debitForCreditsOriginated",
+	CreditForCreditsReceived:           "creditForCreditsReceived",
+	DebitForDebitsReceived:             "debitForDebitsReceived",
+	CreditForCreditsRejected:           "creditForCreditsRejected",
+	DebitForDebitsRejectedBatches:      "debitForDebitsRejectedBatches",
+	CreditSummary:                      "creditSummary",
+	DebitSummary:                       "debitSummary",
+}
+
+var serviceClassCodeNames = map[int]string{
+	MixedDebitsAndCredits:      "mixedDebitsAndCredits",
+	CreditsOnly:                "creditsOnly",
+	DebitsOnly:                 "debitsOnly",
+	AutomatedAccountingAdvices: "automatedAccountingAdvices",
+}
+
+var secCodeNames = map[string]string{
+	ACK: "paymentAcknowledgment",
+	ADV: "automatedAccountingAdvice",
+	ARC: "accountsReceivable",
+	ATX: "financialEDIAcknowledgment",
+	BOC: "backOfficeConversion",
+	CCD: "corporateCreditOrDebit",
+	CIE: "customerInitiated",
+	COR: "notificationOfChange",
+	CTX: "corporateTradeExchange",
+	DNE: "deathNotification",
+	ENR: "automatedEnrollment",
+	IAT: "internationalACHTransaction",
+	MTE: "machineTransfer",
+	POP: "pointOfPurchase",
+	POS: "pointOfSale",
+	PPD: "prearrangedPaymentAndDeposit",
+	RCK: "representedCheck",
+	SHR: "sharedNetworkTransaction",
+	TEL: "telephoneInitiated",
+	TRC: "checkTruncation",
+	TRX: "checkTruncationEntriesExchange",
+	WEB: "internetInitiated",
+	XCK: "destroyedCheck",
+}
@@ -0,0 +1,195 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+// TestFriendlyJSON__roundTrip writes every valid NACHA file in test/testdata as friendly
+// JSON and expects the file read back to be written as the same NACHA text.
+func TestFriendlyJSON__roundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("test", "testdata", "*.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checked := 0
+	for _, path := range paths {
+		fd, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := NewReader(fd).Read()
+		fd.Close()
+		if err != nil || createXMLTestFile(&file) != nil {
+			continue // invalid files are tested elsewhere
+		}
+
+		bs, err := file.MarshalFriendlyJSON()
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		read, err := FileFromFriendlyJSON(bs)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", path, err, bs)
+		}
+
+		var expected, got bytes.Buffer
+		if err := NewWriter(&expected).Write(&file); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if err := NewWriter(&got).Write(read); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if expected.String() != got.String() {
+			t.Errorf("%s: NACHA contents changed\nexpected:\n%s\ngot:\n%s", path, expected.String(), got.String())
+		}
+		checked++
+	}
+	if checked < 20 {
+		t.Errorf("only %d files were checked", checked)
+	}
+}
+
+func TestFriendlyJSON__layout(t *testing.T) {
+	fd, err := os.Open(filepath.Join("test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs, err := file.MarshalFriendlyJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out struct {
+		Header struct {
+			FileCreationDate string `json:"fileCreationDate"`
+			FileCreationTime string `json:"fileCreationTime"`
+		} `json:"fileHeader"`
+		Batches []struct {
+			Header struct {
+				ServiceClassCode       string `json:"serviceClassCode"`
+				StandardEntryClassCode string `json:"standardEntryClassCode"`
+				EffectiveEntryDate     string `json:"effectiveEntryDate"`
+			} `json:"batchHeader"`
+			Entries []struct {
+				TransactionCode string `json:"transactionCode"`
+				Amount          string `json:"amount"`
+			} `json:"entryDetails"`
+		} `json:"batches"`
+		Control struct {
+			TotalDebit string `json:"totalDebit"`
+		} `json:"fileControl"`
+	}
+	if err := json.Unmarshal(bs, &out); err != nil {
+		t.Fatalf("%v\n%s", err, bs)
+	}
+	if out.Header.FileCreationDate != "2019-06-24" || out.Header.FileCreationTime != "00:00" {
+		t.Errorf("fileHeader: %#v", out.Header)
+	}
+	bh := out.Batches[0].Header
+	if bh.ServiceClassCode != "debitsOnly" || bh.StandardEntryClassCode != "prearrangedPaymentAndDeposit" || bh.EffectiveEntryDate != "2019-06-25" {
+		t.Errorf("batchHeader: %#v", bh)
+	}
+	if ed := out.Batches[0].Entries[0]; ed.TransactionCode != "checkingDebit" || ed.Amount != "1000000.00" {
+		t.Errorf("entryDetail: %#v", ed)
+	}
+	if out.Control.TotalDebit != "1000000.00" {
+		t.Errorf("fileControl: %#v", out.Control)
+	}
+}
+
+func TestFriendlyJSON__read(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("test", "testdata", "ppd-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// NACHA values are also accepted
+	file, err := FileFromFriendlyJSON(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := file.Batches[0].GetEntries()[0].Amount; n != 100000 {
+		t.Errorf("amount=%d", n)
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal(bs, &v); err != nil {
+		t.Fatal(err)
+	}
+	batch := v["batches"].([]interface{})[0].(map[string]interface{})
+	entry := batch["entryDetails"].([]interface{})[0].(map[string]interface{})
+	entry["amount"] = "12.50"
+	entry["transactionCode"] = "savingsCredit"
+	batch["batchHeader"].(map[string]interface{})["standardEntryClassCode"] = "prearrangedPaymentAndDeposit"
+	bs, _ = json.Marshal(v)
+
+	file, err = FileFromFriendlyJSON(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed := file.Batches[0].GetEntries()[0]
+	if ed.Amount != 1250 || ed.TransactionCode != SavingsCredit {
+		t.Errorf("amount=%d transactionCode=%d", ed.Amount, ed.TransactionCode)
+	}
+	if sec := file.Batches[0].GetHeader().StandardEntryClassCode; sec != PPD {
+		t.Errorf("standardEntryClassCode=%s", sec)
+	}
+
+	// invalid values
+	entry["amount"] = "12.345"
+	entry["transactionCode"] = "checkingSomething"
+	bs, _ = json.Marshal(v)
+	_, err = FileFromFriendlyJSON(bs)
+	el, ok := err.(base.ErrorList)
+	if !ok || len(el) != 2 {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(el[0].Error(), `batches[0].entryDetails[0].amount invalid amount "12.345"`) {
+		t.Errorf("unexpected error: %v", el[0])
+	}
+	if !strings.Contains(el[1].Error(), `batches[0].entryDetails[0].transactionCode unknown transaction code "checkingSomething"`) {
+		t.Errorf("unexpected error: %v", el[1])
+	}
+}
+
+func TestFriendlyJSON__amounts(t *testing.T) {
+	cases := map[string]int64{"0": 0, "0.5": 50, "12.34": 1234, "-1.01": -101, "100": 10000}
+	for input, expected := range cases {
+		if n, err := parseFriendlyAmount(input); err != nil || n != expected {
+			t.Errorf("%q: got %d error=%v", input, n, err)
+		}
+	}
+	for _, input := range []string{"", ".5", "1.234", "1,000.00", "$5", "1e3"} {
+		if _, err := parseFriendlyAmount(input); err == nil {
+			t.Errorf("expected error from %q", input)
+		}
+	}
+}
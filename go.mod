@@ -3,16 +3,19 @@ module github.com/moov-io/ach
 require (
 	github.com/Pallinder/go-randomdata v1.2.0
 	github.com/go-kit/kit v0.10.0
+	github.com/golang/protobuf v1.4.2
 	github.com/gorilla/mux v1.7.4
-	github.com/gorilla/websocket v1.4.2 // indirect
 	github.com/juju/ansiterm v0.0.0-20180109212912-720a0952cc2a
+	github.com/lib/pq v1.8.0
 	github.com/lunixbochs/vtclean v1.0.0 // indirect
 	github.com/mattn/go-colorable v0.1.4 // indirect
 	github.com/mattn/go-isatty v0.0.12
 	github.com/moov-io/base v0.11.0
-	github.com/moov-io/customers v0.4.0-rc4.0.20200519011716-05c4c319c49b
 	github.com/prometheus/client_golang v1.6.0
-	golang.org/x/crypto v0.0.0-20200510223506-06a226fb4e37 // indirect
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
+	google.golang.org/grpc v1.33.1
+	google.golang.org/protobuf v1.23.0
+	modernc.org/sqlite v1.10.6
 )
 
 go 1.13
@@ -33,10 +33,10 @@ import (
 // reason for the payment.
 type IATBatch struct {
 	// ID is a client defined string used as a reference to this record.
-	ID      string            `json:"id"`
-	Header  *IATBatchHeader   `json:"IATBatchHeader,omitempty"`
-	Entries []*IATEntryDetail `json:"IATEntryDetails,omitempty"`
-	Control *BatchControl     `json:"batchControl,omitempty"`
+	ID      string            `json:"id" xml:"id"`
+	Header  *IATBatchHeader   `json:"IATBatchHeader,omitempty" xml:"IATBatchHeader,omitempty"`
+	Entries []*IATEntryDetail `json:"IATEntryDetails,omitempty" xml:"IATEntryDetail,omitempty"`
+	Control *BatchControl     `json:"batchControl,omitempty" xml:"batchControl,omitempty"`
 
 	// category defines if the entry is a Forward, Return, or NOC
 	category string
@@ -43,7 +43,7 @@ import (
 // Receiver, Receiver's account number, Receiver's bank identity and reason for the payment.
 type IATBatchHeader struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 
 	// RecordType defines the type of record in the block. 5
 	recordType string
@@ -51,10 +51,10 @@ type IATBatchHeader struct {
 	// ServiceClassCode ACH Mixed Debits and Credits '200'
 	// ACH Credits Only '220'
 	// ACH Debits Only '225'
-	ServiceClassCode int `json:"serviceClassCode"`
+	ServiceClassCode int `json:"serviceClassCode" xml:"serviceClassCode"`
 
 	// IATIndicator - Leave Blank - It is only used for corrected IAT entries
-	IATIndicator string `json:"IATIndicator,omitempty"`
+	IATIndicator string `json:"IATIndicator,omitempty" xml:"IATIndicator,omitempty"`
 
 	// ForeignExchangeIndicator is a code indicating currency conversion
 	//
@@ -72,7 +72,7 @@ type IATBatchHeader struct {
 	// entries transmitted using this code. For entries originated in a fixed value
 	// amount, the foreign Exchange Reference Field will be space
 	// filled.
-	ForeignExchangeIndicator string `json:"foreignExchangeIndicator"`
+	ForeignExchangeIndicator string `json:"foreignExchangeIndicator" xml:"foreignExchangeIndicator"`
 
 	// ForeignExchangeReferenceIndicator is a code used to indicate the content of the
 	// Foreign Exchange Reference Field and is filled by the gateway operator.
@@ -80,24 +80,24 @@ type IATBatchHeader struct {
 	// 1 - Foreign Exchange Rate;
 	// 2 - Foreign Exchange Reference Number; or
 	// 3 - Space Filled
-	ForeignExchangeReferenceIndicator int `json:"foreignExchangeReferenceIndicator"`
+	ForeignExchangeReferenceIndicator int `json:"foreignExchangeReferenceIndicator" xml:"foreignExchangeReferenceIndicator"`
 
 	// ForeignExchangeReference  Contains either the foreign exchange rate used to execute
 	// the foreign exchange conversion of a cross-border entry or another reference to the foreign
 	// exchange transaction.
-	ForeignExchangeReference string `json:"foreignExchangeReference"`
+	ForeignExchangeReference string `json:"foreignExchangeReference" xml:"foreignExchangeReference"`
 
 	// ISODestinationCountryCode is the two-character code, as approved by the International
 	// Organization for Standardization (ISO), to identify the country in which the entry is
 	// to be received. Values can be found on the International Organization for Standardization
 	// website: www.iso.org.  For entries destined to account holder in the U.S., this would be US.
-	ISODestinationCountryCode string `json:"ISODestinationCountryCode"`
+	ISODestinationCountryCode string `json:"ISODestinationCountryCode" xml:"ISODestinationCountryCode"`
 
 	// OriginatorIdentification identifies the following:
 	// For U.S. entities: the number assigned will be your tax ID
 	// For non-U.S. entities: the number assigned will be your DDA number,
 	// or the last 9 characters of your account number if it exceeds 9 characters
-	OriginatorIdentification string `json:"originatorIdentification"`
+	OriginatorIdentification string `json:"originatorIdentification" xml:"originatorIdentification"`
 
 	// StandardEntryClassCode for consumer and non consumer international payments is IAT
 	// Identifies the payment type (product) found within an ACH batch-using a 3-character code.
@@ -106,7 +106,7 @@ type IATBatchHeader struct {
 	// Determines addenda records (required or optional PLUS one or up to 9,999 records).
 	// Determines rules to follow (return time frames).
 	// Some SEC codes require specific data in predetermined fields within the ACH record
-	StandardEntryClassCode string `json:"standardEntryClassCode,omitempty"`
+	StandardEntryClassCode string `json:"standardEntryClassCode,omitempty" xml:"standardEntryClassCode,omitempty"`
 
 	// CompanyEntryDescription A description of the entries contained in the batch
 	//
@@ -123,24 +123,24 @@ type IATBatchHeader struct {
 	//
 	// This field must contain the word "NONSETTLED" (left justified) when the
 	// batch contains entries which could not settle.
-	CompanyEntryDescription string `json:"companyEntryDescription,omitempty"`
+	CompanyEntryDescription string `json:"companyEntryDescription,omitempty" xml:"companyEntryDescription,omitempty"`
 
 	// ISOOriginatingCurrencyCode is the three-character code, as approved by the International
 	// Organization for Standardization (ISO), to identify the currency denomination in which the
 	// entry was first originated. If the source of funds is within the territorial jurisdiction
 	// of the U.S., enter 'USD', otherwise refer to International Organization for Standardization
 	// website for value: www.iso.org -- (Account Currency)
-	ISOOriginatingCurrencyCode string `json:"ISOOriginatingCurrencyCode"`
+	ISOOriginatingCurrencyCode string `json:"ISOOriginatingCurrencyCode" xml:"ISOOriginatingCurrencyCode"`
 
 	// ISODestinationCurrencyCode is the three-character code, as approved by the International
 	// Organization for Standardization (ISO), to identify the currency denomination in which the
 	// entry will ultimately be settled. If the final destination of funds is within the territorial
 	// jurisdiction of the U.S., enter “USD”, otherwise refer to International Organization for
 	// Standardization website for value: www.iso.org -- (Payment Currency)
-	ISODestinationCurrencyCode string `json:"ISODestinationCurrencyCode"`
+	ISODestinationCurrencyCode string `json:"ISODestinationCurrencyCode" xml:"ISODestinationCurrencyCode"`
 
 	// EffectiveEntryDate the date on which the entries are to settle. Format: YYMMDD (Y=Year, M=Month, D=Day)
-	EffectiveEntryDate string `json:"effectiveEntryDate,omitempty"`
+	EffectiveEntryDate string `json:"effectiveEntryDate,omitempty" xml:"effectiveEntryDate,omitempty"`
 
 	// SettlementDate Leave blank, this field is inserted by the ACH operator
 	settlementDate string
@@ -149,21 +149,21 @@ type IATBatchHeader struct {
 	// 0 ADV File prepared by an ACH Operator.
 	// 1 This code identifies the Originator as a depository financial institution.
 	// 2 This code identifies the Originator as a Federal Government entity or agency.
-	OriginatorStatusCode int `json:"originatorStatusCode,omitempty"`
+	OriginatorStatusCode int `json:"originatorStatusCode,omitempty" xml:"originatorStatusCode"`
 
 	// ODFIIdentification First 8 digits of the originating DFI transit routing number
 	// For Inbound IAT Entries, this field contains the routing number of the U.S. Gateway
 	// Operator.  For Outbound IAT Entries, this field contains the standard routing number,
 	// as assigned by Accuity, that identifies the U.S. ODFI initiating the Entry.
 	// Format - TTTTAAAA
-	ODFIIdentification string `json:"ODFIIdentification"`
+	ODFIIdentification string `json:"ODFIIdentification" xml:"ODFIIdentification"`
 
 	// BatchNumber is assigned in ascending sequence to each batch by the ODFI
 	// or its Sending Point in a given file of entries. Since the batch number
 	// in the Batch Header Record and the Batch Control Record is the same,
 	// the ascending sequence number should be assigned by batch and not by
 	// record.
-	BatchNumber int `json:"batchNumber,omitempty"`
+	BatchNumber int `json:"batchNumber,omitempty" xml:"batchNumber"`
 
 	// validator is composed for data validation
 	validator
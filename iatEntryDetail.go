@@ -30,7 +30,7 @@ import (
 // institution, the account number (left justify,no zero fill), name, and dollar amount.
 type IATEntryDetail struct {
 	// ID is a client defined string used as a reference to this record.
-	ID string `json:"id"`
+	ID string `json:"id" xml:"id"`
 	// RecordType defines the type of record in the block. 6
 	recordType string
 	// TransactionCode if the receivers account is:
@@ -42,31 +42,31 @@ type IATEntryDetail struct {
 	// Prenote for credit to savings account '33'
 	// Debit to savings account '37'
 	// Prenote for debit to savings account '38'
-	TransactionCode int `json:"transactionCode"`
+	TransactionCode int `json:"transactionCode" xml:"transactionCode"`
 	// RDFIIdentification is the RDFI's routing number without the last digit.
 	// Receiving Depository Financial Institution
-	RDFIIdentification string `json:"RDFIIdentification"`
+	RDFIIdentification string `json:"RDFIIdentification" xml:"RDFIIdentification"`
 	// CheckDigit the last digit of the RDFI's routing number
-	CheckDigit string `json:"checkDigit"`
+	CheckDigit string `json:"checkDigit" xml:"checkDigit"`
 	// AddendaRecords is the number of Addenda Records
-	AddendaRecords int `json:"AddendaRecords"`
+	AddendaRecords int `json:"AddendaRecords" xml:"AddendaRecords"`
 	// reserved - Leave blank
 	reserved string
 	// Amount Number of cents you are debiting/crediting this account
-	Amount int `json:"amount"`
+	Amount int `json:"amount" xml:"amount"`
 	// DFIAccountNumber is the receiver's bank account number you are crediting/debiting.
 	// It important to note that this is an alphanumeric field, so its space padded, no zero padded
-	DFIAccountNumber string `json:"DFIAccountNumber"`
+	DFIAccountNumber string `json:"DFIAccountNumber" xml:"DFIAccountNumber"`
 	// reservedTwo - Leave blank
 	reservedTwo string
 	// OFACScreeningIndicator - Leave blank
-	OFACScreeningIndicator string `json:"OFACScreeningIndicator"`
+	OFACScreeningIndicator string `json:"OFACScreeningIndicator" xml:"OFACScreeningIndicator"`
 	// SecondaryOFACScreeningIndicator - Leave blank
-	SecondaryOFACScreeningIndicator string `json:"SecondaryOFACScreeningIndicator"`
+	SecondaryOFACScreeningIndicator string `json:"SecondaryOFACScreeningIndicator" xml:"SecondaryOFACScreeningIndicator"`
 	// AddendaRecordIndicator indicates the existence of an Addenda Record.
 	// A value of "1" indicates that one or more addenda records follow,
 	// and "0" means no such record is present.
-	AddendaRecordIndicator int `json:"addendaRecordIndicator,omitempty"`
+	AddendaRecordIndicator int `json:"addendaRecordIndicator,omitempty" xml:"addendaRecordIndicator,omitempty"`
 	// TraceNumber assigned by the ODFI in ascending sequence, is included in each
 	// Entry Detail Record, Corporate Entry Detail Record, and addenda Record.
 	// Trace Numbers uniquely identify each entry within a batch in an ACH input file.
@@ -77,58 +77,58 @@ type IATEntryDetail struct {
 	// with an entry or item rather than a physical record.
 	//
 	// Use TraceNumberField() for a properly formatted string representation.
-	TraceNumber string `json:"traceNumber,omitempty"`
+	TraceNumber string `json:"traceNumber,omitempty" xml:"traceNumber,omitempty"`
 	// Addenda10 is mandatory for IAT entries
 	//
 	// The Addenda10 Record identifies the Receiver of the transaction and the dollar amount of
 	// the payment.
-	Addenda10 *Addenda10 `json:"addenda10,omitempty"`
+	Addenda10 *Addenda10 `json:"addenda10,omitempty" xml:"addenda10,omitempty"`
 	// Addenda11 is mandatory for IAT entries
 	//
 	// The Addenda11 record identifies key information related to the Originator of
 	// the entry.
-	Addenda11 *Addenda11 `json:"addenda11,omitempty"`
+	Addenda11 *Addenda11 `json:"addenda11,omitempty" xml:"addenda11,omitempty"`
 	// Addenda12 is mandatory for IAT entries
 	//
 	// The Addenda12 record identifies key information related to the Originator of
 	// the entry.
-	Addenda12 *Addenda12 `json:"addenda12,omitempty"`
+	Addenda12 *Addenda12 `json:"addenda12,omitempty" xml:"addenda12,omitempty"`
 	// Addenda13 is mandatory for IAT entries
 	//
 	// The Addenda13 contains information related to the financial institution originating the entry.
 	// For inbound IAT entries, the Fourth Addenda Record must contain information to identify the
 	// foreign financial institution that is providing the funding and payment instruction for
 	// the IAT entry.
-	Addenda13 *Addenda13 `json:"addenda13,omitempty"`
+	Addenda13 *Addenda13 `json:"addenda13,omitempty" xml:"addenda13,omitempty"`
 	// Addenda14 is mandatory for IAT entries
 	//
 	// The Addenda14 identifies the Receiving financial institution holding the Receiver's account.
-	Addenda14 *Addenda14 `json:"addenda14,omitempty"`
+	Addenda14 *Addenda14 `json:"addenda14,omitempty" xml:"addenda14,omitempty"`
 	// Addenda15 is mandatory for IAT entries
 	//
 	// The Addenda15 record identifies key information related to the Receiver.
-	Addenda15 *Addenda15 `json:"addenda15,omitempty"`
+	Addenda15 *Addenda15 `json:"addenda15,omitempty" xml:"addenda15,omitempty"`
 	// Addenda16 is mandatory for IAt entries
 	//
 	// Addenda16 record identifies additional key information related to the Receiver.
-	Addenda16 *Addenda16 `json:"addenda16,omitempty"`
+	Addenda16 *Addenda16 `json:"addenda16,omitempty" xml:"addenda16,omitempty"`
 	// Addenda17 is optional for IAT entries
 	//
 	// This is an optional Addenda Record used to provide payment-related data. There i a maximum of up to two of these
 	// Addenda Records with each IAT entry.
-	Addenda17 []*Addenda17 `json:"addenda17,omitempty"`
+	Addenda17 []*Addenda17 `json:"addenda17,omitempty" xml:"addenda17,omitempty"`
 	// Addenda18 is optional for IAT entries
 	//
 	// This optional addenda record is used to provide information on each Foreign Correspondent Bank involved in the
 	// processing of the IAT entry. If no Foreign Correspondent Bank is involved,the record should not be included.
 	// A maximum of five Addenda18 records may be included with each IAT entry.
-	Addenda18 []*Addenda18 `json:"addenda18,omitempty"`
+	Addenda18 []*Addenda18 `json:"addenda18,omitempty" xml:"addenda18,omitempty"`
 	// Addenda98 for user with NOC
-	Addenda98 *Addenda98 `json:"addenda98,omitempty"`
+	Addenda98 *Addenda98 `json:"addenda98,omitempty" xml:"addenda98,omitempty"`
 	// Addenda99 for use with Returns
-	Addenda99 *Addenda99 `json:"addenda99,omitempty"`
+	Addenda99 *Addenda99 `json:"addenda99,omitempty" xml:"addenda99,omitempty"`
 	// Category defines if the entry is a Forward, Return, or NOC
-	Category string `json:"category,omitempty"`
+	Category string `json:"category,omitempty" xml:"category,omitempty"`
 	// validator is composed for data validation
 	validator
 	// converters is composed for ACH to golang Converters
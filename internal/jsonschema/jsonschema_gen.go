@@ -0,0 +1,44 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build ignore
+
+// Generates ach.schema.json
+//
+// The JSON Schema is built from the struct tags of ach.File and its records
+// with ach.JSONSchema, so it needs to be regenerated when they change.
+package main
+
+import (
+	"io/ioutil"
+	"log"
+
+	"github.com/moov-io/ach"
+)
+
+var outputFilename = "ach.schema.json"
+
+func main() {
+	bs, err := ach.JSONSchema()
+	if err != nil {
+		log.Fatalf("ERROR: problem building JSON Schema: %v", err)
+	}
+	if err := ioutil.WriteFile(outputFilename, bs, 0644); err != nil {
+		log.Fatalf("ERROR: problem writing %s: %v", outputFilename, err)
+	}
+	log.Printf("wrote %s", outputFilename)
+}
@@ -0,0 +1,354 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/ach"
+)
+
+// Camt054Namespace is the XML namespace of the messages created by FileToCamt054
+const Camt054Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.054.001.02"
+
+// Camt054 is a bank to customer debit credit notification (camt.054) message.
+type Camt054 struct {
+	XMLName   xml.Name `xml:"Document"`
+	Namespace string   `xml:"xmlns,attr,omitempty"`
+
+	GroupHeader   NotificationHeader    `xml:"BkToCstmrDbtCdtNtfctn>GrpHdr"`
+	Notifications []AccountNotification `xml:"BkToCstmrDbtCdtNtfctn>Ntfctn"`
+}
+
+// NotificationHeader identifies a camt.054 message.
+type NotificationHeader struct {
+	MessageID        string `xml:"MsgId"`
+	CreationDateTime string `xml:"CreDtTm"`
+}
+
+// AccountNotification lists the entries booked (or reported) for one account.
+type AccountNotification struct {
+	ID               string              `xml:"Id"`
+	CreationDateTime string              `xml:"CreDtTm"`
+	Account          Account             `xml:"Acct"`
+	Entries          []NotificationEntry `xml:"Ntry"`
+}
+
+// NotificationEntry is a booked return or an informational notification of change.
+type NotificationEntry struct {
+	Reference         string               `xml:"NtryRef,omitempty"`
+	Amount            Amount               `xml:"Amt"`
+	CreditDebit       string               `xml:"CdtDbtInd"`
+	Reversal          bool                 `xml:"RvslInd,omitempty"`
+	Status            string               `xml:"Sts"`
+	BookingDate       *Date                `xml:"BookgDt,omitempty"`
+	ValueDate         *Date                `xml:"ValDt,omitempty"`
+	ServicerReference string               `xml:"AcctSvcrRef,omitempty"`
+	TransactionCode   BankTransactionCode  `xml:"BkTxCd"`
+	Details           []TransactionDetails `xml:"NtryDtls>TxDtls"`
+	AdditionalInfo    string               `xml:"AddtlNtryInf,omitempty"`
+}
+
+// BankTransactionCode is the ISO 20022 domain, family and sub-family of an entry along with
+// its NACHA transaction code.
+type BankTransactionCode struct {
+	Domain      string           `xml:"Domn>Cd"`
+	Family      string           `xml:"Domn>Fmly>Cd"`
+	SubFamily   string           `xml:"Domn>Fmly>SubFmlyCd"`
+	Proprietary *ProprietaryCode `xml:"Prtry,omitempty"`
+}
+
+// ProprietaryCode is a code and the organisation which issued it.
+type ProprietaryCode struct {
+	Code   string `xml:"Cd"`
+	Issuer string `xml:"Issr,omitempty"`
+}
+
+// TransactionDetails describes the original ACH entry of a return or notification of change.
+type TransactionDetails struct {
+	References     *TransactionReferences `xml:"Refs,omitempty"`
+	Amount         *Amount                `xml:"AmtDtls>TxAmt>Amt,omitempty"`
+	RelatedParties *RelatedParties        `xml:"RltdPties,omitempty"`
+	RelatedAgents  *RelatedAgents         `xml:"RltdAgts,omitempty"`
+	Return         *ReturnInformation     `xml:"RtrInf,omitempty"`
+
+	// AdditionalInfo holds structured remarks, see FileToCamt054
+	AdditionalInfo string `xml:"AddtlTxInf,omitempty"`
+}
+
+// TransactionReferences identifies the original ACH entry.
+type TransactionReferences struct {
+	ServicerReference string `xml:"AcctSvcrRef,omitempty"`
+	EndToEndID        string `xml:"EndToEndId,omitempty"`
+	TransactionID     string `xml:"TxId,omitempty"`
+}
+
+// RelatedParties is the receiver of the original ACH entry.
+type RelatedParties struct {
+	Debtor          *Party   `xml:"Dbtr,omitempty"`
+	DebtorAccount   *Account `xml:"DbtrAcct,omitempty"`
+	Creditor        *Party   `xml:"Cdtr,omitempty"`
+	CreditorAccount *Account `xml:"CdtrAcct,omitempty"`
+}
+
+// RelatedAgents are the financial institutions of the original ACH entry.
+type RelatedAgents struct {
+	DebtorAgent   *Agent `xml:"DbtrAgt,omitempty"`
+	CreditorAgent *Agent `xml:"CdtrAgt,omitempty"`
+}
+
+// ReturnInformation is the ISO 20022 reason of a return.
+type ReturnInformation struct {
+	Reason         *Code    `xml:"Rsn,omitempty"`
+	AdditionalInfo []string `xml:"AddtlInf,omitempty"`
+}
+
+// returnReasons maps NACHA return codes to ISO 20022 ExternalReturnReason1Code values.
+// Return codes without an equivalent are written as NARR with the NACHA code in AddtlInf.
+var returnReasons = map[string]string{
+	"R01": "AM04", // Insufficient Funds
+	"R02": "AC04", // Account Closed
+	"R03": "AC01", // No Account/Unable to Locate Account
+	"R04": "AC01", // Invalid Account Number
+	"R05": "MD01", // Unauthorized Debit to Consumer Account Using Corporate SEC Code
+	"R06": "MS03", // Returned per ODFI's Request
+	"R07": "MD01", // Authorization Revoked by Customer
+	"R08": "MS02", // Payment Stopped
+	"R09": "AM04", // Uncollected Funds
+	"R10": "MD01", // Customer Advises Not Authorized
+	"R11": "MD01", // Customer Advises Entry Not in Accordance with the Terms of the Authorization
+	"R13": "RC01", // Invalid ACH Routing Number
+	"R14": "MD07", // Representative Payee Deceased
+	"R15": "MD07", // Beneficiary or Account Holder Deceased
+	"R16": "AC06", // Account Frozen
+	"R18": "DT01", // Improper Effective Entry Date
+	"R19": "AM09", // Amount Field Error
+	"R20": "AG01", // Non-Transaction Account
+	"R23": "MS02", // Credit Entry Refused by Receiver
+	"R24": "AM05", // Duplicate Entry
+	"R28": "RC01", // Routing Number Check Digit Error
+	"R29": "MD01", // Corporate Customer Advises Not Authorized
+}
+
+// FileToCamt054 converts the return (ReturnEntries) and notification of change (NotificationOfChange)
+// batches of file into a camt.054 message with a Ntfctn element for each batch.
+//
+// Returns are booked entries (RvslInd true) and their Addenda99 return code is mapped to an ISO 20022
+// return reason in RtrInf. Notifications of change are informational zero amount entries. Every entry
+// has a structured remark (AddtlTxInf) of slash separated keys and values from its addenda record:
+//
+//	/RTRCD/R01/ORGNLTRC/091000017611242/ORGNLDFI/09100001
+//	/CHGCD/C01/CRRCTDDATA/1918171614/ORGNLTRC/121042880000001/ORGNLDFI/12104288
+//
+// Other batches, which have nothing to report to treasury, are listed in the Report.
+func FileToCamt054(file *ach.File) (*Camt054, *Report, error) {
+	if file == nil {
+		return nil, nil, errors.New("nil File")
+	}
+	report := &Report{}
+	doc := &Camt054{
+		Namespace: Camt054Namespace,
+		GroupHeader: NotificationHeader{
+			MessageID:        messageID(file),
+			CreationDateTime: creationDateTime(file.Header),
+		},
+	}
+
+	notified := make(map[ach.Batcher]bool)
+	batches := append(append([]ach.Batcher{}, file.ReturnEntries...), file.NotificationOfChange...)
+	for _, batch := range batches {
+		notified[batch] = true
+
+		bh := batch.GetHeader()
+		path := fmt.Sprintf("Batch[%d]", bh.BatchNumber)
+		odfi := bh.ODFIIdentification + strconv.Itoa((&ach.EntryDetail{}).CalculateCheckDigit(bh.ODFIIdentification))
+
+		ntfctn := AccountNotification{
+			ID:               fmt.Sprintf("%s-%d", doc.GroupHeader.MessageID, len(doc.Notifications)+1),
+			CreationDateTime: doc.GroupHeader.CreationDateTime,
+			Account:          *otherAccount(notProvided, ""),
+		}
+		ntfctn.Account.Currency = currencyUSD
+		ntfctn.Account.Owner = organisation(strings.TrimSpace(bh.CompanyName), strings.TrimSpace(bh.CompanyIdentification))
+		ntfctn.Account.Servicer = routingAgent(odfi)
+		report.add(path+"/Acct", "NACHA files do not include the originator's account, %s was used", notProvided)
+
+		for _, ed := range batch.GetEntries() {
+			epath := fmt.Sprintf("%s/Entry[%s]", path, ed.TraceNumber)
+			entry, err := notificationEntry(bh, ed, epath, report)
+			if err != nil {
+				report.add(epath, "skipped: %v", err)
+				continue
+			}
+			ntfctn.Entries = append(ntfctn.Entries, *entry)
+		}
+		if len(ntfctn.Entries) > 0 {
+			doc.Notifications = append(doc.Notifications, ntfctn)
+		}
+	}
+	for _, batch := range file.Batches {
+		if !notified[batch] {
+			report.add(fmt.Sprintf("Batch[%d]", batch.GetHeader().BatchNumber), "not a return or notification of change, skipped")
+		}
+	}
+	for i := range file.IATBatches {
+		report.add(fmt.Sprintf("IATBatch[%d]", file.IATBatches[i].Header.BatchNumber), "IAT batches are not converted")
+	}
+	if len(doc.Notifications) == 0 {
+		return nil, report, errors.New("no returns or notifications of change to convert")
+	}
+	return doc, report, nil
+}
+
+func notificationEntry(bh *ach.BatchHeader, ed *ach.EntryDetail, path string, report *Report) (*NotificationEntry, error) {
+	entry := &NotificationEntry{
+		Reference:         ed.TraceNumber,
+		Amount:            Amount{Currency: currencyUSD, Value: formatAmount(ed.Amount)},
+		ServicerReference: ed.TraceNumber,
+	}
+	switch ed.CreditOrDebit() {
+	case "C":
+		entry.CreditDebit = "CRDT"
+		entry.TransactionCode.Family = "ICDT" // issued credit transfers
+	case "D":
+		entry.CreditDebit = "DBIT"
+		entry.TransactionCode.Family = "IDDT" // issued direct debits
+	default:
+		return nil, fmt.Errorf("unknown TransactionCode %d", ed.TransactionCode)
+	}
+	entry.TransactionCode.Domain = "PMNT"
+	entry.TransactionCode.Proprietary = &ProprietaryCode{Code: strconv.Itoa(ed.TransactionCode), Issuer: "NACHA"}
+
+	if d := isoDate(bh.EffectiveEntryDate); d != "" && d != bh.EffectiveEntryDate {
+		entry.BookingDate = &Date{Dt: d}
+		entry.ValueDate = &Date{Dt: d}
+	}
+
+	details := TransactionDetails{
+		References: &TransactionReferences{
+			ServicerReference: ed.TraceNumber,
+			EndToEndID:        notProvided,
+		},
+		Amount: &Amount{Currency: currencyUSD, Value: formatAmount(ed.Amount)},
+	}
+	if v := strings.TrimSpace(ed.IdentificationNumber); v != "" {
+		details.References.EndToEndID = v
+	}
+
+	// the receiver of the original entry
+	receiver := &Party{Name: receiverName(bh.StandardEntryClassCode, ed)}
+	receiverAcct := otherAccount(strings.TrimSpace(ed.DFIAccountNumber), accountType(ed.TransactionCode))
+	receiverAgent := routingAgent(ed.RDFIIdentification + ed.CheckDigit)
+	if entry.CreditDebit == "CRDT" {
+		details.RelatedParties = &RelatedParties{Creditor: receiver, CreditorAccount: receiverAcct}
+		details.RelatedAgents = &RelatedAgents{CreditorAgent: receiverAgent}
+	} else {
+		details.RelatedParties = &RelatedParties{Debtor: receiver, DebtorAccount: receiverAcct}
+		details.RelatedAgents = &RelatedAgents{DebtorAgent: receiverAgent}
+	}
+
+	switch {
+	case ed.Addenda99 != nil:
+		addenda := ed.Addenda99
+		entry.Status = "BOOK"
+		entry.Reversal = true
+		entry.TransactionCode.SubFamily = "RRTN" // reversal due to payment return
+		if entry.CreditDebit == "DBIT" {
+			entry.TransactionCode.SubFamily = "UPDD" // reversal due to return/unpaid direct debit
+		}
+		details.References.TransactionID = addenda.OriginalTrace
+
+		info := &ReturnInformation{Reason: &Code{Code: returnReasons[addenda.ReturnCode]}}
+		if info.Reason.Code == "" {
+			info.Reason.Code = "NARR"
+			report.add(path+"/Addenda99/ReturnCode", "%s has no ISO 20022 return reason, NARR was used", addenda.ReturnCode)
+		}
+		line := addenda.ReturnCode
+		if rc := addenda.ReturnCodeField(); rc != nil {
+			line += " " + rc.Reason
+		}
+		info.AdditionalInfo = append(info.AdditionalInfo, truncate(line, 105))
+		if v := strings.TrimSpace(addenda.AddendaInformation); v != "" {
+			info.AdditionalInfo = append(info.AdditionalInfo, v)
+		}
+		details.Return = info
+
+		details.AdditionalInfo = remarks(
+			"RTRCD", addenda.ReturnCode,
+			"ORGNLTRC", addenda.OriginalTrace,
+			"ORGNLDFI", addenda.OriginalDFI,
+			"DTOFDTH", strings.TrimSpace(addenda.DateOfDeath),
+		)
+
+	case ed.Addenda98 != nil:
+		addenda := ed.Addenda98
+		entry.Status = "INFO"
+		entry.TransactionCode.SubFamily = "OTHR"
+		details.References.TransactionID = addenda.OriginalTrace
+		if cc := addenda.ChangeCodeField(); cc != nil {
+			entry.AdditionalInfo = truncate(addenda.ChangeCode+" "+cc.Reason, 500)
+		}
+		details.AdditionalInfo = remarks(
+			"CHGCD", addenda.ChangeCode,
+			"CRRCTDDATA", strings.Join(strings.Fields(addenda.CorrectedData), " "),
+			"ORGNLTRC", addenda.OriginalTrace,
+			"ORGNLDFI", addenda.OriginalDFI,
+		)
+
+	default:
+		return nil, errors.New("missing Addenda98 or Addenda99")
+	}
+
+	entry.Details = append(entry.Details, details)
+	return entry, nil
+}
+
+// remarks formats pairs of keys and values as /KEY/value, empty values are skipped.
+func remarks(pairs ...string) string {
+	var buf strings.Builder
+	for i := 0; i+1 < len(pairs); i += 2 {
+		if v := strings.TrimSpace(pairs[i+1]); v != "" {
+			fmt.Fprintf(&buf, "/%s/%s", pairs[i], v)
+		}
+	}
+	return truncate(buf.String(), 500)
+}
+
+// truncate returns the first n characters of s, keeping multi-byte characters whole so
+// the result is still valid UTF-8 (and XML text).
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) > n {
+		return string([]rune(s)[:n])
+	}
+	return s
+}
+
+// WriteCamt054 converts file (see FileToCamt054) and writes the camt.054 XML to w.
+func WriteCamt054(w io.Writer, file *ach.File) (*Report, error) {
+	doc, report, err := FileToCamt054(file)
+	if err != nil {
+		return report, err
+	}
+	return report, writeDocument(w, doc)
+}
@@ -0,0 +1,157 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func readACHFile(t *testing.T, name string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &file
+}
+
+func TestCamt054__Returns(t *testing.T) {
+	file := readACHFile(t, "return-WEB.ach")
+
+	var buf bytes.Buffer
+	report, err := WriteCamt054(&buf, file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `<Document xmlns="`+Camt054Namespace+`">`) {
+		t.Errorf("unexpected XML: %s", buf.String())
+	}
+
+	var doc Camt054
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Notifications) != 2 {
+		t.Fatalf("got %d notifications", len(doc.Notifications))
+	}
+	ntfctn := doc.Notifications[0]
+	if ntfctn.Account.Owner.Name != "CoinLion" || ntfctn.Account.Owner.id() != "123456789" {
+		t.Errorf("unexpected account owner: %#v", ntfctn.Account.Owner)
+	}
+	if len(ntfctn.Entries) != 1 {
+		t.Fatalf("got %d entries", len(ntfctn.Entries))
+	}
+
+	entry := ntfctn.Entries[0]
+	if entry.Amount.Value != "123.54" || entry.CreditDebit != "DBIT" || !entry.Reversal || entry.Status != "BOOK" {
+		t.Errorf("unexpected entry: %#v", entry)
+	}
+	if tc := entry.TransactionCode; tc.Domain != "PMNT" || tc.Family != "IDDT" || tc.SubFamily != "UPDD" || tc.Proprietary.Code != "26" {
+		t.Errorf("unexpected BkTxCd: %#v", tc)
+	}
+	details := entry.Details[0]
+	if details.References.TransactionID != "091400600000001" || details.References.EndToEndID != "MjMxNDAwMjAtOGQ" {
+		t.Errorf("unexpected Refs: %#v", details.References)
+	}
+	if details.RelatedParties.Debtor.Name != "Paul Jones" || details.RelatedParties.DebtorAccount.number() != "123456789" {
+		t.Errorf("unexpected RltdPties: %#v", details.RelatedParties)
+	}
+	if details.Return.Reason.Code != "AM04" || details.Return.AdditionalInfo[0] != "R01 Insufficient Funds" {
+		t.Errorf("unexpected RtrInf: %#v", details.Return)
+	}
+	if details.AdditionalInfo != "/RTRCD/R01/ORGNLTRC/091400600000001/ORGNLDFI/09100001" {
+		t.Errorf("unexpected AddtlTxInf: %q", details.AdditionalInfo)
+	}
+
+	// R03 maps to AC01
+	if rsn := doc.Notifications[1].Entries[0].Details[0].Return.Reason.Code; rsn != "AC01" {
+		t.Errorf("unexpected reason: %s", rsn)
+	}
+	if !strings.Contains(report.String(), "Batch[1]/Acct") {
+		t.Errorf("expected Acct in report: %s", report)
+	}
+}
+
+func TestCamt054__NOC(t *testing.T) {
+	file := readACHFile(t, "cor-example.ach")
+
+	doc, _, err := FileToCamt054(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := doc.Notifications[0].Entries[0]
+	if entry.Amount.Value != "0.00" || entry.CreditDebit != "CRDT" || entry.Reversal || entry.Status != "INFO" {
+		t.Errorf("unexpected entry: %#v", entry)
+	}
+	if entry.AdditionalInfo != "C01 Incorrect bank account number" {
+		t.Errorf("unexpected AddtlNtryInf: %q", entry.AdditionalInfo)
+	}
+	details := entry.Details[0]
+	if details.Return != nil {
+		t.Errorf("unexpected RtrInf: %#v", details.Return)
+	}
+	if details.AdditionalInfo != "/CHGCD/C01/CRRCTDDATA/1918171614/ORGNLTRC/121042880000001/ORGNLDFI/12104288" {
+		t.Errorf("unexpected AddtlTxInf: %q", details.AdditionalInfo)
+	}
+	if details.RelatedParties.Creditor.Name != "Best Co. #23" || details.RelatedAgents.CreditorAgent.MemberID != "231380104" {
+		t.Errorf("unexpected RltdPties: %#v", details.RelatedParties)
+	}
+}
+
+func TestCamt054__errors(t *testing.T) {
+	if _, _, err := FileToCamt054(nil); err == nil {
+		t.Error("expected error")
+	}
+
+	file := readACHFile(t, "ppd-debit.ach")
+	_, report, err := FileToCamt054(file)
+	if err == nil {
+		t.Error("expected error")
+	}
+	if !strings.Contains(report.String(), "Batch[1]: not a return or notification of change, skipped") {
+		t.Errorf("unexpected report: %s", report)
+	}
+
+	// unknown return codes are written as NARR
+	file = readACHFile(t, "return-WEB.ach")
+	file.ReturnEntries[0].GetEntries()[0].Addenda99.ReturnCode = "R61"
+	doc, report, err := FileToCamt054(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rsn := doc.Notifications[0].Entries[0].Details[0].Return.Reason.Code; rsn != "NARR" {
+		t.Errorf("unexpected reason: %s", rsn)
+	}
+	if !strings.Contains(report.String(), "R61 has no ISO 20022 return reason") {
+		t.Errorf("unexpected report: %s", report)
+	}
+}
@@ -0,0 +1,295 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/ach"
+)
+
+// Options sets FileHeader values of ACH files converted from ISO 20022 messages. ImmediateOrigin and
+// ImmediateDestination default to the routing number of the first debtor agent.
+type Options struct {
+	ImmediateOrigin          string
+	ImmediateOriginName      string
+	ImmediateDestination     string
+	ImmediateDestinationName string
+}
+
+// Read converts a pain.001 or pacs.008 message into an ach.File, based on the message's root element.
+func Read(r io.Reader, opts *Options) (*ach.File, *Report, error) {
+	bs, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch kind := messageKind(bs); kind {
+	case "CstmrCdtTrfInitn":
+		return ReadPain001(bytes.NewReader(bs), opts)
+	case "FIToFICstmrCdtTrf":
+		return ReadPacs008(bytes.NewReader(bs), opts)
+	case "":
+		return nil, nil, errors.New("not an ISO 20022 Document")
+	default:
+		return nil, nil, fmt.Errorf("unsupported ISO 20022 message %s", kind)
+	}
+}
+
+// messageKind returns the name of the first element within Document.
+func messageKind(bs []byte) string {
+	dec := xml.NewDecoder(bytes.NewReader(bs))
+	depth := 0
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			if depth == 0 && start.Name.Local != "Document" {
+				return ""
+			}
+			if depth == 1 {
+				return start.Name.Local
+			}
+			depth++
+		}
+	}
+}
+
+// transfer is a credit transfer from either a pain.001 or pacs.008 message.
+type transfer struct {
+	path string
+
+	paymentType    *PaymentType
+	date           string
+	initiatingName string
+
+	debtor      *Party
+	debtorAgent *Agent
+
+	creditor      *Party
+	creditorAgent *Agent
+	creditorAcct  *Account
+
+	amount     Amount
+	paymentID  PaymentIdentification
+	remittance *RemittanceInformation
+}
+
+type batchKey struct {
+	sec     string
+	odfi    string
+	company ach.Company
+}
+
+// entryDescriptions maps ISO 20022 category purpose codes to a CompanyEntryDescription
+var entryDescriptions = map[string]string{
+	"SALA": "PAYROLL",
+	"PENS": "PENSION",
+	"SUPP": "SUPPLIER",
+	"TAXS": "TAX",
+	"TRAD": "TRADE",
+}
+
+func buildFile(gh *GroupHeader, transfers []transfer, opts *Options, report *Report) (*ach.File, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	var fb *ach.FileBuilder
+	batches := make(map[batchKey]*ach.BatchBuilder)
+	for i := range transfers {
+		key, ed, err := transfers[i].convert(report)
+		if err != nil {
+			report.add(transfers[i].path, "skipped: %v", err)
+			continue
+		}
+		if fb == nil {
+			fb = newFileBuilder(gh, key.odfi, opts)
+		}
+		bb, exists := batches[key]
+		if !exists {
+			odfi := key.odfi
+			bb = fb.AddBatch(key.sec, key.company).Header(func(bh *ach.BatchHeader) {
+				bh.ODFIIdentification = odfi[:8]
+			})
+			batches[key] = bb
+		}
+		bb.AddEntry(ed)
+	}
+	if fb == nil {
+		return nil, errors.New("no credit transfers to convert")
+	}
+	return fb.Build()
+}
+
+func newFileBuilder(gh *GroupHeader, odfi string, opts *Options) *ach.FileBuilder {
+	origin, destination := opts.ImmediateOrigin, opts.ImmediateDestination
+	if origin == "" {
+		origin = odfi
+	}
+	if destination == "" {
+		destination = odfi
+	}
+	fb := ach.NewFileBuilder(origin, destination).
+		OriginName(opts.ImmediateOriginName).
+		DestinationName(opts.ImmediateDestinationName).
+		ODFI(odfi)
+	if t, err := parseDateTime(gh.CreationDateTime); err == nil {
+		fb.CreatedAt(t)
+	}
+	return fb
+}
+
+func parseDateTime(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date time %q", s)
+}
+
+// convert returns the batch and EntryDetail for a transfer.
+func (t *transfer) convert(report *Report) (batchKey, *ach.EntryDetail, error) {
+	var key batchKey
+
+	odfi, err := t.debtorAgent.routingNumber()
+	if err != nil {
+		return key, nil, fmt.Errorf("DbtrAgt: %v", err)
+	}
+	rdfi, err := t.creditorAgent.routingNumber()
+	if err != nil {
+		return key, nil, fmt.Errorf("CdtrAgt: %v", err)
+	}
+	if t.creditorAcct == nil {
+		return key, nil, errors.New("missing CdtrAcct")
+	}
+	if t.creditorAcct.ID.IBAN != "" {
+		return key, nil, errors.New("CdtrAcct: IBAN accounts are not supported")
+	}
+	if t.creditorAcct.number() == "" {
+		return key, nil, errors.New("missing CdtrAcct/Id/Othr/Id")
+	}
+	if ccy := t.amount.Currency; ccy != "" && ccy != currencyUSD {
+		return key, nil, fmt.Errorf("currency %s is not supported", ccy)
+	}
+	amount, err := parseAmount(t.amount.Value)
+	if err != nil {
+		return key, nil, err
+	}
+
+	// Batch
+	key.odfi = odfi
+	key.sec = t.paymentType.sec()
+	if key.sec == "" {
+		key.sec = ach.PPD
+		if t.creditor.organisationID() != "" {
+			key.sec = ach.CCD
+		}
+		if t.paymentType != nil && t.paymentType.LocalInstrument.String() != "" {
+			report.add(t.path+"/PmtTpInf/LclInstrm", "unsupported local instrument, converted as %s", key.sec)
+		}
+	}
+	if t.debtor != nil {
+		key.company.Name = t.debtor.Name
+		key.company.Identification = t.debtor.id()
+	}
+	if key.company.Name == "" {
+		key.company.Name = t.initiatingName
+	}
+	if key.company.Identification == "" {
+		key.company.Identification = odfi
+		report.add(t.path+"/Dbtr/Id", "missing, the debtor agent's routing number was used")
+	}
+	key.company.EntryDescription = "PAYMENT"
+	if purpose := t.paymentType.categoryPurpose(); purpose != "" {
+		if desc, ok := entryDescriptions[strings.ToUpper(purpose)]; ok {
+			key.company.EntryDescription = desc
+		} else {
+			report.add(t.path+"/PmtTpInf/CtgyPurp", "%s has no CompanyEntryDescription, PAYMENT was used", purpose)
+		}
+	}
+	if t.date != "" {
+		d, err := time.Parse("2006-01-02", t.date)
+		if err != nil {
+			report.add(t.path, "invalid date %q, the next day was used", t.date)
+		} else {
+			key.company.EffectiveEntryDate = d
+		}
+	}
+
+	// Entry
+	ed := ach.NewEntryDetail()
+	ed.TransactionCode = ach.CheckingCredit
+	if strings.EqualFold(t.creditorAcct.Type.String(), "SVGS") {
+		ed.TransactionCode = ach.SavingsCredit
+	}
+	ed.SetRDFI(rdfi)
+	ed.DFIAccountNumber = t.creditorAcct.number()
+	ed.Amount = amount
+	ed.Category = ach.CategoryForward
+	if t.creditor != nil {
+		ed.IndividualName = t.creditor.Name
+	}
+	if id := t.paymentID.EndToEndID; id != "" && id != notProvided {
+		if utf8.RuneCountInString(id) > 15 {
+			report.add(t.path+"/PmtId/EndToEndId", "%q was truncated to 15 characters", id)
+			id = truncate(id, 15)
+		}
+		ed.IdentificationNumber = id
+	}
+	if n := len(ed.IndividualName); (key.sec == ach.CTX && n > 16) || n > 22 {
+		report.add(t.path+"/Cdtr/Nm", "%q was truncated", ed.IndividualName)
+	}
+
+	if t.remittance != nil {
+		if len(t.remittance.Structured) > 0 {
+			report.add(t.path+"/RmtInf/Strd", "structured remittance information is not converted")
+		}
+		for i, info := range t.remittance.Unstructured {
+			if key.sec != ach.CTX && i > 0 {
+				report.add(t.path+"/RmtInf/Ustrd", "%s entries allow one addenda record, %q was dropped", key.sec, info)
+				continue
+			}
+			if utf8.RuneCountInString(info) > 80 {
+				report.add(t.path+"/RmtInf/Ustrd", "%q was truncated to 80 characters", info)
+				info = truncate(info, 80)
+			}
+			addenda := ach.NewAddenda05()
+			addenda.PaymentRelatedInformation = info
+			ed.AddAddenda05(addenda)
+			ed.AddendaRecordIndicator = 1
+		}
+	}
+	if key.sec == ach.CTX {
+		name := ed.IndividualName
+		ed.IndividualName = ""
+		ed.SetCATXAddendaRecords(len(ed.Addenda05))
+		ed.SetCATXReceivingCompany(name)
+	}
+	return key, ed, nil
+}
@@ -0,0 +1,319 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package iso20022 converts between ACH files and ISO 20022 credit transfer messages.
+//
+// Credit batches (PPD, CCD and CTX) of an ach.File are converted into customer credit transfer
+// initiation (pain.001.001.03) messages and pain.001 or FI to FI customer credit transfer
+// (pacs.008.001.02) messages are converted into ach.File values. Returns and notifications of
+// change are converted into debit credit notification (camt.054.001.02) messages. NACHA and
+// ISO 20022 don't describe payments with the same fields, so each conversion returns a Report
+// of the fields which could not be mapped.
+package iso20022
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/ach"
+)
+
+// Report lists the fields which were dropped or changed in a conversion.
+type Report struct {
+	Unmapped []Unmapped `json:"unmapped"`
+}
+
+// Unmapped describes a field which could not be converted.
+type Unmapped struct {
+	// Path is the location of the field, as XML elements for ISO 20022
+	// messages or record names and numbers for ACH files.
+	Path string `json:"path"`
+
+	// Reason explains what happened to the field
+	Reason string `json:"reason"`
+}
+
+func (r *Report) add(path string, format string, args ...interface{}) {
+	r.Unmapped = append(r.Unmapped, Unmapped{
+		Path:   path,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// Empty returns true when every field was converted.
+func (r *Report) Empty() bool {
+	return r == nil || len(r.Unmapped) == 0
+}
+
+// String formats the Report with one unmapped field per line.
+func (r *Report) String() string {
+	if r.Empty() {
+		return ""
+	}
+	var buf strings.Builder
+	for i := range r.Unmapped {
+		fmt.Fprintf(&buf, "%s: %s\n", r.Unmapped[i].Path, r.Unmapped[i].Reason)
+	}
+	return buf.String()
+}
+
+const (
+	// clearingSystemUSABA identifies routing numbers in ClrSysMmbId elements
+	clearingSystemUSABA = "USABA"
+
+	// currencyUSD is the only currency supported by ACH
+	currencyUSD = "USD"
+
+	// notProvided is used for mandatory ISO 20022 elements without a NACHA value
+	notProvided = "NOTPROVIDED"
+)
+
+// Party is a debtor, creditor or initiating party.
+type Party struct {
+	Name string               `xml:"Nm,omitempty"`
+	ID   *PartyIdentification `xml:"Id,omitempty"`
+}
+
+// PartyIdentification identifies an organisation or a private person.
+type PartyIdentification struct {
+	Organisation *GenericIdentification `xml:"OrgId>Othr,omitempty"`
+	Private      *GenericIdentification `xml:"PrvtId>Othr,omitempty"`
+}
+
+// GenericIdentification is an identifier from a scheme other than BIC or IBAN.
+type GenericIdentification struct {
+	ID string `xml:"Id"`
+}
+
+func organisation(name, id string) *Party {
+	p := &Party{Name: name}
+	if id != "" {
+		p.ID = &PartyIdentification{Organisation: &GenericIdentification{ID: id}}
+	}
+	return p
+}
+
+func (p *Party) id() string {
+	if p == nil || p.ID == nil {
+		return ""
+	}
+	if org := p.ID.Organisation; org != nil && org.ID != "" {
+		return org.ID
+	}
+	if prvt := p.ID.Private; prvt != nil {
+		return prvt.ID
+	}
+	return ""
+}
+
+// organisationID returns the identifier of an organisation, it's empty for private persons.
+func (p *Party) organisationID() string {
+	if p == nil || p.ID == nil || p.ID.Organisation == nil {
+		return ""
+	}
+	return p.ID.Organisation.ID
+}
+
+// Account is a cash account identified by an IBAN or other (local) account number.
+type Account struct {
+	ID AccountIdentification `xml:"Id"`
+
+	// Type is an ISO 20022 cash account type, such as CACC (checking) or SVGS (savings)
+	Type     *Code  `xml:"Tp,omitempty"`
+	Currency string `xml:"Ccy,omitempty"`
+
+	// Owner and Servicer are only written in camt.054 notifications
+	Owner    *Party `xml:"Ownr,omitempty"`
+	Servicer *Agent `xml:"Svcr,omitempty"`
+}
+
+// AccountIdentification is either an IBAN or another account number.
+type AccountIdentification struct {
+	IBAN  string                 `xml:"IBAN,omitempty"`
+	Other *GenericIdentification `xml:"Othr,omitempty"`
+}
+
+func otherAccount(number, accountType string) *Account {
+	acct := &Account{ID: AccountIdentification{Other: &GenericIdentification{ID: number}}}
+	if accountType != "" {
+		acct.Type = &Code{Code: accountType}
+	}
+	return acct
+}
+
+// number returns the non-IBAN account number.
+func (a *Account) number() string {
+	if a == nil || a.ID.Other == nil {
+		return ""
+	}
+	return a.ID.Other.ID
+}
+
+func (a *Account) empty() bool {
+	return a == nil || (a.ID.IBAN == "" && a.number() == "")
+}
+
+// Code is a value from an external ISO 20022 code list or a proprietary value.
+type Code struct {
+	Code        string `xml:"Cd,omitempty"`
+	Proprietary string `xml:"Prtry,omitempty"`
+}
+
+// String returns the code, or the proprietary value when no code was set.
+func (c *Code) String() string {
+	if c == nil {
+		return ""
+	}
+	if c.Code != "" {
+		return c.Code
+	}
+	return c.Proprietary
+}
+
+// Agent is a financial institution identified by a BIC or clearing system member ID.
+type Agent struct {
+	// BIC is read from BIC (pain.001.001.03) or BICFI (later versions) elements
+	BIC   string `xml:"FinInstnId>BIC,omitempty"`
+	BICFI string `xml:"FinInstnId>BICFI,omitempty"`
+
+	ClearingSystem string `xml:"FinInstnId>ClrSysMmbId>ClrSysId>Cd,omitempty"`
+	MemberID       string `xml:"FinInstnId>ClrSysMmbId>MmbId,omitempty"`
+	Name           string `xml:"FinInstnId>Nm,omitempty"`
+}
+
+// routingNumber returns the ABA routing number of the Agent.
+func (a *Agent) routingNumber() (string, error) {
+	if a == nil {
+		return "", errors.New("missing financial institution")
+	}
+	if a.MemberID == "" {
+		if a.BIC != "" || a.BICFI != "" {
+			return "", errors.New("financial institutions identified by BIC are not supported")
+		}
+		return "", errors.New("missing ClrSysMmbId")
+	}
+	if cs := a.ClearingSystem; cs != "" && cs != clearingSystemUSABA {
+		return "", fmt.Errorf("unsupported clearing system %s", cs)
+	}
+	id := strings.TrimPrefix(a.MemberID, clearingSystemUSABA)
+	if err := ach.CheckRoutingNumber(id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func routingAgent(routingNumber string) *Agent {
+	return &Agent{
+		ClearingSystem: clearingSystemUSABA,
+		MemberID:       routingNumber,
+	}
+}
+
+// Amount is a decimal amount with its currency.
+type Amount struct {
+	Currency string `xml:"Ccy,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// PaymentIdentification identifies a transaction end to end.
+type PaymentIdentification struct {
+	InstructionID string `xml:"InstrId,omitempty"`
+	EndToEndID    string `xml:"EndToEndId"`
+	TransactionID string `xml:"TxId,omitempty"`
+}
+
+// PaymentType describes the service level, local instrument (SEC code) and purpose of payments.
+type PaymentType struct {
+	ServiceLevel    *Code `xml:"SvcLvl,omitempty"`
+	LocalInstrument *Code `xml:"LclInstrm,omitempty"`
+	CategoryPurpose *Code `xml:"CtgyPurp,omitempty"`
+}
+
+// sec returns the Standard Entry Class code from the local instrument.
+func (pt *PaymentType) sec() string {
+	if pt == nil || pt.LocalInstrument == nil {
+		return ""
+	}
+	for _, v := range []string{pt.LocalInstrument.Proprietary, pt.LocalInstrument.Code} {
+		switch v = strings.ToUpper(v); v {
+		case ach.PPD, ach.CCD, ach.CTX:
+			return v
+		}
+	}
+	return ""
+}
+
+func (pt *PaymentType) categoryPurpose() string {
+	if pt == nil {
+		return ""
+	}
+	return pt.CategoryPurpose.String()
+}
+
+// RemittanceInformation holds unstructured (and structured) details about a payment.
+type RemittanceInformation struct {
+	Unstructured []string `xml:"Ustrd,omitempty"`
+
+	// Structured remittance is kept as raw XML, it has no NACHA equivalent
+	Structured []struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"Strd,omitempty"`
+}
+
+// Date is an ISO date either as text (pain.001.001.03) or within a Dt element (later versions).
+type Date struct {
+	Value string `xml:",chardata"`
+	Dt    string `xml:"Dt,omitempty"`
+}
+
+func (d *Date) String() string {
+	if d == nil {
+		return ""
+	}
+	if v := strings.TrimSpace(d.Dt); v != "" {
+		return v
+	}
+	return strings.TrimSpace(d.Value)
+}
+
+// formatAmount returns cents as a decimal amount (e.g. 12.34).
+func formatAmount(cents int) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
+
+// parseAmount returns the cents of a decimal amount.
+func parseAmount(s string) (int, error) {
+	s = strings.TrimSpace(s)
+	whole, frac := s, ""
+	if idx := strings.Index(s, "."); idx >= 0 {
+		whole, frac = s[:idx], s[idx+1:]
+	}
+	frac = strings.TrimRight(frac, "0")
+	if len(frac) > 2 {
+		return 0, fmt.Errorf("amount %s has fractional cents", s)
+	}
+	for len(frac) < 2 {
+		frac += "0"
+	}
+	n, err := strconv.Atoi(whole + frac)
+	if err != nil || n < 0 || whole == "" {
+		return 0, fmt.Errorf("invalid amount %q", s)
+	}
+	return n, nil
+}
@@ -0,0 +1,94 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	cases := map[string]int{
+		"12.34":   1234,
+		"0.5":     50,
+		"100":     10000,
+		"1.230":   123,
+		" 7.00 ":  700,
+		"0.01":    1,
+		"1000000": 100000000,
+	}
+	for input, expected := range cases {
+		n, err := parseAmount(input)
+		if err != nil {
+			t.Fatalf("%q: %v", input, err)
+		}
+		if n != expected {
+			t.Errorf("%q: got %d", input, n)
+		}
+	}
+	for _, input := range []string{"", "abc", "1.234", "-5.00", ".50"} {
+		if _, err := parseAmount(input); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+	if v := formatAmount(123456); v != "1234.56" {
+		t.Errorf("formatAmount=%q", v)
+	}
+	if v := formatAmount(5); v != "0.05" {
+		t.Errorf("formatAmount=%q", v)
+	}
+}
+
+func TestReport(t *testing.T) {
+	var r *Report
+	if !r.Empty() || r.String() != "" {
+		t.Error("expected empty report")
+	}
+	r = &Report{}
+	r.add("PmtInf[1]", "skipped: %v", "bad")
+	if r.Empty() || r.String() != "PmtInf[1]: skipped: bad\n" {
+		t.Errorf("unexpected report: %q", r.String())
+	}
+}
+
+func TestRead(t *testing.T) {
+	for _, name := range []string{"pain001.xml", "pacs008.xml"} {
+		fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, _, err := Read(fd, nil)
+		fd.Close()
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(file.Batches) == 0 {
+			t.Errorf("%s: no batches", name)
+		}
+	}
+
+	if _, _, err := Read(strings.NewReader(`<File></File>`), nil); err == nil {
+		t.Error("expected error")
+	}
+	_, _, err := Read(strings.NewReader(`<Document><BkToCstmrDbtCdtNtfctn></BkToCstmrDbtCdtNtfctn></Document>`), nil)
+	if err == nil || !strings.Contains(err.Error(), "unsupported ISO 20022 message BkToCstmrDbtCdtNtfctn") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
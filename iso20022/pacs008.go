@@ -0,0 +1,94 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/ach"
+)
+
+// Pacs008 is a FI to FI customer credit transfer (pacs.008) message.
+type Pacs008 struct {
+	XMLName xml.Name `xml:"Document"`
+
+	GroupHeader  GroupHeader               `xml:"FIToFICstmrCdtTrf>GrpHdr"`
+	Transactions []InterbankCreditTransfer `xml:"FIToFICstmrCdtTrf>CdtTrfTxInf"`
+}
+
+// InterbankCreditTransfer is one payment between financial institutions on behalf of a debtor.
+type InterbankCreditTransfer struct {
+	PaymentID        PaymentIdentification  `xml:"PmtId"`
+	PaymentType      *PaymentType           `xml:"PmtTpInf,omitempty"`
+	SettlementAmount Amount                 `xml:"IntrBkSttlmAmt"`
+	SettlementDate   string                 `xml:"IntrBkSttlmDt,omitempty"`
+	ChargeBearer     string                 `xml:"ChrgBr,omitempty"`
+	Debtor           *Party                 `xml:"Dbtr,omitempty"`
+	DebtorAccount    *Account               `xml:"DbtrAcct,omitempty"`
+	DebtorAgent      *Agent                 `xml:"DbtrAgt,omitempty"`
+	CreditorAgent    *Agent                 `xml:"CdtrAgt,omitempty"`
+	Creditor         *Party                 `xml:"Cdtr,omitempty"`
+	CreditorAcct     *Account               `xml:"CdtrAcct,omitempty"`
+	Remittance       *RemittanceInformation `xml:"RmtInf,omitempty"`
+}
+
+// ReadPacs008 reads a pacs.008 message and converts its credit transfers into an ach.File.
+// Transactions are grouped into batches by debtor, local instrument and settlement date.
+func ReadPacs008(r io.Reader, opts *Options) (*ach.File, *Report, error) {
+	var doc Pacs008
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("problem reading pacs.008: %v", err)
+	}
+	return doc.File(opts)
+}
+
+// File converts the credit transfers of the pacs.008 message into an ach.File.
+func (doc *Pacs008) File(opts *Options) (*ach.File, *Report, error) {
+	report := &Report{}
+	transfers := make([]transfer, 0, len(doc.Transactions))
+	for i := range doc.Transactions {
+		tx := &doc.Transactions[i]
+		path := fmt.Sprintf("CdtTrfTxInf[%d]", i+1)
+
+		date := tx.SettlementDate
+		if date == "" {
+			date = doc.GroupHeader.SettlementDate
+		}
+		if !tx.DebtorAccount.empty() {
+			report.add(path+"/DbtrAcct", "NACHA files do not include the originator's account")
+		}
+		transfers = append(transfers, transfer{
+			path:           path,
+			paymentType:    tx.PaymentType,
+			date:           date,
+			debtor:         tx.Debtor,
+			debtorAgent:    tx.DebtorAgent,
+			creditor:       tx.Creditor,
+			creditorAgent:  tx.CreditorAgent,
+			creditorAcct:   tx.CreditorAcct,
+			amount:         tx.SettlementAmount,
+			paymentID:      tx.PaymentID,
+			remittance:     tx.Remittance,
+			initiatingName: partyName(doc.GroupHeader.InitiatingParty),
+		})
+	}
+	file, err := buildFile(&doc.GroupHeader, transfers, opts, report)
+	return file, report, err
+}
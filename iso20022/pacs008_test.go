@@ -0,0 +1,82 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+)
+
+func TestPacs008__Read(t *testing.T) {
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "pacs008.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, report, err := ReadPacs008(fd, &Options{ImmediateOrigin: "121042882", ImmediateDestination: "231380104"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Header.FileCreationDate != "200612" || file.Header.FileCreationTime != "1405" {
+		t.Errorf("unexpected FileHeader: %#v", file.Header)
+	}
+
+	// the second transaction has no local instrument and is a PPD batch
+	if len(file.Batches) != 2 {
+		t.Fatalf("got %d batches", len(file.Batches))
+	}
+	bh := file.Batches[0].GetHeader()
+	if bh.StandardEntryClassCode != ach.CCD || bh.CompanyName != "Widgets Inc" || bh.CompanyIdentification != "1234567890" || bh.EffectiveEntryDate != "200615" {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+	ed := file.Batches[0].GetEntries()[0]
+	if ed.Amount != 10000 || ed.IdentificationNumber != "E2E-1" || ed.Addenda05[0].PaymentRelatedInformation != "PO 1234" {
+		t.Errorf("unexpected entry: %#v", ed)
+	}
+	if bh := file.Batches[1].GetHeader(); bh.StandardEntryClassCode != ach.PPD {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+	if ed := file.Batches[1].GetEntries()[0]; ed.TransactionCode != ach.SavingsCredit || ed.Amount != 25000 {
+		t.Errorf("unexpected entry: %#v", ed)
+	}
+
+	if !strings.Contains(report.String(), "CdtTrfTxInf[2]/RmtInf/Strd") {
+		t.Errorf("expected structured remittance in report: %s", report)
+	}
+}
+
+func TestPacs008__errors(t *testing.T) {
+	if _, _, err := ReadPacs008(strings.NewReader("<Document"), nil); err == nil {
+		t.Error("expected error")
+	}
+
+	// no convertible transactions
+	doc := `<Document><FIToFICstmrCdtTrf><CdtTrfTxInf><IntrBkSttlmAmt Ccy="USD">1.00</IntrBkSttlmAmt></CdtTrfTxInf></FIToFICstmrCdtTrf></Document>`
+	_, report, err := ReadPacs008(strings.NewReader(doc), nil)
+	if err == nil {
+		t.Error("expected error")
+	}
+	if !strings.Contains(report.String(), "CdtTrfTxInf[1]: skipped: DbtrAgt: missing financial institution") {
+		t.Errorf("unexpected report: %s", report)
+	}
+}
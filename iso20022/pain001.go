@@ -0,0 +1,331 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/ach"
+)
+
+// Pain001Namespace is the XML namespace of the messages created by FileToPain001
+const Pain001Namespace = "urn:iso:std:iso:20022:tech:xsd:pain.001.001.03"
+
+// Pain001 is a customer credit transfer initiation (pain.001) message.
+type Pain001 struct {
+	XMLName   xml.Name `xml:"Document"`
+	Namespace string   `xml:"xmlns,attr,omitempty"`
+
+	GroupHeader         GroupHeader          `xml:"CstmrCdtTrfInitn>GrpHdr"`
+	PaymentInstructions []PaymentInstruction `xml:"CstmrCdtTrfInitn>PmtInf"`
+}
+
+// GroupHeader describes every transaction in a message.
+type GroupHeader struct {
+	MessageID            string                 `xml:"MsgId"`
+	CreationDateTime     string                 `xml:"CreDtTm"`
+	NumberOfTransactions int                    `xml:"NbOfTxs"`
+	ControlSum           string                 `xml:"CtrlSum,omitempty"`
+	InitiatingParty      *Party                 `xml:"InitgPty,omitempty"`
+	TotalSettlement      *Amount                `xml:"TtlIntrBkSttlmAmt,omitempty"`
+	SettlementDate       string                 `xml:"IntrBkSttlmDt,omitempty"`
+	Settlement           *SettlementInstruction `xml:"SttlmInf,omitempty"`
+}
+
+// SettlementInstruction describes how a pacs.008 message is settled.
+type SettlementInstruction struct {
+	Method string `xml:"SttlmMtd"`
+}
+
+// PaymentInstruction is a set of credit transfers from one debtor account.
+type PaymentInstruction struct {
+	PaymentInformationID string       `xml:"PmtInfId"`
+	PaymentMethod        string       `xml:"PmtMtd"`
+	BatchBooking         string       `xml:"BtchBookg,omitempty"`
+	NumberOfTransactions int          `xml:"NbOfTxs"`
+	ControlSum           string       `xml:"CtrlSum,omitempty"`
+	PaymentType          *PaymentType `xml:"PmtTpInf,omitempty"`
+	RequestedExecution   Date         `xml:"ReqdExctnDt"`
+	Debtor               Party        `xml:"Dbtr"`
+	DebtorAccount        Account      `xml:"DbtrAcct"`
+	DebtorAgent          Agent        `xml:"DbtrAgt"`
+	ChargeBearer         string       `xml:"ChrgBr,omitempty"`
+
+	Transactions []CreditTransferTransaction `xml:"CdtTrfTxInf"`
+}
+
+// CreditTransferTransaction is one payment to a creditor.
+type CreditTransferTransaction struct {
+	PaymentID     PaymentIdentification  `xml:"PmtId"`
+	PaymentType   *PaymentType           `xml:"PmtTpInf,omitempty"`
+	Amount        Amount                 `xml:"Amt>InstdAmt"`
+	CreditorAgent *Agent                 `xml:"CdtrAgt,omitempty"`
+	Creditor      *Party                 `xml:"Cdtr,omitempty"`
+	CreditorAcct  *Account               `xml:"CdtrAcct,omitempty"`
+	Remittance    *RemittanceInformation `xml:"RmtInf,omitempty"`
+}
+
+// creditSEC are the Standard Entry Class codes which can be converted into pain.001 messages.
+func creditSEC(sec string) bool {
+	switch sec {
+	case ach.PPD, ach.CCD, ach.CTX:
+		return true
+	}
+	return false
+}
+
+// FileToPain001 converts the credit entries of PPD, CCD and CTX batches into a pain.001 message.
+// Each batch is written as a PmtInf element.
+//
+// Debit entries, other batch types and addenda besides Addenda05 are listed in the Report.
+func FileToPain001(file *ach.File) (*Pain001, *Report, error) {
+	if file == nil {
+		return nil, nil, errors.New("nil File")
+	}
+	report := &Report{}
+	doc := &Pain001{
+		Namespace: Pain001Namespace,
+		GroupHeader: GroupHeader{
+			MessageID:        messageID(file),
+			CreationDateTime: creationDateTime(file.Header),
+			InitiatingParty:  organisation(strings.TrimSpace(file.Header.ImmediateOriginName), strings.TrimSpace(file.Header.ImmediateOrigin)),
+		},
+	}
+
+	total := 0
+	for i, batch := range file.Batches {
+		bh := batch.GetHeader()
+		path := fmt.Sprintf("Batch[%d]", bh.BatchNumber)
+		if !creditSEC(bh.StandardEntryClassCode) {
+			report.add(path, "%s batches are not credit transfers and were skipped", bh.StandardEntryClassCode)
+			continue
+		}
+		odfi := bh.ODFIIdentification + strconv.Itoa((&ach.EntryDetail{}).CalculateCheckDigit(bh.ODFIIdentification))
+		pmt := PaymentInstruction{
+			PaymentInformationID: fmt.Sprintf("%s-%d", doc.GroupHeader.MessageID, i+1),
+			PaymentMethod:        "TRF",
+			BatchBooking:         "true",
+			PaymentType: &PaymentType{
+				ServiceLevel:    &Code{Code: "NURG"},
+				LocalInstrument: &Code{Proprietary: bh.StandardEntryClassCode},
+			},
+			RequestedExecution: Date{Value: isoDate(bh.EffectiveEntryDate)},
+			Debtor:             *organisation(strings.TrimSpace(bh.CompanyName), strings.TrimSpace(bh.CompanyIdentification)),
+			DebtorAccount:      *otherAccount(notProvided, ""),
+			DebtorAgent:        *routingAgent(odfi),
+			ChargeBearer:       "SLEV",
+		}
+		report.add(path+"/DbtrAcct", "NACHA files do not include the originator's account, %s was used", notProvided)
+		if v := strings.TrimSpace(bh.CompanyEntryDescription); v != "" {
+			report.add(path+"/CompanyEntryDescription", "%q has no pain.001 equivalent", v)
+		}
+		if v := strings.TrimSpace(bh.CompanyDiscretionaryData); v != "" {
+			report.add(path+"/CompanyDiscretionaryData", "%q has no pain.001 equivalent", v)
+		}
+
+		sum := 0
+		for _, ed := range batch.GetEntries() {
+			epath := fmt.Sprintf("%s/Entry[%s]", path, ed.TraceNumber)
+			if ed.CreditOrDebit() != "C" {
+				report.add(epath, "debit entries are not credit transfers and were skipped")
+				continue
+			}
+			tx := CreditTransferTransaction{
+				PaymentID: PaymentIdentification{
+					InstructionID: ed.TraceNumber,
+					EndToEndID:    notProvided,
+				},
+				Amount:        Amount{Currency: currencyUSD, Value: formatAmount(ed.Amount)},
+				CreditorAgent: routingAgent(ed.RDFIIdentification + ed.CheckDigit),
+				Creditor:      &Party{Name: receiverName(bh.StandardEntryClassCode, ed)},
+				CreditorAcct:  otherAccount(strings.TrimSpace(ed.DFIAccountNumber), accountType(ed.TransactionCode)),
+			}
+			if v := strings.TrimSpace(ed.IdentificationNumber); v != "" {
+				tx.PaymentID.EndToEndID = v
+			}
+			for _, addenda := range ed.Addenda05 {
+				if tx.Remittance == nil {
+					tx.Remittance = &RemittanceInformation{}
+				}
+				tx.Remittance.Unstructured = append(tx.Remittance.Unstructured, strings.TrimSpace(addenda.PaymentRelatedInformation))
+			}
+			if v := strings.TrimSpace(ed.DiscretionaryData); v != "" {
+				report.add(epath+"/DiscretionaryData", "%q has no pain.001 equivalent", v)
+			}
+			if ed.Addenda02 != nil || ed.Addenda98 != nil || ed.Addenda99 != nil {
+				report.add(epath, "only Addenda05 records are converted")
+			}
+			switch ed.TransactionCode {
+			case ach.CheckingPrenoteCredit, ach.SavingsPrenoteCredit, ach.CheckingZeroDollarRemittanceCredit, ach.SavingsZeroDollarRemittanceCredit:
+				report.add(epath+"/TransactionCode", "%d is converted as a zero amount credit transfer", ed.TransactionCode)
+			}
+			sum += ed.Amount
+			pmt.Transactions = append(pmt.Transactions, tx)
+		}
+		if len(pmt.Transactions) == 0 {
+			continue
+		}
+		pmt.NumberOfTransactions = len(pmt.Transactions)
+		pmt.ControlSum = formatAmount(sum)
+		doc.PaymentInstructions = append(doc.PaymentInstructions, pmt)
+
+		doc.GroupHeader.NumberOfTransactions += pmt.NumberOfTransactions
+		total += sum
+	}
+	for i := range file.IATBatches {
+		report.add(fmt.Sprintf("IATBatch[%d]", file.IATBatches[i].Header.BatchNumber), "IAT batches are not converted")
+	}
+	if len(doc.PaymentInstructions) == 0 {
+		return nil, report, errors.New("no credit entries to convert")
+	}
+	doc.GroupHeader.ControlSum = formatAmount(total)
+	return doc, report, nil
+}
+
+// WritePain001 converts file (see FileToPain001) and writes the pain.001 XML to w.
+func WritePain001(w io.Writer, file *ach.File) (*Report, error) {
+	doc, report, err := FileToPain001(file)
+	if err != nil {
+		return report, err
+	}
+	return report, writeDocument(w, doc)
+}
+
+// writeDocument writes doc as indented XML with a header.
+func writeDocument(w io.Writer, doc interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// ReadPain001 reads a pain.001 message and converts its credit transfers into an ach.File
+// with a batch for each PmtInf element. Transactions which can't be converted are skipped
+// and listed in the Report.
+func ReadPain001(r io.Reader, opts *Options) (*ach.File, *Report, error) {
+	var doc Pain001
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, nil, fmt.Errorf("problem reading pain.001: %v", err)
+	}
+	return doc.File(opts)
+}
+
+// File converts the credit transfers of the pain.001 message into an ach.File.
+func (doc *Pain001) File(opts *Options) (*ach.File, *Report, error) {
+	report := &Report{}
+	var transfers []transfer
+	for i := range doc.PaymentInstructions {
+		pmt := &doc.PaymentInstructions[i]
+		path := fmt.Sprintf("PmtInf[%d]", i+1)
+		if m := strings.ToUpper(pmt.PaymentMethod); m != "" && m != "TRF" {
+			report.add(path+"/PmtMtd", "payment method %s is not a credit transfer, skipped", pmt.PaymentMethod)
+			continue
+		}
+		if !pmt.DebtorAccount.empty() {
+			report.add(path+"/DbtrAcct", "NACHA files do not include the originator's account")
+		}
+		for j := range pmt.Transactions {
+			tx := &pmt.Transactions[j]
+			pt := pmt.PaymentType
+			if tx.PaymentType != nil {
+				pt = tx.PaymentType
+			}
+			transfers = append(transfers, transfer{
+				path:           fmt.Sprintf("%s/CdtTrfTxInf[%d]", path, j+1),
+				paymentType:    pt,
+				date:           pmt.RequestedExecution.String(),
+				debtor:         &pmt.Debtor,
+				debtorAgent:    &pmt.DebtorAgent,
+				creditor:       tx.Creditor,
+				creditorAgent:  tx.CreditorAgent,
+				creditorAcct:   tx.CreditorAcct,
+				amount:         tx.Amount,
+				paymentID:      tx.PaymentID,
+				remittance:     tx.Remittance,
+				initiatingName: partyName(doc.GroupHeader.InitiatingParty),
+			})
+		}
+	}
+	file, err := buildFile(&doc.GroupHeader, transfers, opts, report)
+	return file, report, err
+}
+
+func partyName(p *Party) string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
+
+// messageID returns the file's ID or an identifier derived from the FileHeader.
+func messageID(file *ach.File) string {
+	if file.ID != "" {
+		return file.ID
+	}
+	fh := file.Header
+	return strings.TrimSpace(fh.ImmediateOrigin) + fh.FileCreationDate + fh.FileCreationTime + fh.FileIDModifier
+}
+
+func creationDateTime(fh ach.FileHeader) string {
+	t, err := time.Parse("0601021504", fh.FileCreationDate+fh.FileCreationTime)
+	if err != nil {
+		t, err = time.Parse("060102", fh.FileCreationDate)
+		if err != nil {
+			t = time.Now()
+		}
+	}
+	return t.Format("2006-01-02T15:04:05")
+}
+
+// isoDate converts a YYMMDD date into YYYY-MM-DD
+func isoDate(yymmdd string) string {
+	t, err := time.Parse("060102", yymmdd)
+	if err != nil {
+		return yymmdd
+	}
+	return t.Format("2006-01-02")
+}
+
+func accountType(code int) string {
+	switch code {
+	case ach.SavingsCredit, ach.SavingsDebit, ach.SavingsPrenoteCredit, ach.SavingsPrenoteDebit,
+		ach.SavingsZeroDollarRemittanceCredit, ach.SavingsZeroDollarRemittanceDebit:
+		return "SVGS"
+	}
+	return "CACC"
+}
+
+// receiverName returns IndividualName, or the receiving company of CTX entries.
+func receiverName(sec string, ed *ach.EntryDetail) string {
+	if sec == ach.CTX && len(ed.IndividualName) >= 20 {
+		return ed.CATXReceivingCompanyField()
+	}
+	return strings.TrimSpace(ed.IndividualName)
+}
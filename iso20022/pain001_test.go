@@ -0,0 +1,226 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package iso20022
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/moov-io/ach"
+)
+
+func readPain001(t *testing.T) (*ach.File, *Report) {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "pain001.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, report, err := ReadPain001(fd, &Options{ImmediateDestination: "231380104", ImmediateDestinationName: "Federal Reserve Bank"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file, report
+}
+
+func TestPain001__Read(t *testing.T) {
+	file, report := readPain001(t)
+
+	if file.Header.ImmediateOrigin != "121042882" || file.Header.ImmediateDestination != "231380104" {
+		t.Errorf("unexpected FileHeader: %#v", file.Header)
+	}
+	if file.Header.FileCreationDate != "200612" || file.Header.FileCreationTime != "0930" {
+		t.Errorf("unexpected FileHeader: %#v", file.Header)
+	}
+	if len(file.Batches) != 2 {
+		t.Fatalf("got %d batches", len(file.Batches))
+	}
+
+	// PPD batch
+	bh := file.Batches[0].GetHeader()
+	if bh.StandardEntryClassCode != ach.PPD || bh.CompanyName != "My Company" || bh.CompanyIdentification != "121042882" {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+	if bh.CompanyEntryDescription != "PAYROLL" || bh.EffectiveEntryDate != "200615" || bh.ServiceClassCode != ach.CreditsOnly {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+	entries := file.Batches[0].GetEntries()
+	if len(entries) != 2 {
+		t.Fatalf("got %d entries", len(entries))
+	}
+	if ed := entries[0]; ed.TransactionCode != ach.CheckingCredit || ed.Amount != 125000 || ed.IdentificationNumber != "EMP-1001" || ed.IndividualName != "Jane Doe" {
+		t.Errorf("unexpected entry: %#v", ed)
+	}
+	if ed := entries[0]; len(ed.Addenda05) != 1 || ed.Addenda05[0].PaymentRelatedInformation != "June payroll" {
+		t.Errorf("unexpected addenda: %#v", ed.Addenda05)
+	}
+	if ed := entries[1]; ed.TransactionCode != ach.SavingsCredit || ed.Amount != 98055 || ed.IdentificationNumber != "" {
+		t.Errorf("unexpected entry: %#v", ed)
+	}
+
+	// CTX batch, the EUR payment is skipped
+	bh = file.Batches[1].GetHeader()
+	if bh.StandardEntryClassCode != ach.CTX {
+		t.Errorf("unexpected BatchHeader: %#v", bh)
+	}
+	entries = file.Batches[1].GetEntries()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries", len(entries))
+	}
+	if ed := entries[0]; ed.CATXReceivingCompanyField() != "Acme Corp" || ed.CATXAddendaRecordsField() != "0002" || len(ed.Addenda05) != 2 {
+		t.Errorf("unexpected CTX entry: %#v", ed)
+	}
+
+	out := report.String()
+	if !strings.Contains(out, "PmtInf[1]/DbtrAcct") {
+		t.Errorf("expected DbtrAcct in report: %s", out)
+	}
+	if !strings.Contains(out, "PmtInf[2]/CdtTrfTxInf[2]: skipped") {
+		t.Errorf("expected skipped EUR payment in report: %s", out)
+	}
+}
+
+func TestPain001__FromFile(t *testing.T) {
+	file, err := ach.NewFileBuilder("121042882", "231380104").
+		OriginName("My Bank Name").
+		CreatedAt(time.Date(2020, time.June, 12, 9, 30, 0, 0, time.UTC)).
+		AddPPDBatch(ach.Company{Name: "My Company", Identification: "121042882", EntryDescription: "PAYROLL", EffectiveEntryDate: time.Date(2020, time.June, 15, 0, 0, 0, 0, time.UTC)}).
+		Credit(ach.Account{RoutingNumber: "231380104", Number: "81967038518"}, 125000, "Jane Doe").
+		IdentificationNumber("EMP-1001").
+		Addenda("June payroll").
+		Credit(ach.Account{RoutingNumber: "231380104", Number: "12345678", Savings: true}, 98055, "John Smith").
+		Debit(ach.Account{RoutingNumber: "231380104", Number: "12345678"}, 100, "John Smith").
+		AddCTXBatch(ach.Company{Name: "My Company", Identification: "121042882", EntryDescription: "VENDOR"}).
+		Credit(ach.Account{RoutingNumber: "231380104", Number: "744-5678-99"}, 2500, "Acme Corp").
+		Addenda("INVOICE 42").
+		AddBatch(ach.WEB, ach.Company{Name: "My Company", Identification: "121042882", EntryDescription: "TRANSFER"}).
+		Credit(ach.Account{RoutingNumber: "231380104", Number: "12345678"}, 100, "John Smith").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, report, err := FileToPain001(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc.GroupHeader.NumberOfTransactions != 3 || doc.GroupHeader.ControlSum != "2255.55" {
+		t.Errorf("unexpected GrpHdr: %#v", doc.GroupHeader)
+	}
+	if doc.GroupHeader.CreationDateTime != "2020-06-12T09:30:00" {
+		t.Errorf("CreDtTm=%q", doc.GroupHeader.CreationDateTime)
+	}
+	if len(doc.PaymentInstructions) != 2 {
+		t.Fatalf("got %d PmtInf", len(doc.PaymentInstructions))
+	}
+	pmt := doc.PaymentInstructions[0]
+	if pmt.RequestedExecution.String() != "2020-06-15" || pmt.DebtorAgent.MemberID != "121042882" || pmt.PaymentType.sec() != ach.PPD {
+		t.Errorf("unexpected PmtInf: %#v", pmt)
+	}
+	if tx := pmt.Transactions[0]; tx.Amount.Value != "1250.00" || tx.CreditorAgent.MemberID != "231380104" || tx.PaymentID.EndToEndID != "EMP-1001" || tx.Remittance.Unstructured[0] != "June payroll" {
+		t.Errorf("unexpected CdtTrfTxInf: %#v", tx)
+	}
+	if tx := pmt.Transactions[1]; tx.CreditorAcct.Type.String() != "SVGS" || tx.PaymentID.EndToEndID != notProvided {
+		t.Errorf("unexpected CdtTrfTxInf: %#v", tx)
+	}
+	if tx := doc.PaymentInstructions[1].Transactions[0]; tx.Creditor.Name != "Acme Corp" {
+		t.Errorf("unexpected CTX creditor: %#v", tx.Creditor)
+	}
+
+	out := report.String()
+	for _, expected := range []string{"debit entries are not credit transfers", "WEB batches are not credit transfers"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in report: %s", expected, out)
+		}
+	}
+
+	// write and read the message back
+	var buf bytes.Buffer
+	if _, err := WritePain001(&buf, file); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `<Document xmlns="`+Pain001Namespace+`">`) {
+		t.Errorf("unexpected XML: %s", buf.String())
+	}
+	var decoded Pain001
+	if err := xml.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	converted, _, err := decoded.File(&Options{ImmediateDestination: "231380104"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if converted.Control.TotalCreditEntryDollarAmountInFile != 225555 || converted.Control.EntryAddendaCount != 5 {
+		t.Errorf("unexpected FileControl: %#v", converted.Control)
+	}
+}
+
+func TestPain001__ReadNonASCII(t *testing.T) {
+	bs, err := os.ReadFile(filepath.Join("..", "test", "testdata", "pain001.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs = bytes.Replace(bs, []byte("<Ustrd>June payroll</Ustrd>"), []byte("<Ustrd>"+strings.Repeat("é", 100)+"</Ustrd>"), 1)
+	bs = bytes.Replace(bs, []byte("<EndToEndId>EMP-1001</EndToEndId>"), []byte("<EndToEndId>EMP-1001-1ÄÖÜäöü</EndToEndId>"), 1)
+
+	// ACH files only allow ASCII, but values are truncated without splitting characters
+	_, report, err := ReadPain001(bytes.NewReader(bs), nil)
+	if err == nil || !strings.Contains(err.Error(), "EMP-1001-1ÄÖÜäö ") || !utf8.ValidString(err.Error()) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	out := report.String()
+	if !strings.Contains(out, "truncated to 15 characters") || !strings.Contains(out, "truncated to 80 characters") {
+		t.Errorf("expected truncations in report: %s", out)
+	}
+	if v := truncate(strings.Repeat("é", 100), 80); v != strings.Repeat("é", 80) {
+		t.Errorf("truncate=%q", v)
+	}
+}
+
+func TestPain001__errors(t *testing.T) {
+	if _, _, err := FileToPain001(nil); err == nil {
+		t.Error("expected error")
+	}
+
+	file, err := ach.NewFileBuilder("121042882", "231380104").
+		AddPPDBatch(ach.Company{Name: "My Company", Identification: "121042882", EntryDescription: "PAYMENT"}).
+		Debit(ach.Account{RoutingNumber: "231380104", Number: "12345678"}, 100, "John Smith").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := FileToPain001(file); err == nil {
+		t.Error("expected error with no credits")
+	}
+
+	if _, _, err := ReadPain001(strings.NewReader("<Document>"), nil); err == nil {
+		t.Error("expected error")
+	}
+	_, report, err := ReadPain001(strings.NewReader(`<Document><CstmrCdtTrfInitn><PmtInf><PmtMtd>CHK</PmtMtd></PmtInf></CstmrCdtTrfInitn></Document>`), nil)
+	if err == nil || !strings.Contains(report.String(), "not a credit transfer") {
+		t.Errorf("unexpected error=%v report=%s", err, report)
+	}
+}
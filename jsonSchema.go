@@ -0,0 +1,309 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/base"
+)
+
+// JSONError describes a key or value in JSON data which doesn't match the JSON model
+// of a File. Path points to the offending element (e.g. batches[0].entryDetails[1].amount).
+type JSONError struct {
+	Path string
+	Msg  string
+}
+
+func (e *JSONError) Error() string {
+	if e.Path == "" {
+		return e.Msg
+	}
+	return fmt.Sprintf("%s %s", e.Path, e.Msg)
+}
+
+// FileFromJSONStrict is FileFromJSON which first checks the input against JSONSchema.
+// Unknown (or misspelled) keys and values of the wrong type are rejected as a
+// base.ErrorList of *JSONError values instead of being skipped over.
+//
+// Keys must match the case of those written by json.Marshal.
+func FileFromJSONStrict(bs []byte) (*File, error) {
+	if len(bs) == 0 {
+		return nil, errors.New("no JSON data provided")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+
+	schema := fileJSONSchema()
+	var errs base.ErrorList
+	schema.validate(v, "", schema.Definitions, &errs)
+	if !errs.Empty() {
+		return nil, errs
+	}
+	return FileFromJSON(bs)
+}
+
+// JSONSchema returns a JSON Schema (draft-07) describing the JSON model of a File
+// as written by json.Marshal and read by FileFromJSON.
+func JSONSchema() ([]byte, error) {
+	bs, err := json.MarshalIndent(fileJSONSchema(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(bs, '\n'), nil
+}
+
+// jsonSchema is the subset of JSON Schema we need to describe (and check) the JSON model
+// of a File. Every object is closed with additionalProperties so misspelled keys are caught.
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	ID                   string                 `json:"$id,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Type                 jsonTypes              `json:"type,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	Definitions          map[string]*jsonSchema `json:"definitions,omitempty"`
+}
+
+// jsonTypes is written as a single string when only one type is allowed.
+type jsonTypes []string
+
+func (t jsonTypes) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+const jsonDefinitions = "#/definitions/"
+
+var batcherType = reflect.TypeOf((*Batcher)(nil)).Elem()
+
+func fileJSONSchema() *jsonSchema {
+	definitions := make(map[string]*jsonSchema)
+	root := jsonSchemaOf(reflect.TypeOf(File{}), definitions)
+	root.Schema = "http://json-schema.org/draft-07/schema#"
+	root.ID = "https://github.com/moov-io/ach/ach.schema.json"
+	root.Title = "ACH File"
+	root.Definitions = definitions
+	return root
+}
+
+// jsonSchemaOf follows the rules of encoding/json to describe t. Structs are added to
+// definitions by name and referenced from where they're used.
+func jsonSchemaOf(t reflect.Type, definitions map[string]*jsonSchema) *jsonSchema {
+	if t == batcherType {
+		// Batchers are written (and read) as a Batch
+		t = reflect.TypeOf(Batch{})
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return jsonSchemaOf(t.Elem(), definitions).nullable()
+
+	case reflect.Slice, reflect.Array:
+		elem := t.Elem()
+		if elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		return &jsonSchema{
+			Type:  jsonTypes{"array", "null"},
+			Items: jsonSchemaOf(elem, definitions),
+		}
+
+	case reflect.Struct:
+		name := t.Name()
+		if _, exists := definitions[name]; !exists {
+			closed := false
+			def := &jsonSchema{
+				Type:                 jsonTypes{"object"},
+				Properties:           make(map[string]*jsonSchema),
+				AdditionalProperties: &closed,
+			}
+			definitions[name] = def // added before fields so recursive types terminate
+
+			for i := 0; i < t.NumField(); i++ {
+				field := t.Field(i)
+				if field.PkgPath != "" {
+					continue // unexported
+				}
+				key := strings.Split(field.Tag.Get("json"), ",")[0]
+				if key == "-" {
+					continue
+				}
+				if key == "" {
+					key = field.Name
+				}
+				def.Properties[key] = jsonSchemaOf(field.Type, definitions)
+			}
+			if t == reflect.TypeOf(Batch{}) {
+				// written by (*Batch).MarshalJSON
+				def.Properties["offset"] = jsonSchemaOf(reflect.TypeOf(&Offset{}), definitions)
+			}
+		}
+		return &jsonSchema{Ref: jsonDefinitions + name}
+
+	case reflect.String:
+		return &jsonSchema{Type: jsonTypes{"string"}}
+	case reflect.Bool:
+		return &jsonSchema{Type: jsonTypes{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: jsonTypes{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: jsonTypes{"number"}}
+	}
+	return &jsonSchema{} // any value
+}
+
+// nullable returns a copy of s which also accepts null.
+func (s *jsonSchema) nullable() *jsonSchema {
+	if s.Ref != "" {
+		return &jsonSchema{
+			AnyOf: []*jsonSchema{s, {Type: jsonTypes{"null"}}},
+		}
+	}
+	out := *s
+	out.Type = append(append(jsonTypes{}, s.Type...), "null")
+	return &out
+}
+
+// validate appends a *JSONError to errs for each key or value of v (decoded with UseNumber)
+// which doesn't match s.
+func (s *jsonSchema) validate(v interface{}, path string, definitions map[string]*jsonSchema, errs *base.ErrorList) {
+	if s.Ref != "" {
+		s = definitions[strings.TrimPrefix(s.Ref, jsonDefinitions)]
+	}
+	if len(s.AnyOf) > 0 {
+		var first base.ErrorList
+		for i := range s.AnyOf {
+			var inner base.ErrorList
+			s.AnyOf[i].validate(v, path, definitions, &inner)
+			if inner.Empty() {
+				return
+			}
+			if i == 0 {
+				first = inner
+			}
+		}
+		*errs = append(*errs, first...)
+		return
+	}
+
+	found := jsonTypeOf(v)
+	if !s.Type.allows(found) {
+		errs.Add(&JSONError{
+			Path: path,
+			Msg:  fmt.Sprintf("expected %s but found %s", strings.Join(s.Type, " or "), found),
+		})
+		return
+	}
+
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(vv))
+		for k := range vv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			prop, exists := s.Properties[k]
+			if !exists {
+				if s.AdditionalProperties == nil || *s.AdditionalProperties {
+					continue
+				}
+				errs.Add(&JSONError{Path: joinJSONPath(path, k), Msg: s.unknownField(k)})
+				continue
+			}
+			prop.validate(vv[k], joinJSONPath(path, k), definitions, errs)
+		}
+
+	case []interface{}:
+		if s.Items != nil {
+			for i := range vv {
+				s.Items.validate(vv[i], fmt.Sprintf("%s[%d]", path, i), definitions, errs)
+			}
+		}
+	}
+}
+
+// unknownField describes key, suggesting a property which only differs by case
+// since FileFromJSON reads keys case-insensitively.
+func (s *jsonSchema) unknownField(key string) string {
+	for name := range s.Properties {
+		if strings.EqualFold(name, key) {
+			return fmt.Sprintf("is an unknown field, did you mean %q?", name)
+		}
+	}
+	return "is an unknown field"
+}
+
+func (t jsonTypes) allows(found string) bool {
+	if len(t) == 0 {
+		return true
+	}
+	for i := range t {
+		if t[i] == found || (t[i] == "number" && found == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonTypeOf(v interface{}) string {
+	switch vv := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := strconv.ParseInt(vv.String(), 10, 64); err == nil {
+			return "integer"
+		}
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+func joinJSONPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
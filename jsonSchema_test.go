@@ -0,0 +1,174 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/base"
+)
+
+func TestJSONSchema__generated(t *testing.T) {
+	expected, err := JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := ioutil.ReadFile("ach.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, bs) {
+		t.Error("ach.schema.json is out of date, run 'make generate'")
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(bs, &schema); err != nil {
+		t.Fatal(err)
+	}
+	definitions := schema["definitions"].(map[string]interface{})
+	for _, name := range []string{"File", "FileHeader", "Batch", "EntryDetail", "Addenda05", "IATBatch", "IATEntryDetail", "Addenda17", "ADVFileControl", "Offset"} {
+		if _, exists := definitions[name]; !exists {
+			t.Errorf("missing %s definition", name)
+		}
+	}
+}
+
+func TestFileFromJSONStrict(t *testing.T) {
+	for _, name := range []string{"iat-debit.json", "ppd-valid.json", "ppd-mixedDebitCredit-valid.json", "rfc3339.json"} {
+		bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := FileFromJSONStrict(bs)
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		if file.ID == "" {
+			t.Errorf("%s: missing file ID", name)
+		}
+	}
+
+	// adv-valid.json has a companyIdentification in its advBatchControl
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "adv-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FileFromJSONStrict(bs); err == nil || !strings.Contains(err.Error(), "advBatchControl.companyIdentification is an unknown field") {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// ADV files are written with "fileADVControl"
+	file, err := FileFromJSON(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.ADVControl.ID != "adv-01" {
+		t.Errorf("ADVControl.ID=%q", file.ADVControl.ID)
+	}
+}
+
+func TestFileFromJSONStrict__roundTrip(t *testing.T) {
+	matches, err := filepath.Glob(filepath.Join("test", "testdata", "*.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range matches {
+		fd, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		file, err := NewReader(fd).Read()
+		fd.Close()
+		if err != nil {
+			continue
+		}
+		bs, err := json.Marshal(&file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var errs base.ErrorList
+		var v interface{}
+		dec := json.NewDecoder(bytes.NewReader(bs))
+		dec.UseNumber()
+		if err := dec.Decode(&v); err != nil {
+			t.Fatal(err)
+		}
+		schema := fileJSONSchema()
+		schema.validate(v, "", schema.Definitions, &errs)
+		if !errs.Empty() {
+			t.Errorf("%s: %v", path, errs)
+		}
+	}
+}
+
+func TestFileFromJSONStrict__errors(t *testing.T) {
+	bs, err := ioutil.ReadFile(filepath.Join("test", "testdata", "ppd-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v map[string]interface{}
+	if err := json.Unmarshal(bs, &v); err != nil {
+		t.Fatal(err)
+	}
+	batch := v["batches"].([]interface{})[0].(map[string]interface{})
+	entry := batch["entryDetails"].([]interface{})[0].(map[string]interface{})
+	entry["amount"] = "100"
+	entry["Individualname"] = "Jane Doe"
+	batch["batchHeader"].(map[string]interface{})["companyNam"] = "Moov"
+	v["advFileControl"] = map[string]interface{}{}
+
+	bs, _ = json.Marshal(v)
+	_, err = FileFromJSONStrict(bs)
+	el, ok := err.(base.ErrorList)
+	if !ok {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	expected := []string{
+		`advFileControl is an unknown field`,
+		`batches[0].batchHeader.companyNam is an unknown field`,
+		`batches[0].entryDetails[0].Individualname is an unknown field, did you mean "individualName"?`,
+		`batches[0].entryDetails[0].amount expected integer but found string`,
+	}
+	if len(el) != len(expected) {
+		t.Fatalf("got %d errors: %v", len(el), el)
+	}
+	for i := range expected {
+		if el[i].Error() != expected[i] {
+			t.Errorf("#%d: %v", i, el[i])
+		}
+		if _, ok := el[i].(*JSONError); !ok {
+			t.Errorf("#%d: %T", i, el[i])
+		}
+	}
+
+	for _, input := range []string{``, `{`, `[]`, `{"id": 5}`, `{"batches": [{"entryDetails": {}}]}`} {
+		if _, err := FileFromJSONStrict([]byte(input)); err == nil {
+			t.Errorf("expected error from %q", input)
+		} else if input == `[]` && !strings.Contains(err.Error(), "expected object but found array") {
+			t.Errorf("%q: %v", input, err)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package limits enforces debit and credit exposure limits for originators.
+//
+// Limits are keyed by BatchHeader.CompanyIdentification (or IATBatchHeader.OriginatorIdentification)
+// and cover a single day or a rolling window of days ending on each batch's EffectiveEntryDate.
+// Usage from released files is accumulated in a Store so later files are checked against
+// everything an originator has already sent.
+package limits
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/ach"
+)
+
+// Modes describe what happens to batches which exceed a Limit.
+const (
+	// ModeReport returns violations but still records usage for the batch
+	ModeReport = "report"
+	// ModeBlock returns violations and refuses to record usage for the batch
+	ModeBlock = "block"
+)
+
+// Names of each limit a batch can violate.
+const (
+	DailyDebit    = "dailyDebit"
+	DailyCredit   = "dailyCredit"
+	RollingDebit  = "rollingDebit"
+	RollingCredit = "rollingCredit"
+)
+
+// Limit holds the maximum exposure, in cents, allowed for an originator.
+// Zero values are not enforced.
+type Limit struct {
+	// CompanyIdentification is the originator these limits apply to
+	CompanyIdentification string `json:"companyIdentification"`
+
+	DailyDebit  int `json:"dailyDebit"`
+	DailyCredit int `json:"dailyCredit"`
+
+	// RollingDays is the number of days (including the effective date) covered by the rolling limits
+	RollingDays   int `json:"rollingDays"`
+	RollingDebit  int `json:"rollingDebit"`
+	RollingCredit int `json:"rollingCredit"`
+
+	// Mode is either ModeReport or ModeBlock, and defaults to ModeBlock
+	Mode string `json:"mode"`
+}
+
+// Validate checks the Limit is well formed.
+func (l *Limit) Validate() error {
+	if l == nil {
+		return errors.New("nil Limit")
+	}
+	if strings.TrimSpace(l.CompanyIdentification) == "" {
+		return errors.New("missing CompanyIdentification")
+	}
+	if l.DailyDebit < 0 || l.DailyCredit < 0 || l.RollingDebit < 0 || l.RollingCredit < 0 {
+		return errors.New("negative limit amount")
+	}
+	if (l.RollingDebit > 0 || l.RollingCredit > 0) && l.RollingDays <= 0 {
+		return errors.New("rolling limits require RollingDays")
+	}
+	switch strings.ToLower(l.Mode) {
+	case "", ModeReport, ModeBlock:
+	default:
+		return fmt.Errorf("unknown mode %q", l.Mode)
+	}
+	return nil
+}
+
+func (l *Limit) blocks() bool {
+	return !strings.EqualFold(l.Mode, ModeReport)
+}
+
+// Usage is the total debit and credit amounts, in cents, an originator has released.
+type Usage struct {
+	Debit  int `json:"debit"`
+	Credit int `json:"credit"`
+}
+
+// Violation describes a batch which would exceed an originator's Limit.
+type Violation struct {
+	BatchID               string `json:"batchID"`
+	BatchNumber           int    `json:"batchNumber"`
+	CompanyIdentification string `json:"companyIdentification"`
+	EffectiveEntryDate    string `json:"effectiveEntryDate"`
+
+	// Limit is the name of the exceeded limit (e.g. DailyDebit)
+	Limit string `json:"limit"`
+	// Max is the configured limit amount
+	Max int `json:"max"`
+	// Total is the amount which would have been used with this batch
+	Total int `json:"total"`
+
+	// Blocked is true when the Limit's Mode refuses the batch
+	Blocked bool `json:"blocked"`
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("batch %d (company %s) exceeds %s limit of %d with %d", v.BatchNumber, v.CompanyIdentification, v.Limit, v.Max, v.Total)
+}
+
+// ErrAlreadyReleased is returned by Release for files whose usage was already recorded.
+var ErrAlreadyReleased = errors.New("file was already released")
+
+// Checker evaluates ACH files against originator limits.
+type Checker struct {
+	store Store
+	now   func() time.Time
+
+	// mu serializes releases so concurrent files can't together exceed a limit
+	mu sync.Mutex
+}
+
+// NewChecker returns a Checker which reads limits and usage from store.
+func NewChecker(store Store) *Checker {
+	return &Checker{
+		store: store,
+		now:   time.Now,
+	}
+}
+
+// Store returns the underlying Store of limits and usage.
+func (c *Checker) Store() Store {
+	return c.store
+}
+
+// Check evaluates each batch in file against the configured limits without recording any usage.
+// Batches are evaluated in order, so earlier batches in the file count towards later ones.
+func (c *Checker) Check(file *ach.File) ([]Violation, error) {
+	violations, _, err := c.evaluate(file)
+	return violations, err
+}
+
+// Release evaluates file and, unless any batch is blocked, records the usage of every batch.
+// Violations for both reported and blocked batches are returned. Files with blocked batches
+// aren't released (see Blocked) so they can be released once their limits are raised.
+//
+// Each file (by ID) is only released once, later attempts return ErrAlreadyReleased. Files
+// without an ID can't be told apart so are always released.
+func (c *Checker) Release(file *ach.File) ([]Violation, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if file != nil && file.ID != "" {
+		released, err := c.store.Released(file.ID)
+		if err != nil {
+			return nil, err
+		}
+		if released {
+			return nil, ErrAlreadyReleased
+		}
+	}
+	violations, accepted, err := c.evaluate(file)
+	if err != nil {
+		return nil, err
+	}
+	if Blocked(violations) {
+		return violations, nil
+	}
+	for i := range accepted {
+		if err := c.store.AddUsage(accepted[i].companyID, accepted[i].date, accepted[i].usage); err != nil {
+			return violations, err
+		}
+	}
+	if file.ID != "" {
+		if err := c.store.MarkReleased(file.ID); err != nil {
+			return violations, err
+		}
+	}
+	return violations, nil
+}
+
+// Blocked returns true if any of violations refuses its batch, in which case Release didn't
+// release the file.
+func Blocked(violations []Violation) bool {
+	for i := range violations {
+		if violations[i].Blocked {
+			return true
+		}
+	}
+	return false
+}
+
+// batchUsage is the exposure of one batch.
+type batchUsage struct {
+	batchID     string
+	batchNumber int
+	companyID   string
+	date        time.Time
+	usage       Usage
+}
+
+func (c *Checker) evaluate(file *ach.File) ([]Violation, []batchUsage, error) {
+	if file == nil {
+		return nil, nil, errors.New("nil File")
+	}
+
+	var violations []Violation
+	var accepted []batchUsage
+
+	// pending holds usage of accepted batches earlier in this file
+	pending := func(companyID string, from, to time.Time) Usage {
+		var u Usage
+		for i := range accepted {
+			if accepted[i].companyID == companyID && !accepted[i].date.Before(from) && !accepted[i].date.After(to) {
+				u.Debit += accepted[i].usage.Debit
+				u.Credit += accepted[i].usage.Credit
+			}
+		}
+		return u
+	}
+
+	for _, bu := range c.batches(file) {
+		limit, err := c.store.GetLimit(bu.companyID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if limit == nil {
+			accepted = append(accepted, bu)
+			continue
+		}
+
+		var found []Violation
+		check := func(name string, max, used, amount int) {
+			if max > 0 && amount > 0 && used+amount > max {
+				found = append(found, Violation{
+					BatchID:               bu.batchID,
+					BatchNumber:           bu.batchNumber,
+					CompanyIdentification: bu.companyID,
+					EffectiveEntryDate:    bu.date.Format("060102"),
+					Limit:                 name,
+					Max:                   max,
+					Total:                 used + amount,
+					Blocked:               limit.blocks(),
+				})
+			}
+		}
+
+		daily, err := c.usage(bu.companyID, bu.date, bu.date, pending)
+		if err != nil {
+			return nil, nil, err
+		}
+		check(DailyDebit, limit.DailyDebit, daily.Debit, bu.usage.Debit)
+		check(DailyCredit, limit.DailyCredit, daily.Credit, bu.usage.Credit)
+
+		if limit.RollingDays > 0 {
+			from := bu.date.AddDate(0, 0, -1*(limit.RollingDays-1))
+			rolling, err := c.usage(bu.companyID, from, bu.date, pending)
+			if err != nil {
+				return nil, nil, err
+			}
+			check(RollingDebit, limit.RollingDebit, rolling.Debit, bu.usage.Debit)
+			check(RollingCredit, limit.RollingCredit, rolling.Credit, bu.usage.Credit)
+		}
+
+		violations = append(violations, found...)
+		if len(found) == 0 || !limit.blocks() {
+			accepted = append(accepted, bu)
+		}
+	}
+	return violations, accepted, nil
+}
+
+// usage returns the stored and pending usage of companyID between from and to (inclusive).
+func (c *Checker) usage(companyID string, from, to time.Time, pending func(string, time.Time, time.Time) Usage) (Usage, error) {
+	u, err := c.store.Usage(companyID, from, to)
+	if err != nil {
+		return u, err
+	}
+	p := pending(companyID, from, to)
+	u.Debit += p.Debit
+	u.Credit += p.Credit
+	return u, nil
+}
+
+// batches returns the exposure of each batch in file. Batches without a valid
+// EffectiveEntryDate are counted against the current day.
+func (c *Checker) batches(file *ach.File) []batchUsage {
+	var out []batchUsage
+	for _, b := range file.Batches {
+		bh := b.GetHeader()
+		bu := batchUsage{
+			batchID:     b.ID(),
+			batchNumber: bh.BatchNumber,
+			companyID:   strings.TrimSpace(bh.CompanyIdentification),
+			date:        c.effectiveDate(bh.EffectiveEntryDate),
+		}
+		for _, ed := range b.GetEntries() {
+			switch ed.CreditOrDebit() {
+			case "C":
+				bu.usage.Credit += ed.Amount
+			case "D":
+				bu.usage.Debit += ed.Amount
+			}
+		}
+		out = append(out, bu)
+	}
+	for _, b := range file.IATBatches {
+		bh := b.GetHeader()
+		bu := batchUsage{
+			batchID:     b.ID,
+			batchNumber: bh.BatchNumber,
+			companyID:   strings.TrimSpace(bh.OriginatorIdentification),
+			date:        c.effectiveDate(bh.EffectiveEntryDate),
+		}
+		for _, ed := range b.GetEntries() {
+			// IATEntryDetail shares TransactionCode values with EntryDetail
+			switch (&ach.EntryDetail{TransactionCode: ed.TransactionCode}).CreditOrDebit() {
+			case "C":
+				bu.usage.Credit += ed.Amount
+			case "D":
+				bu.usage.Debit += ed.Amount
+			}
+		}
+		out = append(out, bu)
+	}
+	return out
+}
+
+func (c *Checker) effectiveDate(v string) time.Time {
+	if t, err := time.Parse("060102", v); err == nil {
+		return t
+	}
+	now := c.now().UTC()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,265 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package limits
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/moov-io/ach"
+)
+
+func readFile(t *testing.T, name string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &file
+}
+
+func TestLimit__Validate(t *testing.T) {
+	var l *Limit
+	if err := l.Validate(); err == nil {
+		t.Error("expected error")
+	}
+	l = &Limit{}
+	if err := l.Validate(); err == nil {
+		t.Error("expected error")
+	}
+	l.CompanyIdentification = "121042882"
+	l.DailyDebit = -1
+	if err := l.Validate(); err == nil {
+		t.Error("expected error")
+	}
+	l.DailyDebit = 100
+	l.RollingDebit = 500
+	if err := l.Validate(); err == nil {
+		t.Error("expected error")
+	}
+	l.RollingDays = 5
+	l.Mode = "other"
+	if err := l.Validate(); err == nil {
+		t.Error("expected error")
+	}
+	l.Mode = ModeReport
+	if err := l.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChecker__noLimits(t *testing.T) {
+	checker := NewChecker(NewStoreInMemory())
+	violations, err := checker.Release(readFile(t, "ppd-debit.ach"))
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+
+	// usage is tracked for originators without a limit
+	day := time.Date(2019, time.June, 25, 0, 0, 0, 0, time.UTC)
+	u, err := checker.Store().Usage("121042882", day, day)
+	if err != nil || u.Debit != 100000000 {
+		t.Errorf("usage=%#v error=%v", u, err)
+	}
+}
+
+func TestChecker__daily(t *testing.T) {
+	store := NewStoreInMemory()
+	if err := store.SetLimit(&Limit{CompanyIdentification: "121042882", DailyDebit: 150000000}); err != nil {
+		t.Fatal(err)
+	}
+	checker := NewChecker(store)
+
+	// first file is under the limit
+	violations, err := checker.Release(readFile(t, "ppd-debit.ach"))
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+
+	// second file pushes us over
+	file := readFile(t, "ppd-debit.ach")
+	violations, err = checker.Check(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations: %#v", len(violations), violations)
+	}
+	v := violations[0]
+	if v.Limit != DailyDebit || v.Max != 150000000 || v.Total != 200000000 || !v.Blocked {
+		t.Errorf("unexpected violation: %#v", v)
+	}
+	if v.String() == "" {
+		t.Error("empty String()")
+	}
+
+	// blocked files aren't recorded or released
+	file.ID = "blocked"
+	violations, err = checker.Release(file)
+	if err != nil || !Blocked(violations) {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+	day := time.Date(2019, time.June, 25, 0, 0, 0, 0, time.UTC)
+	u, _ := store.Usage("121042882", day, day)
+	if u.Debit != 100000000 {
+		t.Errorf("unexpected usage: %#v", u)
+	}
+	if released, _ := store.Released(file.ID); released {
+		t.Error("blocked file was released")
+	}
+
+	// raising the limit lets the file be released
+	if err := store.SetLimit(&Limit{CompanyIdentification: "121042882", DailyDebit: 200000000}); err != nil {
+		t.Fatal(err)
+	}
+	violations, err = checker.Release(file)
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+	u, _ = store.Usage("121042882", day, day)
+	if u.Debit != 200000000 {
+		t.Errorf("unexpected usage: %#v", u)
+	}
+	if released, _ := store.Released(file.ID); !released {
+		t.Error("file wasn't released")
+	}
+
+	// a different effective date has its own daily limit
+	file.Batches[0].GetHeader().EffectiveEntryDate = "190626"
+	violations, err = checker.Check(file)
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+}
+
+func TestChecker__releaseOnce(t *testing.T) {
+	store := NewStoreInMemory()
+	if err := store.SetLimit(&Limit{CompanyIdentification: "121042882", DailyDebit: 500000000}); err != nil {
+		t.Fatal(err)
+	}
+	checker := NewChecker(store)
+
+	file := readFile(t, "ppd-debit.ach")
+	file.ID = "once"
+	if _, err := checker.Release(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := checker.Release(file); err != ErrAlreadyReleased {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// concurrent releases can't together exceed the limit
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		file := readFile(t, "ppd-debit.ach")
+		file.ID = strconv.Itoa(i)
+
+		wg.Add(1)
+		go func(file *ach.File) {
+			defer wg.Done()
+			if _, err := checker.Release(file); err != nil {
+				t.Error(err)
+			}
+		}(file)
+	}
+	wg.Wait()
+
+	day := time.Date(2019, time.June, 25, 0, 0, 0, 0, time.UTC)
+	u, _ := store.Usage("121042882", day, day)
+	if u.Debit != 500000000 {
+		t.Errorf("unexpected usage: %#v", u)
+	}
+}
+
+func TestChecker__rolling(t *testing.T) {
+	store := NewStoreInMemory()
+	limit := &Limit{
+		CompanyIdentification: "121042882",
+		RollingDays:           3,
+		RollingDebit:          250000000,
+		Mode:                  ModeReport,
+	}
+	if err := store.SetLimit(limit); err != nil {
+		t.Fatal(err)
+	}
+	checker := NewChecker(store)
+
+	for _, date := range []string{"190625", "190626", "190627"} {
+		file := readFile(t, "ppd-debit.ach")
+		file.Batches[0].GetHeader().EffectiveEntryDate = date
+		violations, err := checker.Release(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if date == "190627" {
+			if len(violations) != 1 || violations[0].Limit != RollingDebit || violations[0].Blocked {
+				t.Errorf("unexpected violations: %#v", violations)
+			}
+		} else if len(violations) != 0 {
+			t.Errorf("%s: unexpected violations: %#v", date, violations)
+		}
+	}
+
+	// reported batches are still recorded
+	from := time.Date(2019, time.June, 25, 0, 0, 0, 0, time.UTC)
+	u, _ := store.Usage("121042882", from, from.AddDate(0, 0, 2))
+	if u.Debit != 300000000 {
+		t.Errorf("unexpected usage: %#v", u)
+	}
+
+	// the window has moved past the first day
+	file := readFile(t, "ppd-debit.ach")
+	file.Batches[0].GetHeader().EffectiveEntryDate = "190629"
+	violations, err := checker.Check(file)
+	if err != nil || len(violations) != 0 {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+}
+
+func TestChecker__withinFile(t *testing.T) {
+	store := NewStoreInMemory()
+	if err := store.SetLimit(&Limit{CompanyIdentification: "121042882", DailyCredit: 150000000}); err != nil {
+		t.Fatal(err)
+	}
+	checker := NewChecker(store)
+
+	// two batches of one credit each, the second exceeds our limit
+	file := readFile(t, "ppd-mixedDebitCredit.ach")
+	violations, err := checker.Check(file)
+	if err != nil || len(violations) != 1 {
+		t.Fatalf("violations=%#v error=%v", violations, err)
+	}
+	if v := violations[0]; v.Limit != DailyCredit || v.Total != 200000000 {
+		t.Errorf("unexpected violation: %#v", v)
+	}
+
+	if _, err := checker.Check(nil); err == nil {
+		t.Error("expected error")
+	}
+}
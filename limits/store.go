@@ -0,0 +1,160 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package limits
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Store holds configured limits and the usage accumulated by each originator.
+type Store interface {
+	// GetLimit returns the Limit for companyID, or nil if none is configured.
+	GetLimit(companyID string) (*Limit, error)
+	// SetLimit creates or replaces the Limit for limit.CompanyIdentification.
+	SetLimit(limit *Limit) error
+	// DeleteLimit removes the Limit for companyID.
+	DeleteLimit(companyID string) error
+	// ListLimits returns every configured Limit.
+	ListLimits() ([]*Limit, error)
+
+	// Usage returns the total usage of companyID for each day between from and to (inclusive).
+	Usage(companyID string, from, to time.Time) (Usage, error)
+	// AddUsage accumulates usage for companyID on day.
+	AddUsage(companyID string, day time.Time, usage Usage) error
+
+	// Released returns true when the file with fileID has had its usage recorded.
+	Released(fileID string) (bool, error)
+	// MarkReleased records that the usage of the file with fileID was recorded.
+	MarkReleased(fileID string) error
+}
+
+type storeInMemory struct {
+	mu     sync.RWMutex
+	limits map[string]*Limit
+	usage  map[string]map[string]Usage // companyID -> YYYYMMDD -> Usage
+
+	released map[string]bool // fileID
+}
+
+// NewStoreInMemory returns a Store which keeps limits and usage in memory.
+func NewStoreInMemory() Store {
+	return &storeInMemory{
+		limits: make(map[string]*Limit),
+		usage:  make(map[string]map[string]Usage),
+
+		released: make(map[string]bool),
+	}
+}
+
+func normalize(companyID string) string {
+	return strings.TrimSpace(companyID)
+}
+
+func dayKey(t time.Time) string {
+	return t.Format("20060102")
+}
+
+func (s *storeInMemory) GetLimit(companyID string) (*Limit, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if l, ok := s.limits[normalize(companyID)]; ok {
+		out := *l
+		return &out, nil
+	}
+	return nil, nil
+}
+
+func (s *storeInMemory) SetLimit(limit *Limit) error {
+	if err := limit.Validate(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l := *limit
+	l.CompanyIdentification = normalize(l.CompanyIdentification)
+	s.limits[l.CompanyIdentification] = &l
+	return nil
+}
+
+func (s *storeInMemory) DeleteLimit(companyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.limits, normalize(companyID))
+	return nil
+}
+
+func (s *storeInMemory) ListLimits() ([]*Limit, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*Limit, 0, len(s.limits))
+	for _, l := range s.limits {
+		ll := *l
+		out = append(out, &ll)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].CompanyIdentification < out[j].CompanyIdentification
+	})
+	return out, nil
+}
+
+func (s *storeInMemory) Usage(companyID string, from, to time.Time) (Usage, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var total Usage
+	days := s.usage[normalize(companyID)]
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		u := days[dayKey(day)]
+		total.Debit += u.Debit
+		total.Credit += u.Credit
+	}
+	return total, nil
+}
+
+func (s *storeInMemory) AddUsage(companyID string, day time.Time, usage Usage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	companyID = normalize(companyID)
+	days, ok := s.usage[companyID]
+	if !ok {
+		days = make(map[string]Usage)
+		s.usage[companyID] = days
+	}
+	u := days[dayKey(day)]
+	u.Debit += usage.Debit
+	u.Credit += usage.Credit
+	days[dayKey(day)] = u
+	return nil
+}
+
+func (s *storeInMemory) Released(fileID string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.released[fileID], nil
+}
+
+func (s *storeInMemory) MarkReleased(fileID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.released[fileID] = true
+	return nil
+}
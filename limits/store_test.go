@@ -0,0 +1,75 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package limits
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStoreInMemory__limits(t *testing.T) {
+	store := NewStoreInMemory()
+
+	if l, err := store.GetLimit("123"); l != nil || err != nil {
+		t.Fatalf("limit=%#v error=%v", l, err)
+	}
+	if err := store.SetLimit(&Limit{}); err == nil {
+		t.Error("expected error")
+	}
+	if err := store.SetLimit(&Limit{CompanyIdentification: " 123 ", DailyDebit: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.SetLimit(&Limit{CompanyIdentification: "456", DailyCredit: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if l, err := store.GetLimit("123"); l == nil || l.DailyDebit != 10 || err != nil {
+		t.Fatalf("limit=%#v error=%v", l, err)
+	}
+
+	limits, err := store.ListLimits()
+	if err != nil || len(limits) != 2 || limits[0].CompanyIdentification != "123" {
+		t.Fatalf("limits=%#v error=%v", limits, err)
+	}
+
+	if err := store.DeleteLimit("123"); err != nil {
+		t.Fatal(err)
+	}
+	if l, _ := store.GetLimit("123"); l != nil {
+		t.Errorf("unexpected limit: %#v", l)
+	}
+}
+
+func TestStoreInMemory__usage(t *testing.T) {
+	store := NewStoreInMemory()
+
+	day := time.Date(2020, time.June, 1, 0, 0, 0, 0, time.UTC)
+	store.AddUsage("123", day, Usage{Debit: 10})
+	store.AddUsage("123", day, Usage{Credit: 5})
+	store.AddUsage("123", day.AddDate(0, 0, 1), Usage{Debit: 1})
+	store.AddUsage("456", day, Usage{Debit: 100})
+
+	if u, err := store.Usage("123", day, day); u.Debit != 10 || u.Credit != 5 || err != nil {
+		t.Errorf("usage=%#v error=%v", u, err)
+	}
+	if u, _ := store.Usage("123", day, day.AddDate(0, 0, 7)); u.Debit != 11 {
+		t.Errorf("usage=%#v", u)
+	}
+	if u, _ := store.Usage("123", day.AddDate(0, 0, -7), day.AddDate(0, 0, -1)); u.Debit != 0 {
+		t.Errorf("usage=%#v", u)
+	}
+}
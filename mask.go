@@ -0,0 +1,307 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaskingPolicy describes which sensitive fields are redacted by File.Mask.
+type MaskingPolicy struct {
+	// AccountNumbers masks DFIAccountNumber values on entries and the Addenda98 CorrectedData
+	// of account and routing number changes. The last two characters are left visible.
+	AccountNumbers bool `json:"accountNumbers"`
+
+	// Names masks IndividualName on entries and the receiver and originator names
+	// on IAT addenda records.
+	Names bool `json:"names"`
+
+	// IdentificationNumbers masks IdentificationNumber on entries and ReceiverIDNumber
+	// on IAT Addenda15 records. The last two characters are left visible.
+	IdentificationNumbers bool `json:"identificationNumbers"`
+
+	// PaymentInformation masks free form addenda fields such as PaymentRelatedInformation,
+	// Addenda02 reference information, Addenda99 AddendaInformation and IAT street addresses.
+	PaymentInformation bool `json:"paymentInformation"`
+}
+
+// DefaultMaskingPolicy masks every sensitive field.
+func DefaultMaskingPolicy() *MaskingPolicy {
+	return &MaskingPolicy{
+		AccountNumbers:        true,
+		Names:                 true,
+		IdentificationNumbers: true,
+		PaymentInformation:    true,
+	}
+}
+
+// Mask returns a copy of the File with fields redacted according to policy. A nil policy
+// uses DefaultMaskingPolicy. The original File is never modified.
+//
+// Masked values keep their length, so the returned File can be encoded as JSON or
+// written with a Writer and shared without exposing customer data.
+func (f *File) Mask(policy *MaskingPolicy) *File {
+	if f == nil {
+		return nil
+	}
+	if policy == nil {
+		policy = DefaultMaskingPolicy()
+	}
+
+	out := *f
+	out.Batches = nil
+	out.IATBatches = nil
+	out.NotificationOfChange = nil
+	out.ReturnEntries = nil
+
+	// Keep references to NOC and Return batches pointing at their masked copy
+	copies := make(map[Batcher]Batcher)
+	for _, b := range f.Batches {
+		mb := maskBatch(b, policy)
+		if f.validateOpts != nil {
+			mb.SetValidation(f.validateOpts)
+		}
+		copies[b] = mb
+		out.Batches = append(out.Batches, mb)
+	}
+	for _, b := range f.NotificationOfChange {
+		if mb, ok := copies[b]; ok {
+			out.NotificationOfChange = append(out.NotificationOfChange, mb)
+		}
+	}
+	for _, b := range f.ReturnEntries {
+		if mb, ok := copies[b]; ok {
+			out.ReturnEntries = append(out.ReturnEntries, mb)
+		}
+	}
+	for i := range f.IATBatches {
+		out.IATBatches = append(out.IATBatches, maskIATBatch(f.IATBatches[i], policy))
+	}
+	return &out
+}
+
+func maskBatch(b Batcher, policy *MaskingPolicy) Batcher {
+	bh := *b.GetHeader()
+	mb := ConvertBatchType(Batch{Header: &bh})
+	mb.SetID(b.ID())
+	if bc := b.GetControl(); bc != nil {
+		c := *bc
+		mb.SetControl(&c)
+	}
+	if bc := b.GetADVControl(); bc != nil {
+		c := *bc
+		mb.SetADVControl(&c)
+	}
+	for _, ed := range b.GetEntries() {
+		mb.AddEntry(maskEntryDetail(ed, bh.StandardEntryClassCode, policy))
+	}
+	for _, ed := range b.GetADVEntries() {
+		mb.AddADVEntry(maskADVEntryDetail(ed, policy))
+	}
+	return mb
+}
+
+func maskEntryDetail(ed *EntryDetail, secCode string, policy *MaskingPolicy) *EntryDetail {
+	out := *ed
+	if policy.AccountNumbers {
+		out.DFIAccountNumber = maskAccountNumber(out.DFIAccountNumber)
+	}
+	if policy.Names {
+		if (secCode == CTX || secCode == ATX) && len(out.IndividualName) > 4 {
+			// CTX and ATX entries hold their addenda count in the first four characters
+			out.IndividualName = out.IndividualName[:4] + maskAll(out.IndividualName[4:])
+		} else {
+			out.IndividualName = maskAll(out.IndividualName)
+		}
+	}
+	if policy.IdentificationNumbers {
+		out.IdentificationNumber = maskAccountNumber(out.IdentificationNumber)
+	}
+	if ed.Addenda02 != nil {
+		a := *ed.Addenda02
+		if policy.PaymentInformation {
+			a.ReferenceInformationOne = maskAll(a.ReferenceInformationOne)
+			a.ReferenceInformationTwo = maskAll(a.ReferenceInformationTwo)
+		}
+		out.Addenda02 = &a
+	}
+	out.Addenda05 = nil
+	for _, a05 := range ed.Addenda05 {
+		a := *a05
+		if policy.PaymentInformation {
+			a.PaymentRelatedInformation = maskAll(a.PaymentRelatedInformation)
+		}
+		out.Addenda05 = append(out.Addenda05, &a)
+	}
+	out.Addenda98 = maskAddenda98(ed.Addenda98, policy)
+	out.Addenda99 = maskAddenda99(ed.Addenda99, policy)
+	return &out
+}
+
+func maskADVEntryDetail(ed *ADVEntryDetail, policy *MaskingPolicy) *ADVEntryDetail {
+	out := *ed
+	if policy.AccountNumbers {
+		out.DFIAccountNumber = maskAccountNumber(out.DFIAccountNumber)
+	}
+	if policy.Names {
+		out.IndividualName = maskAll(out.IndividualName)
+	}
+	out.Addenda99 = maskAddenda99(ed.Addenda99, policy)
+	return &out
+}
+
+func maskAddenda98(a98 *Addenda98, policy *MaskingPolicy) *Addenda98 {
+	if a98 == nil {
+		return nil
+	}
+	a := *a98
+	if policy.AccountNumbers && maskedChangeCodes[a.ChangeCode] {
+		a.CorrectedData = maskAccountNumber(a.CorrectedData)
+	}
+	return &a
+}
+
+// maskedChangeCodes are the Addenda98 ChangeCodes whose CorrectedData holds an account or routing number
+var maskedChangeCodes = map[string]bool{
+	"C01": true, // account number
+	"C02": true, // routing number
+	"C03": true, // routing and account number
+	"C06": true, // account number and transaction code
+	"C07": true, // routing number, account number and transaction code
+}
+
+func maskAddenda99(a99 *Addenda99, policy *MaskingPolicy) *Addenda99 {
+	if a99 == nil {
+		return nil
+	}
+	a := *a99
+	if policy.PaymentInformation {
+		a.AddendaInformation = maskAll(a.AddendaInformation)
+	}
+	return &a
+}
+
+func maskIATBatch(b IATBatch, policy *MaskingPolicy) IATBatch {
+	out := b
+	if b.Header != nil {
+		bh := *b.Header
+		out.Header = &bh
+	}
+	if b.Control != nil {
+		bc := *b.Control
+		out.Control = &bc
+	}
+	out.Entries = nil
+	for _, ed := range b.Entries {
+		out.Entries = append(out.Entries, maskIATEntryDetail(ed, policy))
+	}
+	return out
+}
+
+func maskIATEntryDetail(ed *IATEntryDetail, policy *MaskingPolicy) *IATEntryDetail {
+	out := *ed
+	if policy.AccountNumbers {
+		out.DFIAccountNumber = maskAccountNumber(out.DFIAccountNumber)
+	}
+	if ed.Addenda10 != nil {
+		a := *ed.Addenda10
+		if policy.Names {
+			a.Name = maskAll(a.Name)
+		}
+		out.Addenda10 = &a
+	}
+	if ed.Addenda11 != nil {
+		a := *ed.Addenda11
+		if policy.Names {
+			a.OriginatorName = maskAll(a.OriginatorName)
+		}
+		if policy.PaymentInformation {
+			a.OriginatorStreetAddress = maskAll(a.OriginatorStreetAddress)
+		}
+		out.Addenda11 = &a
+	}
+	if ed.Addenda12 != nil {
+		a := *ed.Addenda12
+		out.Addenda12 = &a
+	}
+	if ed.Addenda13 != nil {
+		a := *ed.Addenda13
+		out.Addenda13 = &a
+	}
+	if ed.Addenda14 != nil {
+		a := *ed.Addenda14
+		out.Addenda14 = &a
+	}
+	if ed.Addenda15 != nil {
+		a := *ed.Addenda15
+		if policy.IdentificationNumbers {
+			a.ReceiverIDNumber = maskAccountNumber(a.ReceiverIDNumber)
+		}
+		if policy.PaymentInformation {
+			a.ReceiverStreetAddress = maskAll(a.ReceiverStreetAddress)
+		}
+		out.Addenda15 = &a
+	}
+	if ed.Addenda16 != nil {
+		a := *ed.Addenda16
+		out.Addenda16 = &a
+	}
+	out.Addenda17 = nil
+	for _, a17 := range ed.Addenda17 {
+		a := *a17
+		if policy.PaymentInformation {
+			a.PaymentRelatedInformation = maskAll(a.PaymentRelatedInformation)
+		}
+		out.Addenda17 = append(out.Addenda17, &a)
+	}
+	out.Addenda18 = nil
+	for _, a18 := range ed.Addenda18 {
+		a := *a18
+		out.Addenda18 = append(out.Addenda18, &a)
+	}
+	out.Addenda98 = maskAddenda98(ed.Addenda98, policy)
+	out.Addenda99 = maskAddenda99(ed.Addenda99, policy)
+	return &out
+}
+
+// maskAccountNumber replaces all but the last two characters of s with asterisks.
+// Any leading or trailing spaces are kept.
+func maskAccountNumber(s string) string {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return s
+	}
+	n := utf8.RuneCountInString(trimmed)
+	masked := "**"
+	if n > 2 {
+		runes := []rune(trimmed)
+		masked = strings.Repeat("*", n-2) + string(runes[n-2:])
+	}
+	return strings.Replace(s, trimmed, masked, 1)
+}
+
+// maskAll replaces every non-space character of s with an asterisk.
+func maskAll(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ' ' {
+			return r
+		}
+		return '*'
+	}, s)
+}
@@ -0,0 +1,175 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMask__accountNumber(t *testing.T) {
+	cases := map[string]string{
+		"":                "",
+		"1":               "**",
+		"12":              "**",
+		"123456789":       "*******89",
+		"12345678       ": "******78       ",
+		"  12345  ":       "  ***45  ",
+	}
+	for input, expected := range cases {
+		if v := maskAccountNumber(input); v != expected {
+			t.Errorf("maskAccountNumber(%q)=%q expected %q", input, v, expected)
+		}
+	}
+	if v := maskAll("Jane Doe "); v != "**** *** " {
+		t.Errorf("maskAll=%q", v)
+	}
+}
+
+func TestFile__Mask(t *testing.T) {
+	file, err := readACHFilepath(filepath.Join("test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masked := file.Mask(nil)
+	ed := masked.Batches[0].GetEntries()[0]
+	if ed.DFIAccountNumber != "******78         " {
+		t.Errorf("DFIAccountNumber=%q", ed.DFIAccountNumber)
+	}
+	if ed.IndividualName != "******** ******* **** " {
+		t.Errorf("IndividualName=%q", ed.IndividualName)
+	}
+
+	// original file is untouched
+	if v := file.Batches[0].GetEntries()[0].DFIAccountNumber; v != "12345678         " {
+		t.Errorf("DFIAccountNumber=%q", v)
+	}
+
+	// write masked file
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(masked); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "12345678") || strings.Contains(buf.String(), "Receiver Account Name") {
+		t.Errorf("unmasked values written:\n%s", buf.String())
+	}
+
+	// masked JSON
+	bs, err := json.Marshal(masked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(bs, []byte("12345678")) {
+		t.Errorf("unmasked JSON: %s", string(bs))
+	}
+
+	var nilFile *File
+	if nilFile.Mask(nil) != nil {
+		t.Error("expected nil File")
+	}
+}
+
+func TestFile__MaskPolicy(t *testing.T) {
+	file, err := readACHFilepath(filepath.Join("test", "testdata", "return-WEB.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masked := file.Mask(&MaskingPolicy{AccountNumbers: true})
+	if len(masked.ReturnEntries) != len(file.ReturnEntries) {
+		t.Fatalf("got %d ReturnEntries", len(masked.ReturnEntries))
+	}
+	if masked.ReturnEntries[0] != masked.Batches[0] {
+		t.Error("ReturnEntries should reference masked batches")
+	}
+	ed := masked.Batches[0].GetEntries()[0]
+	if ed.DFIAccountNumber != "*******89        " {
+		t.Errorf("DFIAccountNumber=%q", ed.DFIAccountNumber)
+	}
+	if ed.IndividualName != file.Batches[0].GetEntries()[0].IndividualName {
+		t.Errorf("IndividualName=%q", ed.IndividualName)
+	}
+	if ed.Addenda99 == nil || ed.Addenda99 == file.Batches[0].GetEntries()[0].Addenda99 {
+		t.Error("expected copied Addenda99")
+	}
+	if err := masked.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFile__MaskIAT(t *testing.T) {
+	file, err := readACHFilepath(filepath.Join("test", "testdata", "iat-mixedDebitCredit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	masked := file.Mask(DefaultMaskingPolicy())
+	orig := file.IATBatches[0].Entries[0]
+	ed := masked.IATBatches[0].Entries[0]
+	if ed.DFIAccountNumber == orig.DFIAccountNumber {
+		t.Errorf("DFIAccountNumber=%q", ed.DFIAccountNumber)
+	}
+	if ed.Addenda10.Name == orig.Addenda10.Name || ed.Addenda11.OriginatorName == orig.Addenda11.OriginatorName {
+		t.Errorf("names not masked: %q %q", ed.Addenda10.Name, ed.Addenda11.OriginatorName)
+	}
+	if ed.Addenda15.ReceiverStreetAddress == orig.Addenda15.ReceiverStreetAddress {
+		t.Errorf("ReceiverStreetAddress=%q", ed.Addenda15.ReceiverStreetAddress)
+	}
+
+	var buf bytes.Buffer
+	if err := NewWriter(&buf).Write(masked); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFile__MaskCTX(t *testing.T) {
+	file := NewFile()
+	file.SetHeader(mockFileHeader())
+	file.AddBatch(mockBatchCTX())
+	if err := file.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	ed := file.Mask(nil).Batches[0].GetEntries()[0]
+	if v := ed.CATXAddendaRecordsField(); v != "0001" {
+		t.Errorf("addenda records=%q", v)
+	}
+	if v := ed.CATXReceivingCompanyField(); v != "******** *******" {
+		t.Errorf("receiving company=%q", v)
+	}
+}
+
+func TestFile__MaskCorrectedData(t *testing.T) {
+	cases := map[string]string{
+		"C01": "********14",
+		"C03": "********14",
+		"C05": "1918171614", // transaction code
+		"C10": "1918171614", // company name
+	}
+	for code, expected := range cases {
+		a98 := mockAddenda98()
+		a98.ChangeCode = code
+		if v := maskAddenda98(a98, DefaultMaskingPolicy()).CorrectedData; v != expected {
+			t.Errorf("%s: CorrectedData=%q expected %q", code, v, expected)
+		}
+	}
+}
@@ -33,6 +33,28 @@ const NACHAFileLineLimit = 10000
 //
 // File Batches can only be merged if they are unique and routed to and from the same ABA routing numbers.
 func MergeFiles(files []*File) ([]*File, error) {
+	return MergeFilesWith(files, Conditions{})
+}
+
+// Conditions are optional limits applied to each File created by MergeFilesWith.
+type Conditions struct {
+	// MaxLines is the most lines (when rendered in their ASCII encoding) a merged File can have.
+	// Zero, or a value over NACHAFileLineLimit, uses NACHAFileLineLimit.
+	MaxLines int `json:"maxLines"`
+}
+
+// MergeFilesWith consolidates an array of ACH Files into as few files as possible (see MergeFiles)
+// while keeping each File within conditions.
+func MergeFilesWith(files []*File, conditions Conditions) ([]*File, error) {
+	maxLines := conditions.MaxLines
+	if maxLines <= 0 || maxLines > NACHAFileLineLimit {
+		maxLines = NACHAFileLineLimit
+	}
+	count := lineCount
+	if maxLines < NACHAFileLineLimit {
+		count = countLines // the shortcut in lineCount only holds for NACHAFileLineLimit
+	}
+
 	fs := &mergableFiles{infiles: files}
 	for i := range fs.infiles {
 		outf := fs.lookupByHeader(fs.infiles[i])
@@ -48,11 +70,11 @@ func MergeFiles(files []*File) ([]*File, error) {
 				if err := outf.Create(); err != nil {
 					return nil, err
 				}
-				n, err := lineCount(outf)
+				n, err := count(outf)
 				if n == 0 || err != nil {
 					return nil, fmt.Errorf("problem getting line count of File (header: %#v): %v", outf.Header, err)
 				}
-				if n > NACHAFileLineLimit {
+				if n > maxLines {
 					outf.RemoveBatch(fs.infiles[i].Batches[j])
 					if err := outf.Create(); err != nil { // rebalance ACH file after removing the Batch
 						return nil, err
@@ -128,7 +150,11 @@ func lineCount(f *File) (int, error) {
 		// Calling Writer.Write() is costly and so we're going to ignore it in easy cases.
 		return 1, nil
 	}
+	return countLines(f)
+}
 
+// countLines returns the number of lines in f when written in its ASCII encoding.
+func countLines(f *File) (int, error) {
 	var buf bytes.Buffer
 	if err := NewWriter(&buf).Write(f); err != nil {
 		return 0, err
@@ -265,3 +265,44 @@ func TestMergeFiles__invalid(t *testing.T) {
 		t.Errorf("expected error: len(out)=%d error=%v", len(out), err)
 	}
 }
+
+func TestMergeFiles__maxLines(t *testing.T) {
+	f1, err := readACHFilepath(filepath.Join("test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f2, err := readACHFilepath(filepath.Join("test", "testdata", "web-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f2.Header = f1.Header // replace Header so they could be merged into one file
+	if err := f2.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := MergeFilesWith([]*File{f1, f2}, Conditions{MaxLines: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) < 2 {
+		t.Fatalf("expected files to be split, got %d", len(out))
+	}
+	batches := 0
+	for i := range out {
+		if n, err := countLines(out[i]); n > 10 && len(out[i].Batches) > 1 || err != nil {
+			t.Errorf("out[%d] has %d lines: %v", i, n, err)
+		}
+		batches += len(out[i].Batches)
+	}
+	if batches != 4 {
+		t.Errorf("found %d batches", batches)
+	}
+
+	// without conditions everything fits into one file
+	f1, _ = readACHFilepath(filepath.Join("test", "testdata", "ppd-debit.ach"))
+	f2.Header = f1.Header
+	out, err = MergeFilesWith([]*File{f1, f2}, Conditions{})
+	if len(out) != 1 || err != nil {
+		t.Errorf("got %d files, error=%v", len(out), err)
+	}
+}
@@ -0,0 +1,291 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package pgp encrypts, signs, decrypts and verifies ACH files with OpenPGP.
+//
+// Many ODFIs require NACHA files to be encrypted for their public key and signed by the
+// originator while in transit, and send returns encrypted the same way. A Config holds the
+// keyrings for both directions and NewWriter / NewReader wrap ach.Writer and ach.Reader.
+package pgp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/moov-io/ach"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+// ErrUnsigned is returned by Config.Decrypt when RequireSignature is set and the message isn't signed
+var ErrUnsigned = errors.New("message is not signed")
+
+// Config holds the keys used to encrypt and decrypt files
+type Config struct {
+	// PublicKeys are who files are encrypted for, and the keys which verify signatures of decrypted files.
+	PublicKeys openpgp.EntityList
+
+	// PrivateKeys decrypt files. The first key with a private key signs encrypted files.
+	PrivateKeys openpgp.EntityList
+
+	// Armor writes encrypted files as ASCII armored text rather than binary.
+	Armor bool
+
+	// RequireSignature rejects decrypted files which aren't signed by one of PublicKeys.
+	// Signed files are always verified.
+	RequireSignature bool
+}
+
+// LoadConfig reads a Config from keyring files, either of which can be empty. Encrypted
+// private keys are decrypted with passphrase.
+func LoadConfig(publicKeyringPath, privateKeyringPath string, passphrase []byte) (*Config, error) {
+	cfg := &Config{}
+	if publicKeyringPath != "" {
+		keys, err := ReadKeyRingFile(publicKeyringPath)
+		if err != nil {
+			return nil, err
+		}
+		cfg.PublicKeys = keys
+	}
+	if privateKeyringPath != "" {
+		keys, err := ReadKeyRingFile(privateKeyringPath)
+		if err != nil {
+			return nil, err
+		}
+		if err := DecryptPrivateKeys(keys, passphrase); err != nil {
+			return nil, err
+		}
+		cfg.PrivateKeys = keys
+	}
+	return cfg, nil
+}
+
+// ReadKeyRingFile reads an ASCII armored or binary keyring from path.
+func ReadKeyRingFile(path string) (openpgp.EntityList, error) {
+	fd, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	keys, err := ReadKeyRing(fd)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading keyring %s: %v", path, err)
+	}
+	return keys, nil
+}
+
+// ReadKeyRing reads an ASCII armored or binary keyring from r.
+func ReadKeyRing(r io.Reader) (openpgp.EntityList, error) {
+	br := bufio.NewReader(r)
+	if isArmored(br) {
+		return openpgp.ReadArmoredKeyRing(br)
+	}
+	return openpgp.ReadKeyRing(br)
+}
+
+// DecryptPrivateKeys decrypts every encrypted private key (and subkey) of keys with passphrase.
+func DecryptPrivateKeys(keys openpgp.EntityList, passphrase []byte) error {
+	for _, e := range keys {
+		if e.PrivateKey != nil && e.PrivateKey.Encrypted {
+			if err := e.PrivateKey.Decrypt(passphrase); err != nil {
+				return fmt.Errorf("problem decrypting key %s: %v", e.PrimaryKey.KeyIdString(), err)
+			}
+		}
+		for _, sub := range e.Subkeys {
+			if sub.PrivateKey != nil && sub.PrivateKey.Encrypted {
+				if err := sub.PrivateKey.Decrypt(passphrase); err != nil {
+					return fmt.Errorf("problem decrypting subkey %s: %v", sub.PublicKey.KeyIdString(), err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// signer returns the first private key able to sign, or nil
+func (cfg *Config) signer() *openpgp.Entity {
+	for _, e := range cfg.PrivateKeys {
+		if e.PrivateKey != nil && !e.PrivateKey.Encrypted {
+			return e
+		}
+	}
+	return nil
+}
+
+// Encrypt returns an io.WriteCloser which encrypts data written to it for PublicKeys (and signs
+// it if there's a private key) onto w. Close must be called to write the end of the message.
+func (cfg *Config) Encrypt(w io.Writer) (io.WriteCloser, error) {
+	if cfg == nil || len(cfg.PublicKeys) == 0 {
+		return nil, errors.New("no public keys to encrypt for")
+	}
+	out := &encryptWriter{}
+	if cfg.Armor {
+		aw, err := armor.Encode(w, "PGP MESSAGE", nil)
+		if err != nil {
+			return nil, err
+		}
+		out.armor = aw
+		w = aw
+	}
+	hints := &openpgp.FileHints{IsBinary: true}
+	enc, err := openpgp.Encrypt(w, cfg.PublicKeys, cfg.signer(), hints, &packet.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("problem encrypting: %v", err)
+	}
+	out.WriteCloser = enc
+	return out, nil
+}
+
+// encryptWriter closes the armor encoder after the encrypted message
+type encryptWriter struct {
+	io.WriteCloser
+	armor io.WriteCloser
+}
+
+func (w *encryptWriter) Close() error {
+	if err := w.WriteCloser.Close(); err != nil {
+		return err
+	}
+	if w.armor != nil {
+		return w.armor.Close()
+	}
+	return nil
+}
+
+// Decrypt reads an ASCII armored or binary OpenPGP message from r and returns its contents.
+// Signed messages must be signed by one of PublicKeys.
+func (cfg *Config) Decrypt(r io.Reader) ([]byte, error) {
+	if cfg == nil {
+		return nil, errors.New("no keys to decrypt with")
+	}
+	br := bufio.NewReader(r)
+	var in io.Reader = br
+	if isArmored(br) {
+		block, err := armor.Decode(br)
+		if err != nil {
+			return nil, fmt.Errorf("problem reading armor: %v", err)
+		}
+		in = block.Body
+	}
+
+	keyring := append(append(openpgp.EntityList{}, cfg.PrivateKeys...), cfg.PublicKeys...)
+	md, err := openpgp.ReadMessage(in, keyring, nil, &packet.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("problem decrypting: %v", err)
+	}
+	bs, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return nil, fmt.Errorf("problem decrypting: %v", err)
+	}
+
+	// Signatures are checked once the entire body has been read
+	if md.IsSigned {
+		if md.SignedBy == nil {
+			return nil, fmt.Errorf("message signed by unknown key %X", md.SignedByKeyId)
+		}
+		if md.SignatureError != nil {
+			return nil, fmt.Errorf("invalid signature: %v", md.SignatureError)
+		}
+	} else if cfg.RequireSignature {
+		return nil, ErrUnsigned
+	}
+	return bs, nil
+}
+
+// IsEncrypted returns true if bs looks like an ASCII armored or binary OpenPGP message.
+func IsEncrypted(bs []byte) bool {
+	if bytes.HasPrefix(bytes.TrimSpace(bs), []byte("-----BEGIN PGP MESSAGE-----")) {
+		return true
+	}
+	if len(bs) == 0 || bs[0]&0x80 == 0 {
+		return false
+	}
+	// The first packet of an encrypted message is an encrypted session key
+	p, err := packet.Read(bytes.NewReader(bs))
+	switch p.(type) {
+	case *packet.EncryptedKey, *packet.SymmetricKeyEncrypted:
+		return err == nil
+	}
+	return false
+}
+
+func isArmored(br *bufio.Reader) bool {
+	peek, _ := br.Peek(64)
+	return bytes.HasPrefix(bytes.TrimLeft(peek, " \t\r\n"), []byte("-----BEGIN "))
+}
+
+// Writer writes ACH files encrypted by a Config
+type Writer struct {
+	w   io.Writer
+	cfg *Config
+}
+
+// NewWriter returns a Writer which encrypts files with cfg onto w.
+func NewWriter(w io.Writer, cfg *Config) *Writer {
+	return &Writer{w: w, cfg: cfg}
+}
+
+// Write encrypts file as a NACHA formatted file.
+func (w *Writer) Write(file *ach.File) error {
+	enc, err := w.cfg.Encrypt(w.w)
+	if err != nil {
+		return err
+	}
+	if err := ach.NewWriter(enc).Write(file); err != nil {
+		enc.Close()
+		return err
+	}
+	return enc.Close()
+}
+
+// Reader reads ACH files decrypted by a Config
+type Reader struct {
+	r    io.Reader
+	cfg  *Config
+	opts *ach.ValidateOpts
+}
+
+// NewReader returns a Reader which decrypts a NACHA formatted file from r with cfg.
+func NewReader(r io.Reader, cfg *Config) *Reader {
+	return &Reader{r: r, cfg: cfg}
+}
+
+// SetValidation sets the ValidateOpts of the underlying ach.Reader
+func (r *Reader) SetValidation(opts *ach.ValidateOpts) {
+	r.opts = opts
+}
+
+// Read decrypts, verifies and parses the file.
+func (r *Reader) Read() (ach.File, error) {
+	bs, err := r.cfg.Decrypt(r.r)
+	if err != nil {
+		return ach.File{}, err
+	}
+	rdr := ach.NewReader(bytes.NewReader(bs))
+	if r.opts != nil {
+		rdr.SetValidation(r.opts)
+	}
+	return rdr.Read()
+}
@@ -0,0 +1,239 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pgp
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+func testEntity(t *testing.T, name string) *openpgp.Entity {
+	t.Helper()
+
+	e, err := openpgp.NewEntity(name, "", name+"@example.com", &packet.Config{RSABits: 1024})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// prefer SHA-256 and AES-256 like keys created by gpg, otherwise RIPEMD-160 is chosen
+	for _, id := range e.Identities {
+		id.SelfSignature.PreferredHash = []uint8{8}
+		id.SelfSignature.PreferredSymmetric = []uint8{uint8(packet.CipherAES256)}
+		if err := id.SelfSignature.SignUserId(id.UserId.Id, e.PrimaryKey, e.PrivateKey, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return e
+}
+
+// publicKey returns e without its private keys
+func publicKey(t *testing.T, e *openpgp.Entity) *openpgp.Entity {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := e.Serialize(&buf); err != nil {
+		t.Fatal(err)
+	}
+	keys, err := ReadKeyRing(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys[0]
+}
+
+// testConfigs returns Configs for an originator sending files to their ODFI and the ODFI receiving them
+func testConfigs(t *testing.T) (originator *Config, odfi *Config) {
+	t.Helper()
+
+	us, bank := testEntity(t, "originator"), testEntity(t, "odfi")
+	originator = &Config{
+		PublicKeys:  openpgp.EntityList{publicKey(t, bank)},
+		PrivateKeys: openpgp.EntityList{us},
+	}
+	odfi = &Config{
+		PublicKeys:       openpgp.EntityList{publicKey(t, us)},
+		PrivateKeys:      openpgp.EntityList{bank},
+		RequireSignature: true,
+	}
+	return originator, odfi
+}
+
+func readTestFile(t *testing.T) *ach.File {
+	t.Helper()
+
+	file, err := ach.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestPGP__WriterReader(t *testing.T) {
+	originator, odfi := testConfigs(t)
+	file := readTestFile(t)
+
+	for _, armored := range []bool{false, true} {
+		originator.Armor = armored
+
+		var buf bytes.Buffer
+		if err := NewWriter(&buf, originator).Write(file); err != nil {
+			t.Fatal(err)
+		}
+		if !IsEncrypted(buf.Bytes()) {
+			t.Errorf("armor=%v: expected encrypted file", armored)
+		}
+		if armored != strings.HasPrefix(buf.String(), "-----BEGIN PGP MESSAGE-----") {
+			t.Errorf("armor=%v: unexpected output:\n%q", armored, buf.String()[:40])
+		}
+		if bytes.Contains(buf.Bytes(), []byte(file.Header.ImmediateOriginName)) {
+			t.Errorf("armor=%v: plaintext found", armored)
+		}
+
+		r := NewReader(bytes.NewReader(buf.Bytes()), odfi)
+		r.SetValidation(&ach.ValidateOpts{})
+		got, err := r.Read()
+		if err != nil {
+			t.Fatalf("armor=%v: %v", armored, err)
+		}
+		if got.Header.ImmediateOrigin != file.Header.ImmediateOrigin || len(got.Batches) != 1 {
+			t.Errorf("armor=%v: unexpected file: %#v", armored, got)
+		}
+
+		// the originator can't decrypt what it sent
+		if _, err := NewReader(bytes.NewReader(buf.Bytes()), originator).Read(); err == nil {
+			t.Errorf("armor=%v: expected error", armored)
+		}
+	}
+}
+
+func TestPGP__signatures(t *testing.T) {
+	originator, odfi := testConfigs(t)
+	file := readTestFile(t)
+
+	// unsigned files are rejected when signatures are required
+	unsigned := &Config{PublicKeys: originator.PublicKeys}
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, unsigned).Write(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := odfi.Decrypt(bytes.NewReader(buf.Bytes())); err != ErrUnsigned {
+		t.Errorf("unexpected error: %v", err)
+	}
+	odfi.RequireSignature = false
+	if _, err := odfi.Decrypt(bytes.NewReader(buf.Bytes())); err != nil {
+		t.Error(err)
+	}
+
+	// files signed by someone else are always rejected
+	other := &Config{
+		PublicKeys:  originator.PublicKeys,
+		PrivateKeys: openpgp.EntityList{testEntity(t, "other")},
+	}
+	buf.Reset()
+	if err := NewWriter(&buf, other).Write(file); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := odfi.Decrypt(bytes.NewReader(buf.Bytes())); err == nil || !strings.Contains(err.Error(), "unknown key") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPGP__errors(t *testing.T) {
+	var cfg *Config
+	if _, err := cfg.Encrypt(ioutil.Discard); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := cfg.Decrypt(strings.NewReader("")); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := (&Config{}).Encrypt(ioutil.Discard); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := (&Config{}).Decrypt(strings.NewReader("101 plain file")); err == nil {
+		t.Error("expected error")
+	}
+
+	if IsEncrypted(nil) || IsEncrypted([]byte("101 231380104")) {
+		t.Error("plaintext is not encrypted")
+	}
+}
+
+func TestPGP__LoadConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	// binary public keyring of the ODFI
+	bank := testEntity(t, "odfi")
+	var public bytes.Buffer
+	if err := bank.Serialize(&public); err != nil {
+		t.Fatal(err)
+	}
+	publicPath := filepath.Join(dir, "odfi.gpg")
+	if err := ioutil.WriteFile(publicPath, public.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// armored private keyring protected by a passphrase
+	privatePath := filepath.Join("..", "test", "testdata", "pgp-originator.key")
+
+	cfg, err := LoadConfig(publicPath, privatePath, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.PublicKeys) != 1 || len(cfg.PrivateKeys) != 1 || cfg.signer() == nil {
+		t.Fatalf("unexpected config: %#v", cfg)
+	}
+
+	// the ODFI can read files from the loaded config
+	var buf bytes.Buffer
+	if err := NewWriter(&buf, cfg).Write(readTestFile(t)); err != nil {
+		t.Fatal(err)
+	}
+	us, err := ReadKeyRingFile(filepath.Join("..", "test", "testdata", "pgp-originator.pub"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	odfi := &Config{
+		PublicKeys:       us,
+		PrivateKeys:      openpgp.EntityList{bank},
+		RequireSignature: true,
+	}
+	if _, err := NewReader(&buf, odfi).Read(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadConfig(publicPath, privatePath, []byte("wrong")); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := LoadConfig(filepath.Join(dir, "missing"), "", nil); !os.IsNotExist(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := LoadConfig("", filepath.Join(dir, "missing"), nil); !os.IsNotExist(err) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := ReadKeyRing(strings.NewReader("-----BEGIN PGP PUBLIC KEY BLOCK-----\n")); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -46,7 +46,7 @@ type createBatchResponse struct {
 func (r createBatchResponse) error() error { return r.Err }
 
 func createBatchEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(createBatchRequest)
 		if !ok {
 			err := errors.New("invalid request")
@@ -55,6 +55,8 @@ func createBatchEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
 			}, err
 		}
 
+		s := forRequest(ctx, s)
+
 		id, err := s.CreateBatch(req.FileID, req.Batch)
 
 		if logger != nil {
@@ -226,7 +228,7 @@ func decodeDeleteBatchRequest(_ context.Context, r *http.Request) (interface{},
 }
 
 func deleteBatchEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(deleteBatchRequest)
 		if !ok {
 			err := errors.New("invalid request")
@@ -235,6 +237,8 @@ func deleteBatchEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
 			}, err
 		}
 
+		s := forRequest(ctx, s)
+
 		err := s.DeleteBatch(req.fileID, req.batchID)
 
 		if logger != nil {
@@ -0,0 +1,375 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/csvimport"
+	"github.com/moov-io/ach/iso20022"
+	"github.com/moov-io/ach/pgp"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+// addConvertRoutes adds POST /validate and POST /convert, which read the file from the request
+// body and never store it.
+func addConvertRoutes(r *mux.Router, logger log.Logger, cfg handlerOptions, options []httptransport.ServerOption) {
+	mapping := cfg.csvMapping
+	if mapping == nil {
+		mapping = csvimport.DefaultMapping()
+	}
+	r.Methods("POST").Path("/validate").Handler(httptransport.NewServer(
+		validateContentsEndpoint(logger),
+		decodeValidateContentsRequest(mapping, cfg.strictJSON, cfg.pgpKeys),
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path("/convert").Handler(httptransport.NewServer(
+		convertFileEndpoint(logger),
+		decodeConvertFileRequest(mapping, cfg.strictJSON, cfg.pgpKeys),
+		encodeTextResponse,
+		options...,
+	))
+}
+
+// readFileBody returns the request body, decrypted when it's an OpenPGP message, and its Content-Type
+func readFileBody(r *http.Request, keys *pgp.Config) ([]byte, string, error) {
+	bs, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, "", err
+	}
+	contentType := strings.ToLower(r.Header.Get("Content-Type"))
+	if strings.Contains(contentType, pgpContentType) || pgp.IsEncrypted(bs) {
+		if bs, err = decryptFile(keys, bs); err != nil {
+			return nil, "", err
+		}
+		contentType = "text/plain"
+	}
+	return bs, contentType, nil
+}
+
+// finding is one problem found in a file, with the line (or CSV row) it's on when known
+type finding struct {
+	Line    int    `json:"line,omitempty"`
+	Record  string `json:"record,omitempty"`
+	Message string `json:"message"`
+}
+
+// findings flattens err (such as the base.ErrorList from ach.Reader) into a finding for each error
+func findings(err error) []finding {
+	switch e := err.(type) {
+	case nil:
+		return nil
+	case base.ErrorList:
+		var out []finding
+		for i := range e {
+			out = append(out, findings(e[i])...)
+		}
+		return out
+	case *base.ParseError:
+		return []finding{{Line: e.Line, Record: e.Record, Message: e.Err.Error()}}
+	case base.ParseError:
+		return []finding{{Line: e.Line, Record: e.Record, Message: e.Err.Error()}}
+	}
+	return []finding{{Message: err.Error()}}
+}
+
+type validateContentsRequest struct {
+	file      *ach.File
+	parsed    bool // the whole file was read
+	findings  []finding
+	opts      *ach.ValidateOpts
+	requestID string
+}
+
+type validateContentsResponse struct {
+	Valid    bool      `json:"valid"`
+	Findings []finding `json:"findings"`
+	Err      error     `json:"error"`
+}
+
+func (r validateContentsResponse) count() int { return len(r.Findings) }
+
+func (r validateContentsResponse) error() error { return r.Err }
+
+// validateContentsEndpoint checks the header, each batch and then the controls of the file,
+// instead of stopping at the first problem like (*ach.File).ValidateWith
+func validateContentsEndpoint(logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(validateContentsRequest)
+		if !ok {
+			return validateContentsResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		out := req.findings
+		seen := make(map[string]bool)
+		for i := range out {
+			seen[out[i].Message] = true
+		}
+		add := func(err error) {
+			for _, f := range findings(err) {
+				if !seen[f.Message] {
+					seen[f.Message] = true
+					out = append(out, f)
+				}
+			}
+		}
+		if f := req.file; f != nil {
+			add(f.Header.ValidateWith(req.opts))
+			for i := range f.Batches {
+				add(f.Batches[i].Validate())
+			}
+			for i := range f.IATBatches {
+				add(f.IATBatches[i].Validate())
+			}
+			// controls of partially read files are missing
+			if req.parsed {
+				add(f.ValidateWith(req.opts))
+			}
+		}
+
+		if logger != nil {
+			logger.Log("files", "validateContents", "findings", len(out), "requestID", req.requestID)
+		}
+		if out == nil {
+			out = []finding{}
+		}
+		return validateContentsResponse{
+			Valid:    len(out) == 0,
+			Findings: out,
+		}, nil
+	}
+}
+
+// decodeValidateContentsRequest reads a file like POST /files/create does. The requireABAOrigin,
+// bypassOriginValidation and bypassDestinationValidation query parameters set ach.ValidateOpts.
+func decodeValidateContentsRequest(mapping *csvimport.Mapping, strict bool, keys *pgp.Config) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		opts, err := validateOptsFromQuery(r)
+		if err != nil {
+			return nil, err
+		}
+		bs, contentType, err := readFileBody(r, keys)
+		if err != nil {
+			return nil, err
+		}
+
+		req := validateContentsRequest{
+			opts:      opts,
+			requestID: moovhttp.GetRequestID(r),
+		}
+		req.file, err = parseFile(bs, contentType, mapping, strict, opts)
+		if req.file != nil && strings.Contains(contentType, "application/json") {
+			// JSON files are returned with the first validation error, which are all found again
+			err = nil
+		}
+		req.parsed = err == nil
+		req.findings = findings(err)
+		return req, nil
+	}
+}
+
+func validateOptsFromQuery(r *http.Request) (*ach.ValidateOpts, error) {
+	q := r.URL.Query()
+	var opts ach.ValidateOpts
+	for name, v := range map[string]*bool{
+		"requireABAOrigin":            &opts.RequireABAOrigin,
+		"bypassOriginValidation":      &opts.BypassOriginValidation,
+		"bypassDestinationValidation": &opts.BypassDestinationValidation,
+	} {
+		if s := q.Get(name); s != "" {
+			b, err := strconv.ParseBool(s)
+			if err != nil {
+				return nil, fmt.Errorf("%v: invalid %s parameter: %v", errInvalidQuery, name, err)
+			}
+			*v = b
+		}
+	}
+	return &opts, nil
+}
+
+type convertFileRequest struct {
+	file      *ach.File
+	to        string
+	friendly  bool
+	err       error
+	requestID string
+}
+
+type convertFileResponse struct {
+	Err error `json:"error"`
+}
+
+func (r convertFileResponse) error() error { return r.Err }
+
+// convertFileEndpoint writes the file as NACHA (to=ach), JSON, XML or an ISO 20022 message
+// (to=pain.001 or to=camt.054)
+func convertFileEndpoint(logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(convertFileRequest)
+		if !ok {
+			return convertFileResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		var buf bytes.Buffer
+		contentType := "text/plain"
+		err := req.err
+		if err == nil {
+			if err = req.file.Create(); err != nil {
+				err = fmt.Errorf("%v: %v", errInvalidFile, err)
+			}
+		}
+		if err == nil {
+			switch req.to {
+			case "ach":
+				w := ach.NewWriter(&buf)
+				if err = w.Write(req.file); err == nil {
+					err = w.Flush()
+				}
+			case "json":
+				contentType = "application/json; charset=utf-8"
+				var bs []byte
+				if req.friendly {
+					bs, err = req.file.MarshalFriendlyJSON()
+				} else {
+					bs, err = json.Marshal(req.file)
+				}
+				buf.Write(bs)
+			case "xml":
+				contentType = "application/xml"
+				buf.WriteString(xml.Header)
+				enc := xml.NewEncoder(&buf)
+				enc.Indent("", "  ")
+				err = enc.Encode(req.file)
+			case "pain.001":
+				contentType = "application/xml"
+				_, err = iso20022.WritePain001(&buf, req.file)
+			case "camt.054":
+				contentType = "application/xml"
+				_, err = iso20022.WriteCamt054(&buf, req.file)
+			}
+			if err != nil {
+				err = fmt.Errorf("%v: problem writing %s: %v", errInvalidFile, req.to, err)
+			}
+		}
+
+		if logger != nil {
+			logger.Log("files", "convertFile", "to", req.to, "requestID", req.requestID, "error", err)
+		}
+		if err != nil {
+			return convertFileResponse{Err: err}, nil
+		}
+		return fileContents{Reader: &buf, contentType: contentType}, nil
+	}
+}
+
+// convertFrom are the 'from' values of POST /convert read by parseFile and the Content-Type they're read as.
+// XML files are read with ach.FileFromXML and ISO 20022 messages with iso20022.Read instead.
+var convertFrom = map[string]string{
+	"ach":  "text/plain",
+	"json": "application/json",
+	"csv":  "text/csv",
+}
+
+// convertFromContentType returns the format of files sent to POST /convert without a 'from' parameter.
+// ISO 20022 messages are XML too, so they need from=iso20022.
+func convertFromContentType(contentType string) string {
+	switch {
+	case strings.Contains(contentType, "application/json"):
+		return "json"
+	case strings.Contains(contentType, "text/csv"):
+		return "csv"
+	case strings.Contains(contentType, "xml"):
+		return "xml"
+	}
+	return "ach"
+}
+
+// decodeConvertFileRequest reads a file in the 'from' format (or else that of its Content-Type) and
+// the format to write it in. JSON is written with (*ach.File).MarshalFriendlyJSON for requests with
+// 'Accept: application/json; format=friendly' and friendly JSON is read with that Content-Type.
+func decodeConvertFileRequest(mapping *csvimport.Mapping, strict bool, keys *pgp.Config) httptransport.DecodeRequestFunc {
+	return func(_ context.Context, r *http.Request) (interface{}, error) {
+		q := r.URL.Query()
+		from, to := strings.ToLower(q.Get("from")), strings.ToLower(q.Get("to"))
+		switch from {
+		case "", "ach", "json", "csv", "xml", "iso20022":
+		default:
+			return nil, fmt.Errorf("%v: unknown format from=%q", errInvalidQuery, from)
+		}
+		switch to {
+		case "ach", "json", "xml", "pain.001", "camt.054":
+		default:
+			return nil, fmt.Errorf("%v: unknown format to=%q", errInvalidQuery, to)
+		}
+		bs, contentType, err := readFileBody(r, keys)
+		if err != nil {
+			return nil, err
+		}
+		if from == "" {
+			from = convertFromContentType(contentType)
+		}
+
+		req := convertFileRequest{
+			to:        to,
+			friendly:  friendlyJSON(r.Header.Get("Accept")),
+			requestID: moovhttp.GetRequestID(r),
+		}
+		switch from {
+		case "xml":
+			if req.file, err = ach.FileFromXML(bs); err != nil {
+				req.err = fmt.Errorf("%v: %v", errInvalidFile, err)
+			}
+
+		case "iso20022":
+			opts := &iso20022.Options{
+				ImmediateOrigin:          q.Get("immediateOrigin"),
+				ImmediateOriginName:      q.Get("immediateOriginName"),
+				ImmediateDestination:     q.Get("immediateDestination"),
+				ImmediateDestinationName: q.Get("immediateDestinationName"),
+			}
+			if req.file, _, err = iso20022.Read(bytes.NewReader(bs), opts); err != nil {
+				req.err = fmt.Errorf("%v: %v", errInvalidISO20022, err)
+			}
+
+		default:
+			if from != "json" || !friendlyJSON(contentType) {
+				contentType = convertFrom[from]
+			}
+			if req.file, err = parseFile(bs, contentType, mapping, strict, nil); err != nil {
+				req.err = fmt.Errorf("%v: %v", errInvalidFile, err)
+			}
+		}
+		return req, nil
+	}
+}
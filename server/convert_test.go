@@ -0,0 +1,176 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+
+	"github.com/go-kit/kit/log"
+)
+
+func serveContents(t *testing.T, handler http.Handler, path, contentType string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", contentType)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+	return w
+}
+
+func TestConvert__validateContents(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	validate := func(path, contentType string, body []byte) validateContentsResponse {
+		t.Helper()
+
+		w := serveContents(t, handler, path, contentType, body)
+		if w.Code != http.StatusOK {
+			t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+		}
+		var resp validateContentsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	if resp := validate("/validate", "text/plain", readTestdata(t, "ppd-debit.ach")); !resp.Valid || len(resp.Findings) != 0 {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+
+	// a bad routing number in the header and the wrong batch totals are both found
+	lines := strings.Split(string(readTestdata(t, "ppd-debit.ach")), "\n")
+	lines[0] = strings.Replace(lines[0], " 231380104", " 23138010X", 1)
+	lines[2] = strings.Replace(lines[2], "0100000000", "0100000099", 1)
+	resp := validate("/validate", "text/plain", []byte(strings.Join(lines, "\n")))
+	if resp.Valid || len(resp.Findings) != 2 {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+	if f := resp.Findings[0]; f.Line != 1 || f.Record != "FileHeader" || !strings.Contains(f.Message, "ImmediateDestination") {
+		t.Errorf("unexpected finding: %#v", f)
+	}
+	if f := resp.Findings[1]; f.Line != 4 || !strings.Contains(f.Message, "TotalDebitEntryDollarAmount") {
+		t.Errorf("unexpected finding: %#v", f)
+	}
+
+	// JSON files are validated after they're read
+	resp = validate("/validate", "application/json", readTestdata(t, "ppd-invalid-EntryDetail-checkDigit.json"))
+	if resp.Valid || len(resp.Findings) != 1 || !strings.Contains(resp.Findings[0].Message, "check digit") {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+	resp = validate("/validate?bypassDestinationValidation=true", "application/json", readTestdata(t, "ppd-valid.json"))
+	if !resp.Valid {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+	if w := serveContents(t, handler, "/validate?requireABAOrigin=maybe", "text/plain", nil); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+
+	if n := len(repo.FindAllFiles()); n != 0 {
+		t.Errorf("stored %d files", n)
+	}
+}
+
+func TestConvert__convertFile(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	// NACHA to JSON and back again
+	w := serveContents(t, handler, "/convert?from=ach&to=json", "text/plain", readTestdata(t, "ppd-debit.ach"))
+	if w.Code != http.StatusOK || !strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	file, err := ach.FileFromJSON(w.Body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(file.Batches) != 1 || file.Batches[0].GetEntries()[0].Amount != 100000000 {
+		t.Errorf("unexpected file: %#v", file)
+	}
+
+	bs, _ := json.Marshal(file)
+	w = serveContents(t, handler, "/convert?to=ach", "application/json", bs)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if _, err := ach.NewReader(bytes.NewReader(w.Body.Bytes())).Read(); err != nil {
+		t.Errorf("problem reading converted file: %v\n%s", err, w.Body.String())
+	}
+
+	// ISO 20022 to NACHA and back again
+	w = serveContents(t, handler, "/convert?from=iso20022&to=ach&immediateDestination=231380104", "", readTestdata(t, "pain001.xml"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	w = serveContents(t, handler, "/convert?to=pain.001", "text/plain", w.Body.Bytes())
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/xml" || !strings.Contains(w.Body.String(), "CstmrCdtTrfInitn") {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// XML (as written with to=xml) to NACHA
+	w = serveContents(t, handler, "/convert?from=ach&to=xml", "text/plain", readTestdata(t, "ppd-debit.ach"))
+	if w.Code != http.StatusOK || w.Header().Get("Content-Type") != "application/xml" {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	for _, path := range []string{"/convert?from=xml&to=ach", "/convert?to=ach"} {
+		w := serveContents(t, handler, path, "application/xml", w.Body.Bytes())
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: bogus HTTP status: %d: %s", path, w.Code, w.Body.String())
+		}
+		if f, err := ach.NewReader(bytes.NewReader(w.Body.Bytes())).Read(); err != nil || f.Batches[0].GetEntries()[0].Amount != 100000000 {
+			t.Errorf("%s: problem reading converted file: %v\n%s", path, err, w.Body.String())
+		}
+	}
+	if w := serveContents(t, handler, "/convert?from=xml&to=ach", "application/xml", readTestdata(t, "pain001.xml")); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// friendly JSON is written for requests accepting it
+	req := httptest.NewRequest("POST", "/convert?to=json", bytes.NewReader(readTestdata(t, "ppd-debit.ach")))
+	req.Header.Set("Accept", "application/json; format=friendly")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"1000000.00"`) {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// problems
+	if w := serveContents(t, handler, "/convert?to=yaml", "text/plain", readTestdata(t, "ppd-debit.ach")); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveContents(t, handler, "/convert?from=yaml&to=json", "text/plain", readTestdata(t, "ppd-debit.ach")); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveContents(t, handler, "/convert?to=json", "text/plain", []byte("not an ACH file")); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	if n := len(repo.FindAllFiles()); n != 0 {
+		t.Errorf("stored %d files", n)
+	}
+}
@@ -0,0 +1,114 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"sync"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/dedupe"
+)
+
+// WithDuplicateDetector returns a Service which refuses to store files through s that detector has
+// seen before, returning a *dedupe.DuplicateError. Give it to NewGRPCServer so calls reject the same
+// files as HTTP requests to MakeHTTPHandler with RejectDuplicateFiles.
+func WithDuplicateDetector(s Service, detector *dedupe.Detector) Service {
+	return withDuplicates(s, detector)
+}
+
+// withDuplicates returns s checking files against detector, which can be nil
+func withDuplicates(s Service, detector *dedupe.Detector) Service {
+	if detector == nil {
+		return s
+	}
+	for inner := s; inner != nil; inner = unwrapService(inner) {
+		if ds, ok := inner.(*duplicateService); ok && ds.detector == detector {
+			return s // already checking files
+		}
+	}
+	return &duplicateService{
+		Service:   s,
+		detector:  detector,
+		unchecked: new(sync.Map),
+	}
+}
+
+// duplicateService rejects files stored through its Service which have been seen before, whichever
+// transport (HTTP or gRPC) they came from. Files are recorded once they're stored so a failed attempt
+// can be retried.
+type duplicateService struct {
+	Service
+
+	detector *dedupe.Detector
+
+	// unchecked holds the IDs of files to store without checking or recording them, such as
+	// those created from stored files which share their FileHeader
+	unchecked *sync.Map
+}
+
+func (s *duplicateService) StoreFile(f *ach.File) error {
+	if f != nil {
+		if _, ok := s.unchecked.Load(f.ID); ok {
+			s.unchecked.Delete(f.ID)
+			return s.Service.StoreFile(f)
+		}
+	}
+	return s.detector.CheckAndStore(f, s.Service.StoreFile)
+}
+
+func (s *duplicateService) SegmentFile(id string, opts *ach.SegmentFileConfiguration) (*ach.File, *ach.File, error) {
+	credit, debit, err := s.Service.SegmentFile(id, opts)
+	if err == nil {
+		s.skip(credit, debit)
+	}
+	return credit, debit, err
+}
+
+func (s *duplicateService) FlattenBatches(id string) (*ach.File, error) {
+	f, err := s.Service.FlattenBatches(id)
+	if err == nil {
+		s.skip(f)
+	}
+	return f, err
+}
+
+func (s *duplicateService) MergeFiles(fileIDs []string, conditions ach.Conditions) ([]*ach.File, error) {
+	files, err := s.Service.MergeFiles(fileIDs, conditions)
+	if err == nil {
+		s.skip(files...)
+	}
+	return files, err
+}
+
+// skip stores files without checking them
+func (s *duplicateService) skip(files ...*ach.File) {
+	for i := range files {
+		if files[i] != nil && files[i].ID != "" {
+			s.unchecked.Store(files[i].ID, true)
+		}
+	}
+}
+
+// skipDuplicateCheck stores f through s without checking it for duplicates, or recording it
+func skipDuplicateCheck(s Service, f *ach.File) {
+	for inner := s; inner != nil; inner = unwrapService(inner) {
+		if ds, ok := inner.(*duplicateService); ok {
+			ds.skip(f)
+		}
+	}
+}
@@ -0,0 +1,549 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+var (
+	errInvalidEntry = errors.New("invalid entry")
+)
+
+// Addenda is an addenda record of an EntryDetail: *ach.Addenda02, *ach.Addenda05, *ach.Addenda98 or *ach.Addenda99
+type Addenda interface {
+	String() string
+	Validate() error
+}
+
+func getAddendaID(a Addenda) string {
+	switch a := a.(type) {
+	case *ach.Addenda02:
+		return a.ID
+	case *ach.Addenda05:
+		return a.ID
+	case *ach.Addenda98:
+		return a.ID
+	case *ach.Addenda99:
+		return a.ID
+	}
+	return ""
+}
+
+func setAddendaID(a Addenda, id string) {
+	switch a := a.(type) {
+	case *ach.Addenda02:
+		a.ID = id
+	case *ach.Addenda05:
+		a.ID = id
+	case *ach.Addenda98:
+		a.ID = id
+	case *ach.Addenda99:
+		a.ID = id
+	}
+}
+
+// entryAddenda returns every addenda record of the entry
+func entryAddenda(entry *ach.EntryDetail) []Addenda {
+	var out []Addenda
+	if entry.Addenda02 != nil {
+		out = append(out, entry.Addenda02)
+	}
+	for i := range entry.Addenda05 {
+		out = append(out, entry.Addenda05[i])
+	}
+	if entry.Addenda98 != nil {
+		out = append(out, entry.Addenda98)
+	}
+	if entry.Addenda99 != nil {
+		out = append(out, entry.Addenda99)
+	}
+	return out
+}
+
+// addAddenda attaches the addenda record to the entry and updates its AddendaRecordIndicator and Category
+func addAddenda(entry *ach.EntryDetail, a Addenda) error {
+	switch a := a.(type) {
+	case *ach.Addenda02:
+		if entry.Addenda02 != nil {
+			return fmt.Errorf("%v: entry %s already has an Addenda02", errInvalidEntry, entry.ID)
+		}
+		entry.Addenda02 = a
+	case *ach.Addenda05:
+		entry.AddAddenda05(a)
+	case *ach.Addenda98:
+		if entry.Addenda98 != nil {
+			return fmt.Errorf("%v: entry %s already has an Addenda98", errInvalidEntry, entry.ID)
+		}
+		entry.Addenda98 = a
+		entry.Category = ach.CategoryNOC
+	case *ach.Addenda99:
+		if entry.Addenda99 != nil {
+			return fmt.Errorf("%v: entry %s already has an Addenda99", errInvalidEntry, entry.ID)
+		}
+		entry.Addenda99 = a
+		entry.Category = ach.CategoryReturn
+	default:
+		return fmt.Errorf("%v: unknown addenda %T", errInvalidEntry, a)
+	}
+	entry.AddendaRecordIndicator = 1
+	return nil
+}
+
+// removeAddenda removes the addenda record with id from the entry, returning false if it wasn't found
+func removeAddenda(entry *ach.EntryDetail, id string) bool {
+	found := false
+	switch {
+	case entry.Addenda02 != nil && entry.Addenda02.ID == id:
+		entry.Addenda02, found = nil, true
+	case entry.Addenda98 != nil && entry.Addenda98.ID == id:
+		entry.Addenda98, found = nil, true
+		entry.Category = ach.CategoryForward
+	case entry.Addenda99 != nil && entry.Addenda99.ID == id:
+		entry.Addenda99, found = nil, true
+		entry.Category = ach.CategoryForward
+	default:
+		for i := range entry.Addenda05 {
+			if entry.Addenda05[i].ID == id {
+				entry.Addenda05 = append(entry.Addenda05[:i], entry.Addenda05[i+1:]...)
+				found = true
+				break
+			}
+		}
+	}
+	if len(entryAddenda(entry)) == 0 {
+		entry.AddendaRecordIndicator = 0
+	}
+	return found
+}
+
+func setMissingAddendaIDs(entry *ach.EntryDetail) {
+	for _, a := range entryAddenda(entry) {
+		if getAddendaID(a) == "" {
+			setAddendaID(a, base.ID())
+		}
+	}
+}
+
+// setMissingIDs creates random IDs for batches, entries and addenda records of the file
+// which don't have one (e.g. files read from NACHA text), so they can be addressed in routes.
+func setMissingIDs(file *ach.File) {
+	for _, b := range file.Batches {
+		if b.ID() == "" {
+			id := b.GetHeader().ID
+			if id == "" {
+				id = base.ID()
+			}
+			b.SetID(id)
+			b.GetHeader().ID = id
+			if b.GetControl() != nil {
+				b.GetControl().ID = id
+			}
+		}
+		setMissingEntryIDs(b)
+	}
+}
+
+func setMissingEntryIDs(b ach.Batcher) {
+	for _, e := range b.GetEntries() {
+		if e.ID == "" {
+			e.ID = base.ID()
+		}
+		setMissingAddendaIDs(e)
+	}
+}
+
+// decodeAddenda reads an addenda record of the type named by its typeCode
+func decodeAddenda(bs []byte) (Addenda, error) {
+	var peek struct {
+		TypeCode string `json:"typeCode"`
+	}
+	if err := json.Unmarshal(bs, &peek); err != nil {
+		return nil, err
+	}
+	var a Addenda
+	switch peek.TypeCode {
+	case "02":
+		a = ach.NewAddenda02()
+	case "05":
+		a = ach.NewAddenda05()
+	case "98":
+		a = ach.NewAddenda98()
+	case "99":
+		a = ach.NewAddenda99()
+	default:
+		return nil, fmt.Errorf("%v: unsupported addenda typeCode %q", errInvalidEntry, peek.TypeCode)
+	}
+	if err := json.Unmarshal(bs, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func addEntryRoutes(r *mux.Router, s Service, logger log.Logger, options []httptransport.ServerOption) {
+	entries := "/files/{fileID}/batches/{batchID}/entries"
+	r.Methods("GET").Path(entries).Handler(httptransport.NewServer(
+		getEntriesEndpoint(s, logger),
+		decodeEntryRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path(entries).Handler(httptransport.NewServer(
+		createEntryEndpoint(s, logger),
+		decodeEntryRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("GET").Path(entries + "/{entryID}").Handler(httptransport.NewServer(
+		getEntryEndpoint(s, logger),
+		decodeEntryRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("PUT").Path(entries + "/{entryID}").Handler(httptransport.NewServer(
+		updateEntryEndpoint(s, logger),
+		decodeEntryRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("DELETE").Path(entries + "/{entryID}").Handler(httptransport.NewServer(
+		deleteEntryEndpoint(s, logger),
+		decodeEntryRequest,
+		encodeResponse,
+		options...,
+	))
+
+	addenda := entries + "/{entryID}/addenda"
+	r.Methods("GET").Path(addenda).Handler(httptransport.NewServer(
+		getAllAddendaEndpoint(s, logger),
+		decodeAddendaRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path(addenda).Handler(httptransport.NewServer(
+		createAddendaEndpoint(s, logger),
+		decodeAddendaRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("GET").Path(addenda + "/{addendaID}").Handler(httptransport.NewServer(
+		getAddendaEndpoint(s, logger),
+		decodeAddendaRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("PUT").Path(addenda + "/{addendaID}").Handler(httptransport.NewServer(
+		updateAddendaEndpoint(s, logger),
+		decodeAddendaRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("DELETE").Path(addenda + "/{addendaID}").Handler(httptransport.NewServer(
+		deleteAddendaEndpoint(s, logger),
+		decodeAddendaRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// entryRequest is used for every entry route, entryID and entry are only set on some routes
+type entryRequest struct {
+	fileID  string
+	batchID string
+	entryID string
+
+	entry *ach.EntryDetail
+
+	requestID string
+}
+
+func decodeEntryRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	req := entryRequest{
+		fileID:    vars["fileID"],
+		batchID:   vars["batchID"],
+		entryID:   vars["entryID"],
+		requestID: moovhttp.GetRequestID(r),
+	}
+	if req.fileID == "" || req.batchID == "" {
+		return nil, ErrBadRouting
+	}
+	if r.Method == "POST" || r.Method == "PUT" {
+		req.entry = ach.NewEntryDetail()
+		if err := json.NewDecoder(r.Body).Decode(req.entry); err != nil {
+			return nil, fmt.Errorf("%v: %v", errInvalidEntry, err)
+		}
+		if req.entryID != "" {
+			req.entry.ID = req.entryID // PUT always replaces the entry in the path
+		}
+	}
+	return req, nil
+}
+
+type getEntriesResponse struct {
+	Entries []*ach.EntryDetail `json:"entries"`
+	Err     error              `json:"error"`
+}
+
+func (r getEntriesResponse) count() int { return len(r.Entries) }
+
+func (r getEntriesResponse) error() error { return r.Err }
+
+func getEntriesEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(entryRequest)
+		if !ok {
+			return getEntriesResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+		entries, err := s.GetEntries(req.fileID, req.batchID)
+		if logger != nil {
+			logger.Log("entries", "getEntries", "file", req.fileID, "batch", req.batchID, "requestID", req.requestID, "error", err)
+		}
+		return getEntriesResponse{Entries: entries, Err: err}, nil
+	}
+}
+
+type entryResponse struct {
+	Entry *ach.EntryDetail `json:"entry"`
+	Err   error            `json:"error"`
+}
+
+func (r entryResponse) error() error { return r.Err }
+
+func getEntryEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(entryRequest)
+		if !ok {
+			return entryResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+		entry, err := s.GetEntry(req.fileID, req.batchID, req.entryID)
+		if logger != nil {
+			logger.Log("entries", "getEntry", "file", req.fileID, "batch", req.batchID, "entry", req.entryID, "requestID", req.requestID, "error", err)
+		}
+		return entryResponse{Entry: entry, Err: err}, nil
+	}
+}
+
+// entryIDResponse returns the ID of the entry (or addenda record) created or changed,
+// which identifies the record failing validation along with the error.
+type entryIDResponse struct {
+	ID  string `json:"id"`
+	Err error  `json:"error"`
+}
+
+func (r entryIDResponse) error() error { return r.Err }
+
+func createEntryEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(entryRequest)
+		if !ok {
+			return entryIDResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		id, err := s.CreateEntry(req.fileID, req.batchID, req.entry)
+		if logger != nil {
+			logger.Log("entries", "createEntry", "file", req.fileID, "batch", req.batchID, "entry", id, "requestID", req.requestID, "error", err)
+		}
+		return entryIDResponse{ID: id, Err: err}, nil
+	}
+}
+
+func updateEntryEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(entryRequest)
+		if !ok {
+			return entryIDResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		err := s.UpdateEntry(req.fileID, req.batchID, req.entry)
+		if logger != nil {
+			logger.Log("entries", "updateEntry", "file", req.fileID, "batch", req.batchID, "entry", req.entryID, "requestID", req.requestID, "error", err)
+		}
+		return entryIDResponse{ID: req.entryID, Err: err}, nil
+	}
+}
+
+func deleteEntryEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(entryRequest)
+		if !ok {
+			return entryIDResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		err := s.DeleteEntry(req.fileID, req.batchID, req.entryID)
+		if logger != nil {
+			logger.Log("entries", "deleteEntry", "file", req.fileID, "batch", req.batchID, "entry", req.entryID, "requestID", req.requestID, "error", err)
+		}
+		return entryIDResponse{ID: req.entryID, Err: err}, nil
+	}
+}
+
+// addendaRequest is used for every addenda route, addendaID and addenda are only set on some routes
+type addendaRequest struct {
+	fileID    string
+	batchID   string
+	entryID   string
+	addendaID string
+
+	addenda Addenda
+
+	requestID string
+}
+
+func decodeAddendaRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	vars := mux.Vars(r)
+	req := addendaRequest{
+		fileID:    vars["fileID"],
+		batchID:   vars["batchID"],
+		entryID:   vars["entryID"],
+		addendaID: vars["addendaID"],
+		requestID: moovhttp.GetRequestID(r),
+	}
+	if req.fileID == "" || req.batchID == "" || req.entryID == "" {
+		return nil, ErrBadRouting
+	}
+	if r.Method == "POST" || r.Method == "PUT" {
+		var raw json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			return nil, fmt.Errorf("%v: %v", errInvalidEntry, err)
+		}
+		a, err := decodeAddenda(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", errInvalidEntry, err)
+		}
+		if req.addendaID != "" {
+			setAddendaID(a, req.addendaID) // PUT always replaces the addenda record in the path
+		}
+		req.addenda = a
+	}
+	return req, nil
+}
+
+type getAllAddendaResponse struct {
+	Addenda []Addenda `json:"addenda"`
+	Err     error     `json:"error"`
+}
+
+func (r getAllAddendaResponse) count() int { return len(r.Addenda) }
+
+func (r getAllAddendaResponse) error() error { return r.Err }
+
+func getAllAddendaEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(addendaRequest)
+		if !ok {
+			return getAllAddendaResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+		addenda, err := s.GetAllAddenda(req.fileID, req.batchID, req.entryID)
+		if logger != nil {
+			logger.Log("entries", "getAllAddenda", "file", req.fileID, "entry", req.entryID, "requestID", req.requestID, "error", err)
+		}
+		return getAllAddendaResponse{Addenda: addenda, Err: err}, nil
+	}
+}
+
+type addendaResponse struct {
+	Addenda Addenda `json:"addenda"`
+	Err     error   `json:"error"`
+}
+
+func (r addendaResponse) error() error { return r.Err }
+
+func getAddendaEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(addendaRequest)
+		if !ok {
+			return addendaResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+		a, err := s.GetAddenda(req.fileID, req.batchID, req.entryID, req.addendaID)
+		if logger != nil {
+			logger.Log("entries", "getAddenda", "file", req.fileID, "entry", req.entryID, "addenda", req.addendaID, "requestID", req.requestID, "error", err)
+		}
+		return addendaResponse{Addenda: a, Err: err}, nil
+	}
+}
+
+func createAddendaEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(addendaRequest)
+		if !ok {
+			return entryIDResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		id, err := s.CreateAddenda(req.fileID, req.batchID, req.entryID, req.addenda)
+		if logger != nil {
+			logger.Log("entries", "createAddenda", "file", req.fileID, "entry", req.entryID, "addenda", id, "requestID", req.requestID, "error", err)
+		}
+		return entryIDResponse{ID: id, Err: err}, nil
+	}
+}
+
+func updateAddendaEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(addendaRequest)
+		if !ok {
+			return entryIDResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		err := s.UpdateAddenda(req.fileID, req.batchID, req.entryID, req.addenda)
+		if logger != nil {
+			logger.Log("entries", "updateAddenda", "file", req.fileID, "entry", req.entryID, "addenda", req.addendaID, "requestID", req.requestID, "error", err)
+		}
+		return entryIDResponse{ID: req.addendaID, Err: err}, nil
+	}
+}
+
+func deleteAddendaEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(addendaRequest)
+		if !ok {
+			return entryIDResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		err := s.DeleteAddenda(req.fileID, req.batchID, req.entryID, req.addendaID)
+		if logger != nil {
+			logger.Log("entries", "deleteAddenda", "file", req.fileID, "entry", req.entryID, "addenda", req.addendaID, "requestID", req.requestID, "error", err)
+		}
+		return entryIDResponse{ID: req.addendaID, Err: err}, nil
+	}
+}
@@ -0,0 +1,300 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+
+	"github.com/go-kit/kit/log"
+)
+
+// setupEntriesTest uploads ppd-debit.ach and returns the IDs of its batch and entry
+func setupEntriesTest(t *testing.T) (http.Handler, Service, string, string) {
+	t.Helper()
+
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger())
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+	req.Header.Set("Content-Type", "text/plain")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	var resp createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+
+	// batches and entries read from NACHA files are given IDs
+	file, err := svc.GetFile(resp.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := file.Batches[0]
+	if batch.ID() == "" || batch.GetEntries()[0].ID == "" {
+		t.Fatalf("batch=%q entry=%q", batch.ID(), batch.GetEntries()[0].ID)
+	}
+	return handler, svc, file.ID, batch.ID()
+}
+
+func serveJSON(t *testing.T, handler http.Handler, method, path string, body interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var r io.Reader
+	if body != nil {
+		bs, err := json.Marshal(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r = bytes.NewReader(bs)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(method, path, r))
+	w.Flush()
+	return w
+}
+
+func mockPPDDebitEntry() *ach.EntryDetail {
+	entry := ach.NewEntryDetail()
+	entry.ID = "debit"
+	entry.TransactionCode = ach.CheckingDebit
+	entry.SetRDFI("231380104")
+	entry.DFIAccountNumber = "987654321"
+	entry.Amount = 2500
+	entry.IndividualName = "Jane Doe"
+	entry.SetTraceNumber("12104288", 2)
+	return entry
+}
+
+func TestEntries__CRUD(t *testing.T) {
+	handler, svc, fileID, batchID := setupEntriesTest(t)
+	path := "/files/" + fileID + "/batches/" + batchID + "/entries"
+
+	// list entries
+	w := serveJSON(t, handler, "GET", path, nil)
+	if w.Code != http.StatusOK || w.Header().Get("X-Total-Count") != "1" {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	entries, _ := svc.GetEntries(fileID, batchID)
+	original := entries[0]
+
+	// read one entry
+	w = serveJSON(t, handler, "GET", path+"/"+original.ID, nil)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), original.DFIAccountNumber) {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// add an entry and check the batch control
+	w = serveJSON(t, handler, "POST", path, mockPPDDebitEntry())
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"debit"`) {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	batch, _ := svc.GetBatch(fileID, batchID)
+	if n := batch.GetControl().EntryAddendaCount; n != 2 {
+		t.Errorf("EntryAddendaCount=%d", n)
+	}
+	if amt := batch.GetControl().TotalDebitEntryDollarAmount; amt != original.Amount+2500 {
+		t.Errorf("TotalDebitEntryDollarAmount=%d", amt)
+	}
+
+	// fix the account number of the first entry
+	update := *original
+	update.DFIAccountNumber = "11112222"
+	update.Amount = 500
+	w = serveJSON(t, handler, "PUT", path+"/"+original.ID, &update)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	entry, err := svc.GetEntry(fileID, batchID, original.ID)
+	if err != nil || strings.TrimSpace(entry.DFIAccountNumber) != "11112222" {
+		t.Fatalf("entry=%#v err=%v", entry, err)
+	}
+	batch, _ = svc.GetBatch(fileID, batchID)
+	if amt := batch.GetControl().TotalDebitEntryDollarAmount; amt != 3000 {
+		t.Errorf("TotalDebitEntryDollarAmount=%d", amt)
+	}
+
+	// remove the new entry
+	w = serveJSON(t, handler, "DELETE", path+"/debit", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	batch, _ = svc.GetBatch(fileID, batchID)
+	if n := len(batch.GetEntries()); n != 1 {
+		t.Errorf("got %d entries", n)
+	}
+	if amt := batch.GetControl().TotalDebitEntryDollarAmount; amt != 500 {
+		t.Errorf("TotalDebitEntryDollarAmount=%d", amt)
+	}
+
+	// the batch is valid and the file can be written
+	if err := batch.Validate(); err != nil {
+		t.Errorf("invalid batch: %v", err)
+	}
+	if _, err := svc.GetFileContents(fileID); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEntries__errors(t *testing.T) {
+	handler, svc, fileID, batchID := setupEntriesTest(t)
+	path := "/files/" + fileID + "/batches/" + batchID + "/entries"
+	entries, _ := svc.GetEntries(fileID, batchID)
+
+	// validation errors name the entry
+	invalid := mockPPDDebitEntry()
+	invalid.CheckDigit = "0"
+	w := serveJSON(t, handler, "POST", path, invalid)
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "debit") || !strings.Contains(w.Body.String(), "RDFIIdentification") {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// credits aren't allowed in a debit batch
+	credit := mockPPDDebitEntry()
+	credit.TransactionCode = ach.CheckingCredit
+	if w := serveJSON(t, handler, "POST", path, credit); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// duplicate IDs
+	dup := mockPPDDebitEntry()
+	dup.ID = entries[0].ID
+	if w := serveJSON(t, handler, "POST", path, dup); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	if w := serveJSON(t, handler, "GET", path+"/missing", nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveJSON(t, handler, "PUT", path+"/missing", mockPPDDebitEntry()); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveJSON(t, handler, "GET", "/files/"+fileID+"/batches/missing/entries", nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// the last entry can't be removed
+	if w := serveJSON(t, handler, "DELETE", path+"/"+entries[0].ID, nil); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// invalid JSON
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", path, strings.NewReader("{")))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// failed changes leave the batch as it was
+	batch, _ := svc.GetBatch(fileID, batchID)
+	if n := len(batch.GetEntries()); n != 1 {
+		t.Errorf("got %d entries", n)
+	}
+}
+
+func TestEntries__addenda(t *testing.T) {
+	handler, svc, fileID, batchID := setupEntriesTest(t)
+	entries, _ := svc.GetEntries(fileID, batchID)
+	entryID := entries[0].ID
+	path := "/files/" + fileID + "/batches/" + batchID + "/entries/" + entryID + "/addenda"
+
+	addenda05 := ach.NewAddenda05()
+	addenda05.ID = "memo"
+	addenda05.PaymentRelatedInformation = "invoice 123"
+	w := serveJSON(t, handler, "POST", path, addenda05)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	entry, _ := svc.GetEntry(fileID, batchID, entryID)
+	if entry.AddendaRecordIndicator != 1 || len(entry.Addenda05) != 1 {
+		t.Fatalf("entry=%#v", entry)
+	}
+	batch, _ := svc.GetBatch(fileID, batchID)
+	if n := batch.GetControl().EntryAddendaCount; n != 2 {
+		t.Errorf("EntryAddendaCount=%d", n)
+	}
+
+	w = serveJSON(t, handler, "GET", path, nil)
+	if w.Code != http.StatusOK || w.Header().Get("X-Total-Count") != "1" {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	addenda05.PaymentRelatedInformation = "invoice 456"
+	if w := serveJSON(t, handler, "PUT", path+"/memo", addenda05); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	w = serveJSON(t, handler, "GET", path+"/memo", nil)
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "invoice 456") {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// unknown addenda types
+	if w := serveJSON(t, handler, "POST", path, map[string]string{"typeCode": "10"}); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveJSON(t, handler, "DELETE", path+"/missing", nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	if w := serveJSON(t, handler, "DELETE", path+"/memo", nil); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	entry, _ = svc.GetEntry(fileID, batchID, entryID)
+	if entry.AddendaRecordIndicator != 0 || len(entry.Addenda05) != 0 {
+		t.Errorf("entry=%#v", entry)
+	}
+}
+
+func TestEntries__addAddenda(t *testing.T) {
+	entry := mockPPDDebitEntry()
+
+	a99 := ach.NewAddenda99()
+	a99.ID = "return"
+	if err := addAddenda(entry, a99); err != nil {
+		t.Fatal(err)
+	}
+	if entry.Category != ach.CategoryReturn || entry.AddendaRecordIndicator != 1 {
+		t.Errorf("Category=%q AddendaRecordIndicator=%d", entry.Category, entry.AddendaRecordIndicator)
+	}
+	if err := addAddenda(entry, ach.NewAddenda99()); err == nil {
+		t.Error("expected error")
+	}
+	if !removeAddenda(entry, "return") || entry.Category != ach.CategoryForward || entry.AddendaRecordIndicator != 0 {
+		t.Errorf("Category=%q AddendaRecordIndicator=%d", entry.Category, entry.AddendaRecordIndicator)
+	}
+	if removeAddenda(entry, "return") {
+		t.Error("removed twice")
+	}
+}
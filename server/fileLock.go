@@ -0,0 +1,51 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !windows
+// +build !windows
+
+package server
+
+import (
+	"os"
+	"syscall"
+)
+
+// fileLock is an advisory lock (flock) shared between processes
+type fileLock struct {
+	fd *os.File
+}
+
+func openFileLock(path string) (*fileLock, error) {
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLock{fd: fd}, nil
+}
+
+func (l *fileLock) rLock() error {
+	return syscall.Flock(int(l.fd.Fd()), syscall.LOCK_SH)
+}
+
+func (l *fileLock) wLock() error {
+	return syscall.Flock(int(l.fd.Fd()), syscall.LOCK_EX)
+}
+
+func (l *fileLock) unlock() error {
+	return syscall.Flock(int(l.fd.Fd()), syscall.LOCK_UN)
+}
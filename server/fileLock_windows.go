@@ -0,0 +1,40 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"os"
+)
+
+// fileLock only checks the lock file can be opened on Windows, so access is only
+// serialized within a process.
+type fileLock struct {
+	fd *os.File
+}
+
+func openFileLock(path string) (*fileLock, error) {
+	fd, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileLock{fd: fd}, nil
+}
+
+func (l *fileLock) rLock() error  { return nil }
+func (l *fileLock) wLock() error  { return nil }
+func (l *fileLock) unlock() error { return nil }
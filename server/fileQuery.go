@@ -0,0 +1,256 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/moov-io/ach"
+)
+
+var (
+	errInvalidQuery = errors.New("invalid query")
+)
+
+// Fields files can be sorted by in a FileQuery
+const (
+	SortByCreationDate         = "creationDate"
+	SortByImmediateOrigin      = "immediateOrigin"
+	SortByImmediateDestination = "immediateDestination"
+	SortByID                   = "id"
+)
+
+// FileQuery filters, sorts and pages through the files in a Repository. Empty fields match every file.
+type FileQuery struct {
+	ImmediateOrigin      string
+	ImmediateDestination string
+
+	// CreatedAfter and CreatedBefore bound the FileHeader's FileCreationDate (inclusive)
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// StandardEntryClassCode matches files with a batch of the SEC code (e.g. PPD or IAT)
+	StandardEntryClassCode string
+
+	// Category matches files with an entry of the category (Forward, Return or NOC)
+	Category string
+
+	// SortBy is one of the SortBy constants and defaults to SortByCreationDate.
+	// Files with equal values are ordered by their ID.
+	SortBy     string
+	Descending bool
+
+	// Cursor is returned from the previous page and Limit caps how many files are returned,
+	// zero returns every file.
+	Cursor string
+	Limit  int
+
+	// idPrefix matches files whose ID starts with it, see tenantRepository
+	idPrefix string
+}
+
+func (q FileQuery) validate() error {
+	switch q.SortBy {
+	case "", SortByCreationDate, SortByImmediateOrigin, SortByImmediateDestination, SortByID:
+	default:
+		return fmt.Errorf("%v: unknown sort %q", errInvalidQuery, q.SortBy)
+	}
+	if q.Limit < 0 {
+		return fmt.Errorf("%v: negative limit", errInvalidQuery)
+	}
+	if _, err := decodeCursor(q.Cursor); err != nil {
+		return err
+	}
+	return nil
+}
+
+// createdRange returns the YYMMDD bounds compared against FileCreationDate, empty when unset
+func (q FileQuery) createdRange() (after string, before string) {
+	if !q.CreatedAfter.IsZero() {
+		after = q.CreatedAfter.Format("060102")
+	}
+	if !q.CreatedBefore.IsZero() {
+		before = q.CreatedBefore.Format("060102")
+	}
+	return after, before
+}
+
+// matches returns true if the file is selected by every filter of the query
+func (q FileQuery) matches(f *ach.File) bool {
+	if q.idPrefix != "" && !strings.HasPrefix(f.ID, q.idPrefix) {
+		return false
+	}
+	if q.ImmediateOrigin != "" && strings.TrimSpace(f.Header.ImmediateOrigin) != strings.TrimSpace(q.ImmediateOrigin) {
+		return false
+	}
+	if q.ImmediateDestination != "" && strings.TrimSpace(f.Header.ImmediateDestination) != strings.TrimSpace(q.ImmediateDestination) {
+		return false
+	}
+	after, before := q.createdRange()
+	if after != "" && f.Header.FileCreationDate < after {
+		return false
+	}
+	if before != "" && f.Header.FileCreationDate > before {
+		return false
+	}
+	if q.StandardEntryClassCode != "" && !hasSECCode(f, q.StandardEntryClassCode) {
+		return false
+	}
+	if q.Category != "" && !hasCategory(f, q.Category) {
+		return false
+	}
+	return true
+}
+
+func hasSECCode(f *ach.File, code string) bool {
+	for _, b := range f.Batches {
+		if strings.EqualFold(b.GetHeader().StandardEntryClassCode, code) {
+			return true
+		}
+	}
+	for _, b := range f.IATBatches {
+		if b.Header != nil && strings.EqualFold(b.Header.StandardEntryClassCode, code) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasCategory(f *ach.File, category string) bool {
+	// entries read from NACHA files leave Category empty unless they're a return or NOC
+	matches := func(c string) bool {
+		if c == "" {
+			c = ach.CategoryForward
+		}
+		return strings.EqualFold(c, category)
+	}
+	for _, b := range f.Batches {
+		for _, e := range b.GetEntries() {
+			if matches(e.Category) {
+				return true
+			}
+		}
+		for _, e := range b.GetADVEntries() {
+			if matches(e.Category) {
+				return true
+			}
+		}
+	}
+	for _, b := range f.IATBatches {
+		for _, e := range b.Entries {
+			if matches(e.Category) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// less orders files by the query's sort field, falling back to their ID
+func (q FileQuery) less(a, b *ach.File) bool {
+	var x, y string
+	switch q.SortBy {
+	case SortByImmediateOrigin:
+		x, y = a.Header.ImmediateOrigin, b.Header.ImmediateOrigin
+	case SortByImmediateDestination:
+		x, y = a.Header.ImmediateDestination, b.Header.ImmediateDestination
+	case SortByID:
+	default:
+		x, y = a.Header.FileCreationDate+a.Header.FileCreationTime, b.Header.FileCreationDate+b.Header.FileCreationTime
+	}
+	if x == y {
+		x, y = a.ID, b.ID
+	}
+	if q.Descending {
+		return y < x
+	}
+	return x < y
+}
+
+// queryFiles returns the page of files selected by the query. It's used by repositories
+// which don't have their own indexes.
+func queryFiles(files []*ach.File, q FileQuery) ([]*ach.File, string, error) {
+	if err := q.validate(); err != nil {
+		return nil, "", err
+	}
+	out := make([]*ach.File, 0, len(files))
+	for i := range files {
+		if q.matches(files[i]) {
+			out = append(out, files[i])
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return q.less(out[i], out[j])
+	})
+
+	offset, _ := decodeCursor(q.Cursor)
+	if offset > len(out) {
+		offset = len(out)
+	}
+	out = out[offset:]
+	if q.Limit > 0 && len(out) > q.Limit {
+		return out[:q.Limit], encodeCursor(offset + q.Limit), nil
+	}
+	return out, "", nil
+}
+
+// Cursors are opaque to callers, but are the offset of the next page
+func encodeCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, fmt.Errorf("%v: invalid cursor", errInvalidQuery)
+	}
+	n, err := strconv.Atoi(string(bs))
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%v: invalid cursor", errInvalidQuery)
+	}
+	return n, nil
+}
+
+// withoutEntries returns a copy of the file with headers and controls, but no entries
+func withoutEntries(f *ach.File) *ach.File {
+	out := *f
+	out.Batches = make([]ach.Batcher, 0, len(f.Batches))
+	for _, b := range f.Batches {
+		var batch ach.Batch
+		batch.SetID(b.ID())
+		batch.SetHeader(b.GetHeader())
+		batch.SetControl(b.GetControl())
+		batch.SetADVControl(b.GetADVControl())
+		out.Batches = append(out.Batches, ach.ConvertBatchType(batch))
+	}
+	out.IATBatches = make([]ach.IATBatch, len(f.IATBatches))
+	for i := range f.IATBatches {
+		out.IATBatches[i] = f.IATBatches[i]
+		out.IATBatches[i].Entries = nil
+	}
+	return &out
+}
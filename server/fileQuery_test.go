@@ -0,0 +1,76 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"testing"
+)
+
+func TestFileQuery__cursor(t *testing.T) {
+	for _, offset := range []int{0, 1, 250} {
+		n, err := decodeCursor(encodeCursor(offset))
+		if err != nil || n != offset {
+			t.Errorf("offset %d: got %d, err=%v", offset, n, err)
+		}
+	}
+	for _, cursor := range []string{"!", encodeCursor(-1), "Zm9v"} {
+		if _, err := decodeCursor(cursor); err == nil {
+			t.Errorf("expected error for %q", cursor)
+		}
+	}
+}
+
+func TestFileQuery__validate(t *testing.T) {
+	if err := (FileQuery{SortBy: SortByImmediateOrigin, Limit: 10}).validate(); err != nil {
+		t.Error(err)
+	}
+	if err := (FileQuery{Limit: -1}).validate(); err == nil {
+		t.Error("expected error")
+	}
+	if err := (FileQuery{SortBy: "other"}).validate(); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestFileQuery__withoutEntries(t *testing.T) {
+	file := readTestFile(t, "ppd-debit.ach")
+	iat := readTestFile(t, "iat-debit.ach")
+	file.IATBatches = iat.IATBatches
+
+	out := withoutEntries(file)
+	if len(out.Batches) != len(file.Batches) || len(out.IATBatches) != len(file.IATBatches) {
+		t.Fatalf("batches=%d iatBatches=%d", len(out.Batches), len(out.IATBatches))
+	}
+	if out.Batches[0].ID() != file.Batches[0].ID() {
+		t.Errorf("batch ID %q", out.Batches[0].ID())
+	}
+	if n := len(out.Batches[0].GetEntries()); n != 0 {
+		t.Errorf("got %d entries", n)
+	}
+	if n := len(out.IATBatches[0].Entries); n != 0 {
+		t.Errorf("got %d IAT entries", n)
+	}
+	if out.Batches[0].GetHeader().CompanyName != file.Batches[0].GetHeader().CompanyName {
+		t.Errorf("CompanyName=%q", out.Batches[0].GetHeader().CompanyName)
+	}
+
+	// the original file is unchanged
+	if len(file.Batches[0].GetEntries()) == 0 || len(file.IATBatches[0].Entries) == 0 {
+		t.Error("entries removed from original file")
+	}
+}
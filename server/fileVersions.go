@@ -0,0 +1,134 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash/fnv"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/ach"
+
+	"github.com/gorilla/mux"
+)
+
+var (
+	errPreconditionFailed = errors.New("file has changed since If-Match ETag")
+)
+
+// fileETag returns the version of a file, which changes with any of its records
+func fileETag(f *ach.File) string {
+	bs, err := json.Marshal(f)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(bs)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// etagMatches returns true if etag is one of the comma separated values of an If-Match header
+func etagMatches(header string, etag string) bool {
+	for _, v := range strings.Split(header, ",") {
+		if v = strings.TrimSpace(v); v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// fileVersions sets the ETag of the file on responses from routes under /files/{fileID} and rejects
+// changes with '412 Precondition Failed' when their If-Match header isn't the file's current ETag.
+// Changes to the same file are made one at a time, so checking If-Match and the change can't race.
+//
+// The locks are held in memory, so they only order the changes made by this process. Servers sharing
+// an on-disk or SQL repository can each accept a change with the same If-Match, and the last one wins.
+type fileVersions struct {
+	repo  Repository
+	locks *[64]sync.Mutex
+}
+
+func (v fileVersions) lock(key string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return &v.locks[h.Sum32()%uint32(len(v.locks))]
+}
+
+func (v fileVersions) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		fileID := vars["fileID"]
+		if fileID == "" {
+			fileID = vars["id"]
+		}
+		if fileID == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if r.Method != "GET" {
+			mu := v.lock(tenantOf(r.Context()) + " " + fileID)
+			mu.Lock()
+			defer mu.Unlock()
+
+			if match := r.Header.Get("If-Match"); match != "" {
+				f, err := v.repo.FindFile(fileID)
+				if err != nil || !etagMatches(match, fileETag(f)) {
+					encodeError(r.Context(), errPreconditionFailed, w)
+					return
+				}
+			}
+		}
+		next.ServeHTTP(&etagWriter{ResponseWriter: w, repo: v.repo, fileID: fileID}, r)
+	})
+}
+
+// etagWriter sets the ETag header of successful responses to the file's version after the route changed it
+type etagWriter struct {
+	http.ResponseWriter
+
+	repo        Repository
+	fileID      string
+	wroteHeader bool
+}
+
+func (w *etagWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	if code < 300 {
+		if f, err := w.repo.FindFile(w.fileID); err == nil {
+			if etag := fileETag(f); etag != "" {
+				w.Header().Set("ETag", etag)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *etagWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(p)
+}
@@ -0,0 +1,98 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func serveIfMatch(t *testing.T, handler http.Handler, method, path string, body interface{}, etag string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req := httptest.NewRequest(method, path, &buf)
+	req.Header.Set("If-Match", etag)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+	return w
+}
+
+func TestFileVersions(t *testing.T) {
+	handler, _, fileID, batchID := setupEntriesTest(t)
+
+	w := serveJSON(t, handler, "GET", "/files/"+fileID, nil)
+	etag := w.Header().Get("ETag")
+	if w.Code != http.StatusOK || etag == "" {
+		t.Fatalf("bogus HTTP status: %d ETag=%q", w.Code, etag)
+	}
+	if w := serveJSON(t, handler, "GET", "/files/"+fileID+"/batches/"+batchID, nil); w.Header().Get("ETag") != etag {
+		t.Errorf("batch ETag=%q", w.Header().Get("ETag"))
+	}
+
+	// a change with the current ETag returns the file's new ETag
+	entries := "/files/" + fileID + "/batches/" + batchID + "/entries"
+	w = serveIfMatch(t, handler, "POST", entries, mockPPDDebitEntry(), etag)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	changed := w.Header().Get("ETag")
+	if changed == "" || changed == etag {
+		t.Fatalf("ETag=%q after change", changed)
+	}
+
+	// changes based on the previous version are rejected
+	w = serveIfMatch(t, handler, "DELETE", "/files/"+fileID+"/batches/"+batchID, nil, etag)
+	if w.Code != http.StatusPreconditionFailed {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveJSON(t, handler, "GET", "/files/"+fileID+"/batches/"+batchID, nil); w.Code != http.StatusOK {
+		t.Errorf("batch was deleted: %d", w.Code)
+	}
+	if w := serveIfMatch(t, handler, "DELETE", "/files/missing", nil, "*"); w.Code != http.StatusPreconditionFailed {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveIfMatch(t, handler, "DELETE", "/files/"+fileID+"/batches/"+batchID, nil, `"other", `+changed); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestFileVersions__etagMatches(t *testing.T) {
+	cases := map[string]bool{
+		`"abc"`:      true,
+		`*`:          true,
+		`"x", "abc"`: true,
+		`"abcd"`:     false,
+		`W/"abc"`:    false,
+		`"x","y"`:    false,
+	}
+	for header, want := range cases {
+		if got := etagMatches(header, `"abc"`); got != want {
+			t.Errorf("If-Match: %s: got %v", header, got)
+		}
+	}
+}
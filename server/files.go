@@ -21,20 +21,27 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/csvimport"
+	"github.com/moov-io/ach/dedupe"
+	"github.com/moov-io/ach/pgp"
 	"github.com/moov-io/base"
 	moovhttp "github.com/moov-io/base/http"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/metrics/prometheus"
+	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
 )
@@ -64,13 +71,15 @@ type createFileResponse struct {
 
 func (r createFileResponse) error() error { return r.Err }
 
-func createFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+func createFileEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(createFileRequest)
 		if !ok {
 			return createFileResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
 
+		s := forRequest(ctx, s)
+
 		// record a metric for files created
 		if req.File != nil && req.File.Header.ImmediateDestination != "" && req.File.Header.ImmediateOrigin != "" {
 			filesCreated.With("destination", req.File.Header.ImmediateDestination, "origin", req.File.Header.ImmediateOrigin).Add(1)
@@ -80,8 +89,13 @@ func createFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.End
 		if req.File.ID == "" {
 			req.File.ID = base.ID()
 		}
+		setMissingIDs(req.File)
 
-		err := r.StoreFile(req.File)
+		// Only files which parsed are checked for duplicates (see RejectDuplicateFiles)
+		if req.parseError != nil {
+			skipDuplicateCheck(s, req.File)
+		}
+		err := s.StoreFile(req.File)
 		if logger != nil {
 			logger.Log("files", "createFile", "requestID", req.requestID, "error", err)
 		}
@@ -90,7 +104,7 @@ func createFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.End
 			ID:  req.File.ID,
 			Err: err,
 		}
-		if req.parseError != nil {
+		if _, ok := err.(*dedupe.DuplicateError); !ok && req.parseError != nil {
 			resp.Err = req.parseError
 		}
 
@@ -98,43 +112,108 @@ func createFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.End
 	}
 }
 
-func decodeCreateFileRequest(_ context.Context, request *http.Request) (interface{}, error) {
-	var r io.Reader
-	req := createFileRequest{
-		File:      ach.NewFile(),
-		requestID: moovhttp.GetRequestID(request),
+// decodeCreateFileRequest reads an ACH file from NACHA formatted text, JSON (checked against
+// ach.JSONSchema when strict), friendly JSON or CSV (with mapping or csvimport.DefaultMapping)
+// depending on the Content-Type header. OpenPGP messages are decrypted with keys and read
+// as NACHA formatted text.
+func decodeCreateFileRequest(mapping *csvimport.Mapping, strict bool, keys *pgp.Config) httptransport.DecodeRequestFunc {
+	if mapping == nil {
+		mapping = csvimport.DefaultMapping()
 	}
+	return func(_ context.Context, request *http.Request) (interface{}, error) {
+		req := createFileRequest{
+			File:      ach.NewFile(),
+			requestID: moovhttp.GetRequestID(request),
+		}
 
-	bs, err := ioutil.ReadAll(request.Body)
-	if err != nil {
-		return nil, err
-	}
+		bs, err := ioutil.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
 
-	h := strings.ToLower(request.Header.Get("Content-Type"))
-	if strings.Contains(h, "application/json") {
-		// Read body as ACH file in JSON
-		f, err := ach.FileFromJSON(bs)
+		h := strings.ToLower(request.Header.Get("Content-Type"))
+		if strings.Contains(h, pgpContentType) || pgp.IsEncrypted(bs) {
+			if bs, err = decryptFile(keys, bs); err != nil {
+				return nil, err
+			}
+			h = "text/plain"
+		}
+
+		f, err := parseFile(bs, h, mapping, strict, nil)
 		if f != nil {
 			req.File = f
 		}
-		req.parseError = err
-	} else {
+		if err != nil {
+			req.parseError = wrapParseError(h, strict, err)
+		}
+		return req, nil
+	}
+}
+
+// parseFile reads an ACH file from bs in the format of contentType: NACHA (the default), JSON,
+// friendly JSON or CSV (with mapping). A partially read file may be returned along with an error.
+// opts are used when reading NACHA files.
+func parseFile(bs []byte, contentType string, mapping *csvimport.Mapping, strict bool, opts *ach.ValidateOpts) (*ach.File, error) {
+	switch {
+	case friendlyJSON(contentType):
+		// Read body as ACH file in JSON from (*ach.File).MarshalFriendlyJSON
+		return ach.FileFromFriendlyJSON(bs)
+
+	case strings.Contains(contentType, "application/json"):
+		// Read body as ACH file in JSON
+		if strict {
+			return ach.FileFromJSONStrict(bs)
+		}
+		return ach.FileFromJSON(bs)
+
+	case strings.Contains(contentType, "text/csv"):
+		// Create an ACH file from rows of the CSV
+		files, err := csvimport.Import(bytes.NewReader(bs), mapping)
+		var f *ach.File
+		if len(files) > 0 {
+			f = files[0]
+		}
+		if err == nil && len(files) != 1 {
+			err = fmt.Errorf("found %d files, only one file can be created per request", len(files))
+		}
+		return f, err
+
+	default:
 		// Attempt parsing body as an ACH File
-		r = bytes.NewReader(bs)
-		f, err := ach.NewReader(r).Read()
-		req.File = &f
-		req.parseError = err
+		r := ach.NewReader(bytes.NewReader(bs))
+		r.SetValidation(opts)
+		f, err := r.Read()
+		return &f, err
 	}
-	return req, nil
+}
+
+// wrapParseError prefixes errors from parseFile which don't otherwise respond with '400 Bad Request'
+func wrapParseError(contentType string, strict bool, err error) error {
+	switch {
+	case friendlyJSON(contentType), strict && strings.Contains(contentType, "application/json"):
+		return fmt.Errorf("%v: %v", errInvalidFile, err)
+	case strings.Contains(contentType, "text/csv"):
+		return fmt.Errorf("%v: %v", errInvalidCSV, err)
+	}
+	return err
 }
 
 type getFilesRequest struct {
+	query FileQuery
+
+	// entries set to false omits entries from the files returned
+	entries bool
+
+	// friendly responds with files encoded by (*ach.File).MarshalFriendlyJSON
+	friendly bool
+
 	requestID string
 }
 
 type getFilesResponse struct {
-	Files []*ach.File `json:"files"`
-	Err   error       `json:"error"`
+	Files      []*ach.File `json:"files"`
+	NextCursor string      `json:"nextCursor,omitempty"`
+	Err        error       `json:"error"`
 }
 
 func (r getFilesResponse) count() int { return len(r.Files) }
@@ -142,23 +221,94 @@ func (r getFilesResponse) count() int { return len(r.Files) }
 func (r getFilesResponse) error() error { return r.Err }
 
 func getFilesEndpoint(s Service) endpoint.Endpoint {
-	return func(_ context.Context, _ interface{}) (interface{}, error) {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getFilesRequest)
+		if !ok {
+			req = getFilesRequest{entries: true} // every file
+		}
+
+		files, cursor, err := s.FindFiles(req.query)
+		if err != nil {
+			return getFilesResponse{Err: err}, nil
+		}
+		if !req.entries {
+			for i := range files {
+				files[i] = withoutEntries(files[i])
+			}
+		}
+		if req.friendly {
+			return getFriendlyFilesResponse{
+				Files:      friendlyFiles(files),
+				NextCursor: cursor,
+			}, nil
+		}
 		return getFilesResponse{
-			Files: s.GetFiles(),
-			Err:   nil,
+			Files:      files,
+			NextCursor: cursor,
+			Err:        nil,
 		}, nil
 	}
 }
 
 func decodeGetFilesRequest(_ context.Context, r *http.Request) (interface{}, error) {
-	return getFilesRequest{
+	req := getFilesRequest{
+		entries:   true,
+		friendly:  friendlyJSON(r.Header.Get("Accept")),
 		requestID: moovhttp.GetRequestID(r),
-	}, nil
+	}
+
+	q := r.URL.Query()
+	req.query = FileQuery{
+		ImmediateOrigin:        q.Get("immediateOrigin"),
+		ImmediateDestination:   q.Get("immediateDestination"),
+		StandardEntryClassCode: q.Get("secCode"),
+		Category:               q.Get("category"),
+		SortBy:                 q.Get("sort"),
+		Cursor:                 q.Get("cursor"),
+	}
+	var err error
+	if v := q.Get("createdAfter"); v != "" {
+		if req.query.CreatedAfter, err = time.Parse("2006-01-02", v); err != nil {
+			return nil, fmt.Errorf("%v: createdAfter: %v", errInvalidQuery, err)
+		}
+	}
+	if v := q.Get("createdBefore"); v != "" {
+		if req.query.CreatedBefore, err = time.Parse("2006-01-02", v); err != nil {
+			return nil, fmt.Errorf("%v: createdBefore: %v", errInvalidQuery, err)
+		}
+	}
+	switch v := strings.ToLower(q.Get("order")); v {
+	case "", "asc":
+	case "desc":
+		req.query.Descending = true
+	default:
+		return nil, fmt.Errorf("%v: unknown order %q", errInvalidQuery, v)
+	}
+	if v := q.Get("limit"); v != "" {
+		if req.query.Limit, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("%v: limit: %v", errInvalidQuery, err)
+		}
+	}
+	if v := q.Get("entries"); v != "" {
+		if req.entries, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("%v: entries: %v", errInvalidQuery, err)
+		}
+	}
+	if err := req.query.validate(); err != nil {
+		return nil, err
+	}
+	return req, nil
 }
 
 type getFileRequest struct {
 	ID string
 
+	// mask redacts sensitive fields with ach.DefaultMaskingPolicy
+	mask bool
+
+	// friendly responds with the file encoded by (*ach.File).MarshalFriendlyJSON
+	friendly bool
+
 	requestID string
 }
 
@@ -177,11 +327,20 @@ func getFileEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
 		}
 
 		f, err := s.GetFile(req.ID)
+		if f != nil && req.mask {
+			f = f.Mask(ach.DefaultMaskingPolicy())
+		}
 
 		if logger != nil {
 			logger.Log("files", "getFile", "requestID", req.requestID, "error", err)
 		}
 
+		if req.friendly {
+			return getFriendlyFileResponse{
+				File: (*friendlyFile)(f),
+				Err:  err,
+			}, nil
+		}
 		return getFileResponse{
 			File: f,
 			Err:  err,
@@ -195,10 +354,19 @@ func decodeGetFileRequest(_ context.Context, r *http.Request) (interface{}, erro
 	if !ok {
 		return nil, ErrBadRouting
 	}
-	return getFileRequest{
+	req := getFileRequest{
 		ID:        id,
+		friendly:  friendlyJSON(r.Header.Get("Accept")),
 		requestID: moovhttp.GetRequestID(r),
-	}, nil
+	}
+	if v := r.URL.Query().Get("mask"); v != "" {
+		mask, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%v: invalid mask parameter: %v", errInvalidQuery, err)
+		}
+		req.mask = mask
+	}
+	return req, nil
 }
 
 type deleteFileRequest struct {
@@ -214,12 +382,14 @@ type deleteFileResponse struct {
 func (r deleteFileResponse) error() error { return r.Err }
 
 func deleteFileEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(deleteFileRequest)
 		if !ok {
 			return deleteFileResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
 
+		s := forRequest(ctx, s)
+
 		filesDeleted.Add(1)
 
 		err := s.DeleteFile(req.ID)
@@ -247,7 +417,8 @@ func decodeDeleteFileRequest(_ context.Context, r *http.Request) (interface{}, e
 }
 
 type getFileContentsRequest struct {
-	ID string
+	ID     string
+	format string
 
 	requestID string
 }
@@ -258,14 +429,25 @@ type getFileContentsResponse struct {
 
 func (v getFileContentsResponse) error() error { return v.Err }
 
-func getFileContentsEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+func getFileContentsEndpoint(s Service, keys *pgp.Config, logger log.Logger) endpoint.Endpoint {
 	return func(_ context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(getFileContentsRequest)
 		if !ok {
 			return getFileContentsResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
 
-		r, err := s.GetFileContents(req.ID)
+		var r io.Reader
+		var err error
+		switch req.format {
+		case "", "ach":
+			r, err = s.GetFileContents(req.ID)
+		case "xml":
+			r, err = getFileContentsXML(s, req.ID)
+		case "pgp":
+			r, err = getFileContentsPGP(s, keys, req.ID)
+		default:
+			err = fmt.Errorf("%v: unknown format %s", errInvalidFile, req.format)
+		}
 
 		if logger != nil {
 			logger.Log("files", "getFileContents", "requestID", req.requestID, "error", err)
@@ -286,10 +468,31 @@ func decodeGetFileContentsRequest(_ context.Context, r *http.Request) (interface
 	}
 	return getFileContentsRequest{
 		ID:        id,
+		format:    strings.ToLower(r.URL.Query().Get("format")),
 		requestID: moovhttp.GetRequestID(r),
 	}, nil
 }
 
+// getFileContentsXML writes a file as XML, see ach.File.MarshalXML
+func getFileContentsXML(s Service, id string) (io.Reader, error) {
+	f, err := s.GetFile(id)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading file %s: %v", id, err)
+	}
+	if err := f.Create(); err != nil {
+		return nil, fmt.Errorf("problem creating file %s: %v", id, err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return nil, fmt.Errorf("problem writing XML file %s: %v", id, err)
+	}
+	return fileContents{Reader: &buf, contentType: "application/xml"}, nil
+}
+
 type validateFileRequest struct {
 	ID        string
 	requestID string
@@ -304,12 +507,14 @@ type validateFileResponse struct {
 func (v validateFileResponse) error() error { return v.Err }
 
 func validateFileEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(validateFileRequest)
 		if !ok {
 			return validateFileResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
 
+		s := forRequest(ctx, s)
+
 		err := s.ValidateFile(req.ID, req.opts)
 		if logger != nil {
 			logger.Log("files", "validateFile", "requestID", req.requestID, "error", err)
@@ -353,12 +558,14 @@ type balanceFileResponse struct {
 }
 
 func balanceFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(balanceFileRequest)
 		if !ok {
 			return balanceFileResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
 
+		s := forRequest(ctx, s)
+
 		balancedFile, err := s.BalanceFile(req.fileID, req.offset)
 		if balancedFile != nil && logger != nil {
 			logger.Log("files", fmt.Sprintf("balance file created %s", balancedFile.ID), "requestID", req.requestID, "error", err)
@@ -409,13 +616,15 @@ type segmentFileResponse struct {
 	Err          error  `json:"error"`
 }
 
-func segmentFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+func segmentFileEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(segmentFileRequest)
 		if !ok {
 			return segmentFileResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
 
+		s := forRequest(ctx, s)
+
 		creditFile, debitFile, err := s.SegmentFile(req.fileID, req.opts)
 
 		if logger != nil {
@@ -426,14 +635,14 @@ func segmentFileEndpoint(s Service, r Repository, logger log.Logger) endpoint.En
 		}
 
 		if creditFile.ID != "" {
-			err = r.StoreFile(creditFile)
+			err = s.StoreFile(creditFile)
 			if logger != nil {
 				logger.Log("files", "storeCreditFile", "requestID", req.requestID, "error", err)
 			}
 		}
 
 		if debitFile.ID != "" {
-			err = r.StoreFile(debitFile)
+			err = s.StoreFile(debitFile)
 			if logger != nil {
 				logger.Log("files", "storeDebitFile", "requestID", req.requestID, "error", err)
 			}
@@ -476,12 +685,15 @@ type flattenBatchesResponse struct {
 	Err error  `json:"error"`
 }
 
-func flattenBatchesEndpoint(s Service, r Repository, logger log.Logger) endpoint.Endpoint {
-	return func(_ context.Context, request interface{}) (interface{}, error) {
+func flattenBatchesEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(flattenBatchesRequest)
 		if !ok {
 			return flattenBatchesResponse{Err: ErrFoundABug}, ErrFoundABug
 		}
+
+		s := forRequest(ctx, s)
+
 		flattenFile, err := s.FlattenBatches(req.fileID)
 		if logger != nil {
 			logger.Log("files", "FlattenBatches", "requestID", req.requestID, "error", err)
@@ -490,7 +702,7 @@ func flattenBatchesEndpoint(s Service, r Repository, logger log.Logger) endpoint
 			return flattenBatchesResponse{Err: err}, err
 		}
 		if flattenFile.ID != "" {
-			err = r.StoreFile(flattenFile)
+			err = s.StoreFile(flattenFile)
 			if logger != nil {
 				logger.Log("files", "storeFlattenFile", "requestID", req.requestID, "error", err)
 			}
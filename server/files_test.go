@@ -21,7 +21,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
@@ -29,8 +31,11 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/csvimport"
+	"github.com/moov-io/ach/dedupe"
 	"github.com/moov-io/base"
 
 	"github.com/go-kit/kit/log"
@@ -84,7 +89,7 @@ func TestFiles__createFileEndpoint(t *testing.T) {
 
 	body := strings.NewReader(`{"random":"json"}`)
 
-	resp, err := createFileEndpoint(svc, repo, nil)(context.TODO(), body)
+	resp, err := createFileEndpoint(svc, nil)(context.TODO(), body)
 	r, ok := resp.(createFileResponse)
 	if !ok {
 		t.Errorf("got %#v", resp)
@@ -94,6 +99,224 @@ func TestFiles__createFileEndpoint(t *testing.T) {
 	}
 }
 
+func TestFiles__CreateFile__duplicates(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+
+	detector := dedupe.NewDetector(dedupe.NewStoreInMemory(), nil)
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger(), RejectDuplicateFiles(detector))
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	createFile := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+		req.Header.Set("x-request-id", base.ID())
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	if w := createFile(); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// upload the same file again
+	w := createFile()
+	if w.Code != http.StatusConflict {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "duplicate file") {
+		t.Errorf("unexpected error: %s", w.Body.String())
+	}
+	if n := len(repo.FindAllFiles()); n != 1 {
+		t.Errorf("got %d files", n)
+	}
+}
+
+func TestFiles__CreateFile__duplicatesDerived(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	detector := dedupe.NewDetector(dedupe.NewStoreInMemory(), nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), RejectDuplicateFiles(detector))
+
+	// create the file today, as flattened files are
+	bs := readTestdata(t, "ppd-debit.ach")
+	copy(bs[23:29], time.Now().Format("060102"))
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+	w.Flush()
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+
+	// the flattened file shares the FileHeader of the one created, but isn't a duplicate of it
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest("POST", "/files/"+created.ID+"/flatten", nil))
+	w.Flush()
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if n := len(repo.FindAllFiles()); n != 2 {
+		t.Errorf("got %d files", n)
+	}
+}
+
+// failingRepository fails to store files while failures is above zero
+type failingRepository struct {
+	Repository
+	failures int
+}
+
+func (r *failingRepository) StoreFile(f *ach.File) error {
+	if r.failures > 0 {
+		r.failures--
+		return errors.New("disk full")
+	}
+	return r.Repository.StoreFile(f)
+}
+
+func TestFiles__CreateFile__duplicatesRetry(t *testing.T) {
+	repo := &failingRepository{Repository: NewRepositoryInMemory(testTTLDuration, nil), failures: 1}
+	detector := dedupe.NewDetector(dedupe.NewStoreInMemory(), nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), RejectDuplicateFiles(detector))
+
+	bs := readTestdata(t, "ppd-debit.ach")
+	createFile := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs)))
+		w.Flush()
+		return w
+	}
+
+	// the file isn't stored, so retrying it isn't a duplicate
+	if w := createFile(); w.Code == http.StatusOK || w.Code == http.StatusConflict {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if w := createFile(); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if w := createFile(); w.Code != http.StatusConflict {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestFiles__CreateFile__CSV(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+
+	mapping, err := csvimport.ReadMappingFile(filepath.Join("..", "test", "testdata", "payouts-mapping.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger(), ImportCSV(mapping))
+
+	createFile := func(body io.Reader) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/files/create", body)
+		req.Header.Set("Content-Type", "text/csv")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "payouts.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	w := createFile(fd)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	var resp createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	file, err := repo.FindFile(resp.ID)
+	if err != nil || file == nil {
+		t.Fatalf("file=%v error=%v", file, err)
+	}
+	if n := len(file.Batches[0].GetEntries()); n != 3 {
+		t.Errorf("got %d entries", n)
+	}
+
+	// invalid rows
+	w = createFile(strings.NewReader("Employee,Routing,Account,Type,Amount,Memo\nJane Doe,231380105,123,checking,1.00,\n"))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "line:2 record:Routing") {
+		t.Errorf("unexpected error: %s", w.Body.String())
+	}
+}
+
+func TestFiles__CreateFile__CSVDefaultMapping(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	body := strings.Join([]string{
+		"origin,destination,companyName,companyIdentification,companyEntryDescription,routingNumber,accountNumber,amount,name",
+		"121042882,231380104,My Company,121042882,PAYROLL,231380104,123456,10.00,Jane Doe",
+		"231380104,121042882,My Company,121042882,PAYROLL,121042882,123456,10.00,Jane Doe",
+	}, "\n")
+	req := httptest.NewRequest("POST", "/files/create", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "found 2 files") {
+		t.Errorf("unexpected error: %s", w.Body.String())
+	}
+}
+
+func TestFiles__CreateFile__StrictJSON(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), StrictJSON())
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	createFile := func(body []byte) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	if w := createFile(bs); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// misspell the entries of a batch
+	w := createFile(bytes.Replace(bs, []byte(`"entryDetails"`), []byte(`"entryDetail"`), 1))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), "batches[0].entryDetail is an unknown field") {
+		t.Errorf("unexpected error: %s", w.Body.String())
+	}
+}
+
 func TestFiles__getFilesEndpoint(t *testing.T) {
 	repo := NewRepositoryInMemory(testTTLDuration, nil)
 	svc := NewService(repo)
@@ -131,6 +354,72 @@ func TestFiles__getFilesEndpoint(t *testing.T) {
 	}
 }
 
+func TestFiles__getFilesQuery(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger())
+
+	for _, id := range []string{"a", "b", "c"} {
+		f := readTestFile(t, "ppd-debit.ach")
+		f.ID = id
+		if err := repo.StoreFile(f); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	type response struct {
+		Files []struct {
+			ID      string `json:"id"`
+			Batches []struct {
+				Entries []interface{} `json:"entryDetails"`
+			} `json:"batches"`
+		} `json:"files"`
+		NextCursor string `json:"nextCursor"`
+	}
+	get := func(query string) (int, response) {
+		t.Helper()
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest("GET", "/files?"+query, nil))
+		w.Flush()
+
+		var resp response
+		if w.Code == http.StatusOK {
+			if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return w.Code, resp
+	}
+
+	code, resp := get("sort=id&order=desc&limit=2&immediateOrigin=121042882&createdAfter=2019-06-01&secCode=PPD&category=Forward")
+	if code != http.StatusOK || len(resp.Files) != 2 || resp.Files[0].ID != "c" || resp.NextCursor == "" {
+		t.Fatalf("code=%d files=%d cursor=%q", code, len(resp.Files), resp.NextCursor)
+	}
+	if n := len(resp.Files[0].Batches[0].Entries); n == 0 {
+		t.Error("expected entries")
+	}
+	code, resp = get("sort=id&order=desc&limit=2&entries=false&cursor=" + resp.NextCursor)
+	if code != http.StatusOK || len(resp.Files) != 1 || resp.Files[0].ID != "a" || resp.NextCursor != "" {
+		t.Fatalf("code=%d files=%d cursor=%q", code, len(resp.Files), resp.NextCursor)
+	}
+	if n := len(resp.Files[0].Batches); n != 1 {
+		t.Fatalf("got %d batches", n)
+	}
+	if n := len(resp.Files[0].Batches[0].Entries); n != 0 {
+		t.Errorf("got %d entries", n)
+	}
+	if code, resp = get("createdBefore=2019-01-01"); code != http.StatusOK || len(resp.Files) != 0 {
+		t.Errorf("code=%d files=%d", code, len(resp.Files))
+	}
+
+	for _, query := range []string{"sort=amount", "order=up", "limit=ten", "limit=-1", "entries=maybe", "createdAfter=190601", "createdBefore=x", "cursor=!"} {
+		if code, _ := get(query); code != http.StatusBadRequest {
+			t.Errorf("%s: got HTTP %d", query, code)
+		}
+	}
+}
+
 func TestFiles__getFileEndpoint(t *testing.T) {
 	repo := NewRepositoryInMemory(testTTLDuration, nil)
 	svc := NewService(repo)
@@ -171,6 +460,47 @@ func TestFiles__getFileEndpoint(t *testing.T) {
 	}
 }
 
+func TestFiles__getFileEndpoint__mask(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+
+	f := ach.NewFile()
+	f.ID = "foo"
+	f.Header = *mockFileHeader()
+	f.AddBatch(mockBatchWEB())
+	if err := repo.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger())
+
+	req := httptest.NewRequest("GET", "/files/foo?mask=true", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if body := w.Body.String(); strings.Contains(body, "123456789") || strings.Contains(body, "Wade Arnold") {
+		t.Errorf("unmasked response: %s", body)
+	}
+	if !strings.Contains(w.Body.String(), "*******89") {
+		t.Errorf("expected masked account number: %s", w.Body.String())
+	}
+
+	// stored file is untouched
+	if v := f.Batches[0].GetEntries()[0].DFIAccountNumber; v != "123456789" {
+		t.Errorf("DFIAccountNumber=%q", v)
+	}
+
+	req = httptest.NewRequest("GET", "/files/foo?mask=other", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d", w.Code)
+	}
+}
+
 func TestFiles__getFileContentsEndpoint(t *testing.T) {
 	repo := NewRepositoryInMemory(testTTLDuration, nil)
 	svc := NewService(repo)
@@ -203,7 +533,7 @@ func TestFiles__getFileContentsEndpoint(t *testing.T) {
 
 	// sad path
 	body := strings.NewReader(`{"random":"json"}`)
-	resp, err := getFileContentsEndpoint(svc, nil)(context.TODO(), body)
+	resp, err := getFileContentsEndpoint(svc, nil, nil)(context.TODO(), body)
 	_, ok := resp.(getFileContentsResponse)
 	if !ok {
 		t.Errorf("got %#v", resp)
@@ -465,7 +795,7 @@ func TestFilesError__segmentFileEndpoint(t *testing.T) {
 	repo := NewRepositoryInMemory(testTTLDuration, nil)
 	svc := NewService(repo)
 
-	resp, err := segmentFileEndpoint(svc, repo, nil)(context.TODO(), nil)
+	resp, err := segmentFileEndpoint(svc, nil)(context.TODO(), nil)
 	r, ok := resp.(segmentFileResponse)
 	if !ok {
 		t.Errorf("got %#v", resp)
@@ -568,7 +898,7 @@ func TestFilesError__flattenFileEndpoint(t *testing.T) {
 	repo := NewRepositoryInMemory(testTTLDuration, nil)
 	svc := NewService(repo)
 
-	resp, err := flattenBatchesEndpoint(svc, repo, nil)(context.TODO(), nil)
+	resp, err := flattenBatchesEndpoint(svc, nil)(context.TODO(), nil)
 	r, ok := resp.(flattenBatchesResponse)
 	if !ok {
 		t.Errorf("got %#v", resp)
@@ -656,6 +986,49 @@ func TestFileContentsByID__getFileContentsEndpoint(t *testing.T) {
 
 }
 
+func TestFileContentsByID__XML(t *testing.T) {
+	logger := log.NewNopLogger()
+	repo := NewRepositoryInMemory(testTTLDuration, logger)
+	svc := NewService(repo)
+	router := MakeHTTPHandler(svc, repo, logger)
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-mixedDebitCredit-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, _ := ach.FileFromJSON(bs)
+	repo.StoreFile(file)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", fmt.Sprintf("/files/%s/contents?format=xml", file.ID), nil)
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if v := w.Header().Get("Content-Type"); v != "application/xml" {
+		t.Errorf("unexpected Content-Type: %s", v)
+	}
+	read, err := ach.FileFromXML(w.Body.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.ID != file.ID || len(read.Batches) != len(file.Batches) {
+		t.Errorf("unexpected file: %#v", read)
+	}
+
+	// unknown formats
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("GET", fmt.Sprintf("/files/%s/contents?format=yaml", file.ID), nil)
+	router.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+}
+
 // TestFilesByID__deleteFileEndpoint tests by File ID
 func TestFilesByID__deleteFileEndpoint(t *testing.T) {
 	logger := log.NewNopLogger()
@@ -0,0 +1,69 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"mime"
+	"strings"
+
+	"github.com/moov-io/ach"
+)
+
+// friendlyJSON returns true when header (Accept or Content-Type) names 'application/json'
+// with the 'format=friendly' parameter, e.g. 'Accept: application/json; format=friendly'.
+func friendlyJSON(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == "application/json" && strings.EqualFold(params["format"], "friendly") {
+			return true
+		}
+	}
+	return false
+}
+
+// friendlyFile is an ach.File encoded with (*ach.File).MarshalFriendlyJSON
+type friendlyFile ach.File
+
+func (f *friendlyFile) MarshalJSON() ([]byte, error) {
+	return (*ach.File)(f).MarshalFriendlyJSON()
+}
+
+func friendlyFiles(files []*ach.File) []*friendlyFile {
+	out := make([]*friendlyFile, len(files))
+	for i := range files {
+		out[i] = (*friendlyFile)(files[i])
+	}
+	return out
+}
+
+type getFriendlyFilesResponse struct {
+	Files      []*friendlyFile `json:"files"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+	Err        error           `json:"error"`
+}
+
+func (r getFriendlyFilesResponse) count() int { return len(r.Files) }
+
+func (r getFriendlyFilesResponse) error() error { return r.Err }
+
+type getFriendlyFileResponse struct {
+	File *friendlyFile `json:"file"`
+	Err  error         `json:"error"`
+}
+
+func (r getFriendlyFileResponse) error() error { return r.Err }
@@ -0,0 +1,135 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+
+	"github.com/go-kit/kit/log"
+)
+
+func TestFriendlyJSON__header(t *testing.T) {
+	cases := map[string]bool{
+		"":                                  false,
+		"application/json":                  false,
+		"text/plain, application/json":      false,
+		"application/json; format=friendly": true,
+		"application/json;format=Friendly":  true,
+		"text/plain, application/json; format=friendly": true,
+		"application/xml; format=friendly":              false,
+	}
+	for header, expected := range cases {
+		if got := friendlyJSON(header); got != expected {
+			t.Errorf("%q: got %v", header, got)
+		}
+	}
+}
+
+func TestFiles__friendlyJSON(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := file.MarshalFriendlyJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// create the file from friendly JSON
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(bs))
+	req.Header.Set("Content-Type", "application/json; format=friendly")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	if f, _ := repo.FindFile(created.ID); f == nil || f.Batches[0].GetEntries()[0].Amount != 100000000 {
+		t.Fatalf("unexpected file: %#v", f)
+	}
+
+	var resp struct {
+		File struct {
+			Batches []struct {
+				Entries []struct {
+					TransactionCode string `json:"transactionCode"`
+					Amount          string `json:"amount"`
+				} `json:"entryDetails"`
+			} `json:"batches"`
+		} `json:"file"`
+	}
+	req = httptest.NewRequest("GET", "/files/"+created.ID, nil)
+	req.Header.Set("Accept", "application/json; format=friendly")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if ed := resp.File.Batches[0].Entries[0]; ed.TransactionCode != "checkingDebit" || ed.Amount != "1000000.00" {
+		t.Errorf("unexpected entry: %#v", ed)
+	}
+
+	// list files
+	req = httptest.NewRequest("GET", "/files", nil)
+	req.Header.Set("Accept", "application/json; format=friendly")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"amount":"1000000.00"`) {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// invalid amount
+	req = httptest.NewRequest("POST", "/files/create", bytes.NewReader(bytes.Replace(bs, []byte(`"1000000.00"`), []byte(`"10,000.00"`), 1)))
+	req.Header.Set("Content-Type", "application/json; format=friendly")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "invalid amount") {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -0,0 +1,465 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/ach/server/pb"
+	"github.com/moov-io/base"
+
+	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fileContentsChunkSize is the most bytes sent in each message of GetFileContents
+const fileContentsChunkSize = 32 * 1024
+
+// NewGRPCServer returns a *grpc.Server with the ACH service of server/pb/ach.proto registered,
+// which offers the same operations as the HTTP routes from MakeHTTPHandler.
+func NewGRPCServer(s Service, repo Repository, logger log.Logger, opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	g := &grpcServer{
+		svc:    s,
+		repo:   repo,
+		logger: logger,
+	}
+	pb.RegisterACHServer(srv, g)
+	return srv
+}
+
+// GRPCAuthentication returns options for NewGRPCServer which require every call to carry credentials
+// accepted by a in the "authorization" (as "Bearer <token>") or "x-api-key" metadata. Calls are scoped to
+// the files of the caller's tenant like HTTP requests are with the Authenticate HandlerOption.
+func GRPCAuthentication(a auth.Authenticator) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			ctx, err := grpcAuthenticate(ctx, a, info.FullMethod)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			ctx, err := grpcAuthenticate(ss.Context(), a, info.FullMethod)
+			if err != nil {
+				return err
+			}
+			return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+		}),
+	}
+}
+
+// grpcAuthenticate returns ctx with the caller's Principal if it's allowed to call method,
+// which is the full name such as "/moov.ach.v1.ACH/DeleteFile".
+func grpcAuthenticate(ctx context.Context, a auth.Authenticator, method string) (context.Context, error) {
+	var authorization, apiKey string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("authorization"); len(v) > 0 {
+			authorization = v[0]
+		}
+		if v := md.Get("x-api-key"); len(v) > 0 {
+			apiKey = v[0]
+		}
+	}
+	p, err := a.Authenticate(auth.TokenFromHeaders(authorization, apiKey))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	name := method[strings.LastIndex(method, "/")+1:]
+	scope := auth.ScopeWrite
+	switch {
+	case strings.HasPrefix(name, "Get"), strings.HasPrefix(name, "List"), name == "ValidateFile":
+		scope = auth.ScopeRead
+	case strings.HasPrefix(name, "Delete"):
+		scope = auth.ScopeDelete
+	}
+	if !p.Allowed(scope) {
+		return nil, status.Errorf(codes.PermissionDenied, "%v: %s requires the %s scope", errForbidden, name, scope)
+	}
+	return auth.NewContext(ctx, p), nil
+}
+
+// authenticatedStream overrides the context of a grpc.ServerStream
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+type grpcServer struct {
+	pb.UnimplementedACHServer
+
+	svc    Service
+	repo   Repository
+	logger log.Logger
+}
+
+// service returns the Service of the caller's tenant when calls are authenticated (see GRPCAuthentication),
+// which sends events and records history like the Service given to NewGRPCServer does
+func (g *grpcServer) service(ctx context.Context) Service {
+	s := g.svc
+	if p := auth.FromContext(ctx); p != nil {
+		s = forTenant(g.svc, NewService(g.repository(ctx)), p.Tenant)
+	}
+	return forRequest(ctx, s)
+}
+
+// repository returns the files of the caller's tenant when calls are authenticated
+func (g *grpcServer) repository(ctx context.Context) Repository {
+	if p := auth.FromContext(ctx); p != nil {
+		return newTenantRepository(g.repo, p.Tenant)
+	}
+	return g.repo
+}
+
+func (g *grpcServer) log(ctx context.Context, keyvals ...interface{}) {
+	if g.logger != nil {
+		g.logger.Log(append(keyvals, "requestID", grpcRequestID(ctx))...)
+	}
+}
+
+// grpcRequestID returns the 'x-request-id' metadata of an incoming call
+func grpcRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("x-request-id"); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// grpcError converts err into a gRPC status with the code matching its HTTP status
+func grpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	code := codes.Internal
+	switch codeFrom(err) {
+	case http.StatusBadRequest:
+		code = codes.InvalidArgument
+	case http.StatusNotFound:
+		code = codes.NotFound
+	case http.StatusConflict:
+		code = codes.AlreadyExists
+	}
+	return status.Error(code, err.Error())
+}
+
+func (g *grpcServer) CreateFile(ctx context.Context, req *pb.CreateFileRequest) (*pb.CreateFileResponse, error) {
+	var file *ach.File
+	var err error
+	switch {
+	case req.GetFile() != nil:
+		file, err = fileFromProto(req.GetFile())
+	case len(req.GetContents()) > 0:
+		var f ach.File
+		f, err = ach.NewReader(bytes.NewReader(req.GetContents())).Read()
+		file = &f
+	default:
+		err = errors.New("no file or contents provided")
+	}
+	if err != nil {
+		g.log(ctx, "files", "createFile", "error", err)
+		return nil, grpcError(fmt.Errorf("%v: %v", errInvalidFile, err))
+	}
+
+	// Create a random file ID if none was provided
+	if file.ID == "" {
+		file.ID = base.ID()
+	}
+	setMissingIDs(file)
+	err = g.service(ctx).StoreFile(file)
+	g.log(ctx, "files", "createFile", "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.CreateFileResponse{Id: file.ID}, nil
+}
+
+func (g *grpcServer) GetFile(ctx context.Context, req *pb.GetFileRequest) (*pb.File, error) {
+	f, err := g.service(ctx).GetFile(req.GetId())
+	g.log(ctx, "files", "getFile", "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if req.GetMask() {
+		f = f.Mask(ach.DefaultMaskingPolicy())
+	}
+	return fileToProto(f)
+}
+
+func (g *grpcServer) ListFiles(ctx context.Context, req *pb.ListFilesRequest) (*pb.ListFilesResponse, error) {
+	files := g.service(ctx).GetFiles()
+	resp := &pb.ListFilesResponse{
+		Files: make([]*pb.File, len(files)),
+	}
+	for i := range files {
+		f, err := fileToProto(files[i])
+		if err != nil {
+			return nil, err
+		}
+		resp.Files[i] = f
+	}
+	return resp, nil
+}
+
+func (g *grpcServer) DeleteFile(ctx context.Context, req *pb.DeleteFileRequest) (*pb.DeleteFileResponse, error) {
+	filesDeleted.Add(1)
+
+	err := g.service(ctx).DeleteFile(req.GetId())
+	g.log(ctx, "files", "deleteFile", "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.DeleteFileResponse{}, nil
+}
+
+func (g *grpcServer) GetFileContents(req *pb.GetFileContentsRequest, stream pb.ACH_GetFileContentsServer) error {
+	ctx := stream.Context()
+	r, err := g.service(ctx).GetFileContents(req.GetId())
+	g.log(ctx, "files", "getFileContents", "error", err)
+	if err != nil {
+		return grpcError(err)
+	}
+
+	buf := make([]byte, fileContentsChunkSize)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.FileContents{Contents: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return grpcError(err)
+		}
+	}
+}
+
+func (g *grpcServer) ValidateFile(ctx context.Context, req *pb.ValidateFileRequest) (*pb.ValidateFileResponse, error) {
+	var opts *ach.ValidateOpts
+	if o := req.GetOpts(); o != nil {
+		opts = &ach.ValidateOpts{
+			RequireABAOrigin:            o.GetRequireAbaOrigin(),
+			BypassOriginValidation:      o.GetBypassOriginValidation(),
+			BypassDestinationValidation: o.GetBypassDestinationValidation(),
+		}
+	}
+	err := g.service(ctx).ValidateFile(req.GetId(), opts)
+	g.log(ctx, "files", "validateFile", "error", err)
+	if err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, grpcError(fmt.Errorf("%v: %v", errInvalidFile, err))
+	}
+	return &pb.ValidateFileResponse{}, nil
+}
+
+func (g *grpcServer) BalanceFile(ctx context.Context, req *pb.BalanceFileRequest) (*pb.BalanceFileResponse, error) {
+	off := req.GetOffset()
+	if off.GetRoutingNumber() == "" || off.GetAccountNumber() == "" || off.GetAccountType() == "" {
+		return nil, status.Error(codes.InvalidArgument, "missing some offset fields")
+	}
+	balancedFile, err := g.service(ctx).BalanceFile(req.GetFileId(), &ach.Offset{
+		RoutingNumber: off.GetRoutingNumber(),
+		AccountNumber: off.GetAccountNumber(),
+		AccountType:   ach.OffsetAccountType(off.GetAccountType()),
+		Description:   off.GetDescription(),
+	})
+	g.log(ctx, "files", "balanceFile", "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.BalanceFileResponse{Id: balancedFile.ID}, nil
+}
+
+func (g *grpcServer) SegmentFile(ctx context.Context, req *pb.SegmentFileRequest) (*pb.SegmentFileResponse, error) {
+	s := g.service(ctx)
+	creditFile, debitFile, err := s.SegmentFile(req.GetFileId(), ach.NewSegmentFileConfiguration())
+	g.log(ctx, "files", "segmentFile", "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	for _, f := range []*ach.File{creditFile, debitFile} {
+		if f.ID != "" {
+			if err := s.StoreFile(f); err != nil {
+				return nil, grpcError(err)
+			}
+		}
+	}
+	return &pb.SegmentFileResponse{
+		CreditFileId: creditFile.ID,
+		DebitFileId:  debitFile.ID,
+	}, nil
+}
+
+func (g *grpcServer) FlattenBatches(ctx context.Context, req *pb.FlattenBatchesRequest) (*pb.FlattenBatchesResponse, error) {
+	s := g.service(ctx)
+	flattenFile, err := s.FlattenBatches(req.GetFileId())
+	g.log(ctx, "files", "flattenBatches", "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	if flattenFile.ID != "" {
+		if err := s.StoreFile(flattenFile); err != nil {
+			return nil, grpcError(err)
+		}
+	}
+	return &pb.FlattenBatchesResponse{Id: flattenFile.ID}, nil
+}
+
+func (g *grpcServer) CreateBatch(ctx context.Context, req *pb.CreateBatchRequest) (*pb.CreateBatchResponse, error) {
+	if req.GetBatch() == nil {
+		return nil, status.Error(codes.InvalidArgument, "no Batch provided")
+	}
+	bs, err := json.Marshal(protoJSON(req.GetBatch().ProtoReflect()))
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	var batch ach.Batch
+	if err := json.Unmarshal(bs, &batch); err != nil {
+		return nil, grpcError(fmt.Errorf("%v: %v", errInvalidFile, err))
+	}
+
+	id, err := g.service(ctx).CreateBatch(req.GetFileId(), &batch)
+	g.log(ctx, "batches", "createBatch", "file", req.GetFileId(), "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.CreateBatchResponse{Id: id}, nil
+}
+
+func (g *grpcServer) GetBatch(ctx context.Context, req *pb.GetBatchRequest) (*pb.Batch, error) {
+	batch, err := g.service(ctx).GetBatch(req.GetFileId(), req.GetBatchId())
+	g.log(ctx, "batches", "getBatch", "file", req.GetFileId(), "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	var out pb.Batch
+	if err := toProto(batch, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (g *grpcServer) ListBatches(ctx context.Context, req *pb.ListBatchesRequest) (*pb.ListBatchesResponse, error) {
+	batches := g.service(ctx).GetBatches(req.GetFileId())
+	g.log(ctx, "batches", "getBatches", "file", req.GetFileId())
+
+	resp := &pb.ListBatchesResponse{
+		Batches: make([]*pb.Batch, len(batches)),
+	}
+	for i := range batches {
+		resp.Batches[i] = &pb.Batch{}
+		if err := toProto(batches[i], resp.Batches[i]); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (g *grpcServer) DeleteBatch(ctx context.Context, req *pb.DeleteBatchRequest) (*pb.DeleteBatchResponse, error) {
+	err := g.service(ctx).DeleteBatch(req.GetFileId(), req.GetBatchId())
+	g.log(ctx, "batches", "deleteBatch", "file", req.GetFileId(), "error", err)
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &pb.DeleteBatchResponse{}, nil
+}
+
+// fileToProto converts f into its protobuf message. Messages in ach.proto use the
+// same JSON names as the ach package so the JSON encoding is shared.
+func fileToProto(f *ach.File) (*pb.File, error) {
+	var out pb.File
+	if err := toProto(f, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func toProto(in interface{}, out proto.Message) error {
+	bs, err := json.Marshal(in)
+	if err != nil {
+		return grpcError(err)
+	}
+	opts := protojson.UnmarshalOptions{DiscardUnknown: true}
+	if err := opts.Unmarshal(bs, out); err != nil {
+		return grpcError(err)
+	}
+	return nil
+}
+
+// fileFromProto reads f with ach.FileFromJSON
+func fileFromProto(f *pb.File) (*ach.File, error) {
+	bs, err := json.Marshal(protoJSON(f.ProtoReflect()))
+	if err != nil {
+		return nil, err
+	}
+	return ach.FileFromJSON(bs)
+}
+
+// protoJSON returns the set fields of m keyed by their JSON name. protojson.Marshal isn't
+// used as it writes 64-bit integers as strings, which the ach package doesn't read.
+func protoJSON(m protoreflect.Message) map[string]interface{} {
+	out := make(map[string]interface{})
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() {
+			list := v.List()
+			items := make([]interface{}, list.Len())
+			for i := range items {
+				items[i] = protoJSONValue(fd, list.Get(i))
+			}
+			out[fd.JSONName()] = items
+		} else {
+			out[fd.JSONName()] = protoJSONValue(fd, v)
+		}
+		return true
+	})
+	return out
+}
+
+func protoJSONValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	if fd.Kind() != protoreflect.MessageKind {
+		return v.Interface()
+	}
+	return protoJSON(v.Message())
+}
@@ -0,0 +1,335 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/dedupe"
+	"github.com/moov-io/ach/server/pb"
+
+	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func grpcTestClient(t *testing.T, s Service, repo Repository) pb.ACHClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := NewGRPCServer(s, repo, log.NewNopLogger())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewACHClient(conn)
+}
+
+func TestGRPC__files(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	client := grpcTestClient(t, NewService(repo), repo)
+	ctx := context.Background()
+
+	contents, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.CreateFile(ctx, &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: contents},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetId() == "" {
+		t.Fatal("empty file ID")
+	}
+
+	file, err := client.GetFile(ctx, &pb.GetFileRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(file.GetBatches()); n != 1 {
+		t.Fatalf("got %d batches", n)
+	}
+	entry := file.GetBatches()[0].GetEntries()[0]
+	if entry.GetAmount() != 100000000 || entry.GetDfiAccountNumber() != "12345678         " {
+		t.Errorf("unexpected entry: %v", entry)
+	}
+
+	masked, err := client.GetFile(ctx, &pb.GetFileRequest{Id: created.GetId(), Mask: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if acct := masked.GetBatches()[0].GetEntries()[0].GetDfiAccountNumber(); acct == entry.GetDfiAccountNumber() {
+		t.Errorf("account number wasn't masked: %q", acct)
+	}
+
+	// create a copy of the file from its message
+	file.Id = ""
+	copied, err := client.CreateFile(ctx, &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_File{File: file},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ValidateFile(ctx, &pb.ValidateFileRequest{Id: copied.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := client.ListFiles(ctx, &pb.ListFilesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(list.GetFiles()); n != 2 {
+		t.Errorf("got %d files", n)
+	}
+
+	// the stored copy renders the same as the original file
+	original := readGRPCFileContents(t, client, created.GetId())
+	if _, err := ach.NewReader(bytes.NewReader(original)).Read(); err != nil {
+		t.Fatal(err)
+	}
+	if c := readGRPCFileContents(t, client, copied.GetId()); !bytes.Equal(c, original) {
+		t.Errorf("copied file differs:\n%s", c)
+	}
+
+	if _, err := client.DeleteFile(ctx, &pb.DeleteFileRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetFile(ctx, &pb.GetFileRequest{Id: created.GetId()})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGRPC__duplicates(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	detector := dedupe.NewDetector(dedupe.NewStoreInMemory(), nil)
+	client := grpcTestClient(t, WithDuplicateDetector(NewService(repo), detector), repo)
+	ctx := context.Background()
+
+	contents, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: contents},
+	}
+	if _, err := client.CreateFile(ctx, req); err != nil {
+		t.Fatal(err)
+	}
+
+	// create the same file again
+	_, err = client.CreateFile(ctx, req)
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if n := len(repo.FindAllFiles()); n != 1 {
+		t.Errorf("got %d files", n)
+	}
+}
+
+func readGRPCFileContents(t *testing.T, client pb.ACHClient, fileID string) []byte {
+	t.Helper()
+
+	stream, err := client.GetFileContents(context.Background(), &pb.GetFileContentsRequest{Id: fileID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			return buf.Bytes()
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		buf.Write(msg.GetContents())
+	}
+}
+
+func TestGRPC__fileContentsStream(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	client := grpcTestClient(t, NewService(repo), repo)
+
+	// build a file large enough to need several messages
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := file.Batches[0].GetEntries()[0]
+	for i := 0; i < 500; i++ {
+		ed := *entry
+		ed.SetTraceNumber("23138010", i+2)
+		file.Batches[0].AddEntry(&ed)
+	}
+	if err := file.Batches[0].Create(); err != nil {
+		t.Fatal(err)
+	}
+	if err := file.Create(); err != nil {
+		t.Fatal(err)
+	}
+	file.ID = "large"
+	if err := repo.StoreFile(&file); err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.GetFileContents(context.Background(), &pb.GetFileContentsRequest{Id: "large"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	messages := 0
+	for {
+		msg, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		messages++
+		buf.Write(msg.GetContents())
+	}
+	if messages < 2 {
+		t.Errorf("expected several messages, got %d", messages)
+	}
+	read, err := ach.NewReader(&buf).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(read.Batches[0].GetEntries()); n != 501 {
+		t.Errorf("got %d entries", n)
+	}
+}
+
+func TestGRPC__batches(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	client := grpcTestClient(t, NewService(repo), repo)
+	ctx := context.Background()
+
+	contents, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created, err := client.CreateFile(ctx, &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: contents},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := client.ListBatches(ctx, &pb.ListBatchesRequest{FileId: created.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(list.GetBatches()); n != 1 {
+		t.Fatalf("got %d batches", n)
+	}
+
+	batch := list.GetBatches()[0]
+	batch.Header.Id = "batch-2"
+	batch.Header.BatchNumber = 2
+	batch.Control.BatchNumber = 2
+	resp, err := client.CreateBatch(ctx, &pb.CreateBatchRequest{FileId: created.GetId(), Batch: batch})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetId() != "batch-2" {
+		t.Errorf("unexpected batch ID: %q", resp.GetId())
+	}
+
+	got, err := client.GetBatch(ctx, &pb.GetBatchRequest{FileId: created.GetId(), BatchId: "batch-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.GetHeader().GetCompanyName() != batch.GetHeader().GetCompanyName() {
+		t.Errorf("unexpected batch: %v", got)
+	}
+
+	if _, err := client.DeleteBatch(ctx, &pb.DeleteBatchRequest{FileId: created.GetId(), BatchId: "batch-2"}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.GetBatch(ctx, &pb.GetBatchRequest{FileId: created.GetId(), BatchId: "batch-2"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGRPC__errors(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	client := grpcTestClient(t, NewService(repo), repo)
+	ctx := context.Background()
+
+	_, err := client.CreateFile(ctx, &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: []byte("invalid")},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if files := repo.FindAllFiles(); len(files) != 0 {
+		t.Errorf("invalid file was stored")
+	}
+
+	_, err = client.CreateFile(ctx, &pb.CreateFileRequest{})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = client.ValidateFile(ctx, &pb.ValidateFileRequest{Id: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = client.BalanceFile(ctx, &pb.BalanceFileRequest{FileId: "missing"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	stream, err := client.GetFileContents(ctx, &pb.GetFileContentsRequest{Id: "missing"})
+	if err == nil {
+		_, err = stream.Recv()
+	}
+	if status.Code(err) == codes.OK {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,421 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/audit"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/metadata"
+)
+
+func addHistoryRoutes(r *mux.Router, repo Repository, history audit.Store, logger log.Logger, options []httptransport.ServerOption) {
+	if n, ok := repo.(expiryNotifier); ok {
+		n.onExpired(func(fileID string) {
+			recordEvent(history, logger, audit.Event{Operation: audit.FileExpired, FileID: fileID})
+		})
+	}
+
+	r.Methods("GET").Path("/files/{fileID}/history").Handler(httptransport.NewServer(
+		getFileHistoryEndpoint(history, logger),
+		decodeGetFileHistoryRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// recordEvent saves event to history, filling in its ID and timestamp
+func recordEvent(history audit.Store, logger log.Logger, event audit.Event) {
+	event.ID = base.ID()
+	event.CreatedAt = time.Now()
+	if err := history.Record(event); err != nil && logger != nil {
+		logger.Log("history", "recordEvent", "operation", event.Operation, "fileID", event.FileID, "error", err)
+	}
+}
+
+// WithHistory returns a Service which records an audit.Event in store for each change (or validation)
+// of a file made through s. Give it to NewGRPCServer so calls are recorded like HTTP requests to
+// MakeHTTPHandler with RecordHistory.
+func WithHistory(s Service, store audit.Store, logger log.Logger) Service {
+	return withHistory(s, store, "", logger)
+}
+
+// withHistory returns s recording the changes of tenant's files in store, which can be nil
+func withHistory(s Service, store audit.Store, tenant string, logger log.Logger) Service {
+	if store == nil {
+		return s
+	}
+	for inner := s; inner != nil; inner = unwrapService(inner) {
+		if hs, ok := inner.(*historyService); ok && hs.history == store && hs.tenant == tenant {
+			return s // already recording history
+		}
+	}
+	return &historyService{
+		Service: s,
+		history: store,
+		tenant:  tenant,
+		logger:  logger,
+		derived: new(sync.Map),
+	}
+}
+
+// historyService records an audit.Event for each change made through its Service, whichever
+// transport (HTTP or gRPC) the change came from. Files are read before and after each change
+// to summarize it.
+type historyService struct {
+	Service
+
+	history audit.Store
+	tenant  string
+	logger  log.Logger
+
+	// requestID and actor identify the request making the changes, see forRequest
+	requestID string
+	actor     string
+
+	// derived holds the source file IDs of files balanced, segmented, flattened or merged
+	// but not yet stored, keyed by their ID
+	derived *sync.Map
+}
+
+// summarize returns the summary of a file, or nil if it doesn't exist
+func (s *historyService) summarize(fileID string) *audit.Summary {
+	f, err := s.Service.GetFile(fileID)
+	if err != nil {
+		return nil
+	}
+	return audit.Summarize(f)
+}
+
+func (s *historyService) record(event audit.Event) {
+	event.RequestID = s.requestID
+	event.Actor = s.actor
+	event.Tenant = s.tenant
+	recordEvent(s.history, s.logger, event)
+}
+
+// recordChange records event unless the change failed or its file didn't exist
+func (s *historyService) recordChange(event audit.Event, before *audit.Summary, err error) {
+	if err != nil || before == nil {
+		return
+	}
+	event.Before = before
+	event.After = s.summarize(event.FileID)
+	s.record(event)
+}
+
+// recordDerived records the operation on each of sources which created files. Those files are
+// recorded as created from sources once they're stored.
+func (s *historyService) recordDerived(operation string, sources []string, created []*ach.File) {
+	var ids []string
+	for i := range created {
+		if created[i] != nil && created[i].ID != "" {
+			ids = append(ids, created[i].ID)
+			s.derived.Store(created[i].ID, sources)
+		}
+	}
+	s.recordSources(operation, sources, ids)
+}
+
+// recordSources records the operation on each of sources which created the files of ids
+func (s *historyService) recordSources(operation string, sources []string, ids []string) {
+	for _, id := range sources {
+		summary := s.summarize(id)
+		s.record(audit.Event{
+			Operation:      operation,
+			FileID:         id,
+			RelatedFileIDs: ids,
+			Before:         summary,
+			After:          summary,
+		})
+	}
+}
+
+func (s *historyService) CreateFile(fh *ach.FileHeader) (string, error) {
+	id, err := s.Service.CreateFile(fh)
+	if err == nil {
+		s.record(audit.Event{Operation: audit.FileCreated, FileID: id, After: s.summarize(id)})
+	}
+	return id, err
+}
+
+func (s *historyService) StoreFile(f *ach.File) error {
+	if err := s.Service.StoreFile(f); err != nil {
+		return err
+	}
+	event := audit.Event{
+		Operation: audit.FileCreated,
+		FileID:    f.ID,
+		After:     audit.Summarize(f),
+	}
+	if sources, ok := s.derived.Load(f.ID); ok {
+		s.derived.Delete(f.ID)
+		event.RelatedFileIDs = sources.([]string)
+	}
+	if err := f.Validate(); err != nil {
+		event.Error = err.Error() // files with errors are still created
+	}
+	s.record(event)
+	return nil
+}
+
+func (s *historyService) DeleteFile(id string) error {
+	before := s.summarize(id)
+	err := s.Service.DeleteFile(id)
+	s.recordChange(audit.Event{Operation: audit.FileDeleted, FileID: id}, before, err)
+	return err
+}
+
+func (s *historyService) ValidateFile(id string, opts *ach.ValidateOpts) error {
+	before := s.summarize(id)
+	err := s.Service.ValidateFile(id, opts)
+	if before != nil {
+		event := audit.Event{
+			Operation: audit.FileValidated,
+			FileID:    id,
+			Before:    before,
+			After:     before,
+		}
+		if err != nil {
+			event.Operation = audit.FileValidationFailed
+			event.Error = err.Error()
+		}
+		s.record(event)
+	}
+	return err
+}
+
+func (s *historyService) BalanceFile(fileID string, off *ach.Offset) (*ach.File, error) {
+	f, err := s.Service.BalanceFile(fileID, off) // stores the balanced file
+	if err == nil {
+		s.record(audit.Event{
+			Operation:      audit.FileCreated,
+			FileID:         f.ID,
+			RelatedFileIDs: []string{fileID},
+			After:          audit.Summarize(f),
+		})
+		s.recordSources(audit.FileBalanced, []string{fileID}, []string{f.ID})
+	}
+	return f, err
+}
+
+func (s *historyService) SegmentFile(id string, opts *ach.SegmentFileConfiguration) (*ach.File, *ach.File, error) {
+	credit, debit, err := s.Service.SegmentFile(id, opts)
+	if err == nil {
+		s.recordDerived(audit.FileSegmented, []string{id}, []*ach.File{credit, debit})
+	}
+	return credit, debit, err
+}
+
+func (s *historyService) FlattenBatches(id string) (*ach.File, error) {
+	f, err := s.Service.FlattenBatches(id)
+	if err == nil {
+		s.recordDerived(audit.FileFlattened, []string{id}, []*ach.File{f})
+	}
+	return f, err
+}
+
+func (s *historyService) MergeFiles(fileIDs []string, conditions ach.Conditions) ([]*ach.File, error) {
+	files, err := s.Service.MergeFiles(fileIDs, conditions)
+	if err == nil {
+		s.recordDerived(audit.FileMerged, fileIDs, files)
+	}
+	return files, err
+}
+
+func (s *historyService) CreateBatch(fileID string, batch ach.Batcher) (string, error) {
+	before := s.summarize(fileID)
+	id, err := s.Service.CreateBatch(fileID, batch)
+	s.recordChange(audit.Event{Operation: audit.BatchCreated, FileID: fileID, BatchID: id}, before, err)
+	return id, err
+}
+
+func (s *historyService) DeleteBatch(fileID string, batchID string) error {
+	before := s.summarize(fileID)
+	err := s.Service.DeleteBatch(fileID, batchID)
+	s.recordChange(audit.Event{Operation: audit.BatchDeleted, FileID: fileID, BatchID: batchID}, before, err)
+	return err
+}
+
+func (s *historyService) CreateEntry(fileID string, batchID string, entry *ach.EntryDetail) (string, error) {
+	before := s.summarize(fileID)
+	id, err := s.Service.CreateEntry(fileID, batchID, entry)
+	s.recordChange(audit.Event{Operation: audit.EntryCreated, FileID: fileID, BatchID: batchID, EntryID: id}, before, err)
+	return id, err
+}
+
+func (s *historyService) UpdateEntry(fileID string, batchID string, entry *ach.EntryDetail) error {
+	before := s.summarize(fileID)
+	err := s.Service.UpdateEntry(fileID, batchID, entry)
+	s.recordChange(audit.Event{Operation: audit.EntryUpdated, FileID: fileID, BatchID: batchID, EntryID: entry.ID}, before, err)
+	return err
+}
+
+func (s *historyService) DeleteEntry(fileID string, batchID string, entryID string) error {
+	before := s.summarize(fileID)
+	err := s.Service.DeleteEntry(fileID, batchID, entryID)
+	s.recordChange(audit.Event{Operation: audit.EntryDeleted, FileID: fileID, BatchID: batchID, EntryID: entryID}, before, err)
+	return err
+}
+
+func (s *historyService) CreateAddenda(fileID string, batchID string, entryID string, addenda Addenda) (string, error) {
+	before := s.summarize(fileID)
+	id, err := s.Service.CreateAddenda(fileID, batchID, entryID, addenda)
+	s.recordChange(audit.Event{
+		Operation: audit.AddendaCreated,
+		FileID:    fileID,
+		BatchID:   batchID,
+		EntryID:   entryID,
+		AddendaID: id,
+	}, before, err)
+	return id, err
+}
+
+func (s *historyService) UpdateAddenda(fileID string, batchID string, entryID string, addenda Addenda) error {
+	before := s.summarize(fileID)
+	err := s.Service.UpdateAddenda(fileID, batchID, entryID, addenda)
+	s.recordChange(audit.Event{
+		Operation: audit.AddendaUpdated,
+		FileID:    fileID,
+		BatchID:   batchID,
+		EntryID:   entryID,
+		AddendaID: getAddendaID(addenda),
+	}, before, err)
+	return err
+}
+
+func (s *historyService) DeleteAddenda(fileID string, batchID string, entryID string, addendaID string) error {
+	before := s.summarize(fileID)
+	err := s.Service.DeleteAddenda(fileID, batchID, entryID, addendaID)
+	s.recordChange(audit.Event{
+		Operation: audit.AddendaDeleted,
+		FileID:    fileID,
+		BatchID:   batchID,
+		EntryID:   entryID,
+		AddendaID: addendaID,
+	}, before, err)
+	return err
+}
+
+// requestKey is the context key of the requestIdentity of HTTP requests
+type requestKey struct{}
+
+// requestIdentity is who sent an HTTP request, from its X-Request-Id and X-User-Id headers
+type requestIdentity struct {
+	requestID string
+	userID    string
+}
+
+// saveRequestIdentityIntoContext saves the X-Request-Id and X-User-Id headers into the go-kit
+// context, so changes made by the request are recorded with them (see forRequest).
+func saveRequestIdentityIntoContext() httptransport.RequestFunc {
+	return func(ctx context.Context, r *http.Request) context.Context {
+		return context.WithValue(ctx, requestKey{}, requestIdentity{
+			requestID: moovhttp.GetRequestID(r),
+			userID:    moovhttp.GetUserID(r),
+		})
+	}
+}
+
+// requestIDOf returns the X-Request-Id header of an HTTP request or 'x-request-id' metadata of a gRPC call
+func requestIDOf(ctx context.Context) string {
+	if id, ok := ctx.Value(requestKey{}).(requestIdentity); ok {
+		return id.requestID
+	}
+	return grpcRequestID(ctx)
+}
+
+// actorOf returns who sent a request, the authenticated subject or else the X-User-Id header
+// ('x-user-id' metadata of gRPC calls)
+func actorOf(ctx context.Context) string {
+	if p := auth.FromContext(ctx); p != nil {
+		return p.Subject
+	}
+	if id, ok := ctx.Value(requestKey{}).(requestIdentity); ok {
+		return id.userID
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get("x-user-id"); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+type getFileHistoryRequest struct {
+	fileID    string
+	requestID string
+}
+
+func decodeGetFileHistoryRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return getFileHistoryRequest{
+		fileID:    mux.Vars(r)["fileID"],
+		requestID: moovhttp.GetRequestID(r),
+	}, nil
+}
+
+type getFileHistoryResponse struct {
+	History []audit.Event `json:"history"`
+	Err     error         `json:"error"`
+}
+
+func (r getFileHistoryResponse) count() int { return len(r.History) }
+
+func (r getFileHistoryResponse) error() error { return r.Err }
+
+// getFileHistoryEndpoint returns the events of a file, oldest first. History is kept after
+// the file is deleted.
+func getFileHistoryEndpoint(history audit.Store, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getFileHistoryRequest)
+		if !ok {
+			return getFileHistoryResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		events, err := history.History(req.fileID)
+		tenant := tenantOf(ctx)
+		out := make([]audit.Event, 0, len(events))
+		for i := range events {
+			if events[i].Tenant == tenant {
+				out = append(out, events[i])
+			}
+		}
+		if err == nil && len(out) == 0 {
+			err = ErrNotFound
+		}
+		if logger != nil {
+			logger.Log("history", "getFileHistory", "fileID", req.fileID, "requestID", req.requestID, "error", err)
+		}
+		return getFileHistoryResponse{
+			History: out,
+			Err:     err,
+		}, nil
+	}
+}
@@ -0,0 +1,230 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/moov-io/ach/audit"
+	"github.com/moov-io/ach/server/pb"
+
+	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc/metadata"
+)
+
+func getHistory(t *testing.T, handler http.Handler, key, fileID string) []audit.Event {
+	t.Helper()
+
+	w := serveWithKey(t, handler, key, "GET", "/files/"+fileID+"/history", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	var resp struct {
+		History []audit.Event `json:"history"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp.History
+}
+
+func TestFileHistory(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	store := audit.NewStoreInMemory()
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), RecordHistory(store))
+
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(readTestdata(t, "ppd-debit.ach")))
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("X-Request-Id", "req-1")
+	req.Header.Set("X-User-Id", "jane")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil || created.ID == "" {
+		t.Fatalf("id=%q error=%v: %s", created.ID, err, w.Body.String())
+	}
+	fileID := created.ID
+
+	file, _ := repo.FindFile(fileID)
+	batchID := file.Batches[0].ID()
+	entries := "/files/" + fileID + "/batches/" + batchID + "/entries"
+	if w := serveJSON(t, handler, "POST", entries, mockPPDDebitEntry()); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	// the file control isn't updated with the new entry
+	if w := serveJSON(t, handler, "GET", "/files/"+fileID+"/validate", nil); w.Code != http.StatusBadRequest {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	// reads and failed changes aren't recorded
+	serveJSON(t, handler, "GET", "/files/"+fileID, nil)
+	serveJSON(t, handler, "DELETE", entries+"/missing", nil)
+	if w := serveJSON(t, handler, "DELETE", "/files/"+fileID, nil); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// history is kept after the file is deleted
+	history := getHistory(t, handler, "", fileID)
+	ops := make([]string, len(history))
+	for i := range history {
+		ops[i] = history[i].Operation
+	}
+	want := []string{audit.FileCreated, audit.EntryCreated, audit.FileValidationFailed, audit.FileDeleted}
+	if len(ops) != len(want) {
+		t.Fatalf("operations=%v", ops)
+	}
+	for i := range want {
+		if ops[i] != want[i] {
+			t.Errorf("operations=%v", ops)
+		}
+	}
+
+	if e := history[0]; e.RequestID != "req-1" || e.Actor != "jane" || e.Before != nil || e.After == nil || e.After.Entries != 1 {
+		t.Errorf("created: %#v", e)
+	}
+	if e := history[1]; e.BatchID != batchID || e.EntryID == "" || e.Before.Entries != 1 || e.After.Entries != 2 {
+		t.Errorf("entry: %#v", e)
+	} else if e.After.TotalDebit <= e.Before.TotalDebit {
+		t.Errorf("debits before=%d after=%d", e.Before.TotalDebit, e.After.TotalDebit)
+	}
+	if e := history[2]; e.Error == "" || e.Before == nil || e.After == nil {
+		t.Errorf("validated: %#v", e)
+	}
+	if e := history[3]; e.Before == nil || e.Before.Entries != 2 || e.After != nil {
+		t.Errorf("deleted: %#v", e)
+	}
+
+	if w := serveJSON(t, handler, "GET", "/files/missing/history", nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+}
+
+func TestFileHistory__Derived(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	store := audit.NewStoreInMemory()
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), RecordHistory(store))
+
+	w := serveWithKey(t, handler, "", "POST", "/files/create", readTestdata(t, "ppd-debit.ach"))
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	fileID := created.ID
+
+	w = serveJSON(t, handler, "POST", "/files/"+fileID+"/flatten", nil)
+	var flattened struct {
+		ID string `json:"id"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&flattened); err != nil || flattened.ID == "" {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	history := getHistory(t, handler, "", fileID)
+	if n := len(history); n != 2 || history[1].Operation != audit.FileFlattened {
+		t.Fatalf("history=%#v", history)
+	}
+	if ids := history[1].RelatedFileIDs; len(ids) != 1 || ids[0] != flattened.ID {
+		t.Errorf("related=%v", ids)
+	}
+	history = getHistory(t, handler, "", flattened.ID)
+	if n := len(history); n != 1 || history[0].Operation != audit.FileCreated {
+		t.Fatalf("history=%#v", history)
+	}
+	if ids := history[0].RelatedFileIDs; len(ids) != 1 || ids[0] != fileID {
+		t.Errorf("related=%v", ids)
+	}
+}
+
+func TestFileHistory__Tenants(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	store := audit.NewStoreInMemory()
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), Authenticate(testAuthenticator(t)), RecordHistory(store))
+
+	w := serveWithKey(t, handler, "acme-key", "POST", "/files/create", readTestdata(t, "ppd-debit.ach"))
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil || created.ID == "" {
+		t.Fatalf("id=%q error=%v", created.ID, err)
+	}
+
+	history := getHistory(t, handler, "acme-reader", created.ID)
+	if len(history) != 1 || history[0].Tenant != "acme" || history[0].Actor != "acme" {
+		t.Fatalf("history=%#v", history)
+	}
+	if w := serveWithKey(t, handler, "other-key", "GET", "/files/"+created.ID+"/history", nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+}
+
+func TestFileHistory__gRPC(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	store := audit.NewStoreInMemory()
+	client := grpcTestClient(t, WithHistory(NewService(repo), store, log.NewNopLogger()), repo)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-request-id", "req-1", "x-user-id", "jane")
+
+	created, err := client.CreateFile(ctx, &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: readTestdata(t, "ppd-debit.ach")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ValidateFile(ctx, &pb.ValidateFileRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	flattened, err := client.FlattenBatches(ctx, &pb.FlattenBatchesRequest{FileId: created.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.DeleteFile(ctx, &pb.DeleteFileRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+
+	history, err := store.History(created.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{audit.FileCreated, audit.FileValidated, audit.FileFlattened, audit.FileDeleted}
+	if len(history) != len(expected) {
+		t.Fatalf("history=%#v", history)
+	}
+	for i := range history {
+		if history[i].Operation != expected[i] {
+			t.Errorf("history[%d].Operation=%s", i, history[i].Operation)
+		}
+		if history[i].RequestID != "req-1" || history[i].Actor != "jane" {
+			t.Errorf("history[%d] requestID=%q actor=%q", i, history[i].RequestID, history[i].Actor)
+		}
+	}
+	if history[3].Before == nil || history[3].After != nil {
+		t.Errorf("before=%#v after=%#v", history[3].Before, history[3].After)
+	}
+
+	history, err = store.History(flattened.GetId())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0].Operation != audit.FileCreated {
+		t.Fatalf("history=%#v", history)
+	}
+	if ids := history[0].RelatedFileIDs; len(ids) != 1 || ids[0] != created.GetId() {
+		t.Errorf("related=%v", ids)
+	}
+}
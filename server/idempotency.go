@@ -0,0 +1,197 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/moov-io/base/idempotent"
+)
+
+const (
+	// IdempotencyKeyHeader is the request header which identifies retries of a POST request.
+	// The X-Idempotency-Key header used by other Moov services is also accepted.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	// maxIdempotencyKeyLength is the longest Idempotency-Key accepted
+	maxIdempotencyKeyLength = 255
+)
+
+var (
+	errInvalidIdempotencyKey = errors.New("invalid Idempotency-Key")
+	errIdempotencyKeyInUse   = errors.New("a request with this Idempotency-Key is in progress")
+	errIdempotencyKeyReused  = errors.New("the Idempotency-Key was used with a different request")
+)
+
+// idempotentResponse is the response to the first request with an Idempotency-Key
+type idempotentResponse struct {
+	fingerprint [32]byte
+	expires     time.Time
+
+	// done is false while the first request is being served
+	done   bool
+	code   int
+	header http.Header
+	body   []byte
+}
+
+// idempotencyKeys records the responses of POST requests with an Idempotency-Key header and
+// replays them when the request is retried, so retries don't create files or batches twice.
+type idempotencyKeys struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	responses map[string]*idempotentResponse
+	lastSweep time.Time
+}
+
+func newIdempotencyKeys(ttl time.Duration) *idempotencyKeys {
+	return &idempotencyKeys{
+		ttl:       ttl,
+		responses: make(map[string]*idempotentResponse),
+	}
+}
+
+// begin returns the recorded response of key, or nil if this is the first request with key
+func (k *idempotencyKeys) begin(key string, fingerprint [32]byte) *idempotentResponse {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(k.lastSweep) > time.Minute {
+		for id, resp := range k.responses {
+			if resp.done && now.After(resp.expires) {
+				delete(k.responses, id)
+			}
+		}
+		k.lastSweep = now
+	}
+	if resp, ok := k.responses[key]; ok && (!resp.done || now.Before(resp.expires)) {
+		out := *resp
+		return &out
+	}
+	k.responses[key] = &idempotentResponse{
+		fingerprint: fingerprint,
+	}
+	return nil
+}
+
+// finish records the response to key, or forgets key so the request can be retried
+func (k *idempotencyKeys) finish(key string, w *recordingWriter) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	// Server errors might not happen again
+	if w.code >= 500 {
+		delete(k.responses, key)
+		return
+	}
+	if resp, ok := k.responses[key]; ok {
+		resp.done = true
+		resp.code = w.code
+		resp.header = w.header
+		resp.body = w.body.Bytes()
+		resp.expires = time.Now().Add(k.ttl)
+	}
+}
+
+func (k *idempotencyKeys) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" {
+			key = r.Header.Get(idempotent.HeaderKey)
+		}
+		if r.Method != "POST" || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			encodeError(r.Context(), errInvalidIdempotencyKey, w)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			encodeError(r.Context(), err, w)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		// Keys are only unique to each tenant, and a retry must be the same request
+		if tenant := tenantOf(r.Context()); tenant != "" {
+			key = tenant + " " + key
+		}
+		fingerprint := sha256.Sum256(append([]byte(r.URL.RequestURI()+"\n"), body...))
+
+		resp := k.begin(key, fingerprint)
+		switch {
+		case resp == nil:
+			rec := &recordingWriter{ResponseWriter: w, code: http.StatusOK}
+			defer k.finish(key, rec)
+			next.ServeHTTP(rec, r)
+
+		case resp.fingerprint != fingerprint:
+			encodeError(r.Context(), errIdempotencyKeyReused, w)
+
+		case !resp.done:
+			encodeError(r.Context(), errIdempotencyKeyInUse, w)
+
+		default:
+			for name, values := range resp.header {
+				w.Header()[name] = values
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			w.WriteHeader(resp.code)
+			w.Write(resp.body)
+		}
+	})
+}
+
+// recordingWriter keeps a copy of the response written to an http.ResponseWriter
+type recordingWriter struct {
+	http.ResponseWriter
+
+	wroteHeader bool
+	code        int
+	header      http.Header
+	body        bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.code = code
+	w.header = w.ResponseWriter.Header().Clone()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *recordingWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body.Write(p)
+	return w.ResponseWriter.Write(p)
+}
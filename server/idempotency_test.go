@@ -0,0 +1,105 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/log"
+)
+
+func serveWithIdempotencyKey(t *testing.T, handler http.Handler, key, path string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest("POST", path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set(IdempotencyKeyHeader, key)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+	return w
+}
+
+func TestIdempotencyKeys(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), ReplayIdempotentRequests(time.Hour))
+	contents := readTestdata(t, "ppd-debit.ach")
+
+	first := serveWithIdempotencyKey(t, handler, "create-1", "/files/create", contents)
+	if first.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", first.Code, first.Body.String())
+	}
+	retry := serveWithIdempotencyKey(t, handler, "create-1", "/files/create", contents)
+	if retry.Code != http.StatusOK || retry.Body.String() != first.Body.String() {
+		t.Errorf("replayed %d: %s", retry.Code, retry.Body.String())
+	}
+	if retry.Header().Get("Idempotent-Replayed") != "true" || retry.Header().Get("Content-Type") != first.Header().Get("Content-Type") {
+		t.Errorf("unexpected headers: %v", retry.Header())
+	}
+	if n := len(repo.FindAllFiles()); n != 1 {
+		t.Errorf("created %d files", n)
+	}
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(contents))
+	req.Header.Set("Content-Type", "text/plain")
+	req.Header.Set("X-Idempotency-Key", "create-1")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Body.String() != first.Body.String() {
+		t.Errorf("X-Idempotency-Key wasn't replayed: %s", w.Body.String())
+	}
+
+	// a new key creates another file
+	if w := serveWithIdempotencyKey(t, handler, "create-2", "/files/create", contents); w.Code != http.StatusOK || w.Body.String() == first.Body.String() {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if n := len(repo.FindAllFiles()); n != 2 {
+		t.Errorf("created %d files", n)
+	}
+
+	// keys can't be reused for other requests
+	if w := serveWithIdempotencyKey(t, handler, "create-1", "/files/create", readTestdata(t, "return-WEB.ach")); w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveWithIdempotencyKey(t, handler, strings.Repeat("a", 256), "/files/create", contents); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestIdempotencyKeys__inProgress(t *testing.T) {
+	keys := newIdempotencyKeys(time.Hour)
+	handler := keys.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// retry while the first request is being served
+		if w := serveWithIdempotencyKey(t, keys.middleware(http.NotFoundHandler()), "key", "/files/create", nil); w.Code != http.StatusConflict {
+			t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	if w := serveWithIdempotencyKey(t, handler, "key", "/files/create", nil); w.Code != http.StatusInternalServerError {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	// server errors aren't replayed
+	if resp := keys.begin("key", [32]byte{}); resp != nil {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+}
@@ -0,0 +1,191 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/iso20022"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+var (
+	errInvalidISO20022 = errors.New("invalid ISO 20022 message")
+)
+
+func addISO20022Routes(r *mux.Router, s Service, logger log.Logger, options []httptransport.ServerOption) {
+	r.Methods("GET").Path("/files/{fileID}/pain.001").Handler(httptransport.NewServer(
+		getISO20022Endpoint(s, "pain.001", iso20022.WritePain001, logger),
+		decodeGetISO20022Request,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("GET").Path("/files/{fileID}/camt.054").Handler(httptransport.NewServer(
+		getISO20022Endpoint(s, "camt.054", iso20022.WriteCamt054, logger),
+		decodeGetISO20022Request,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path("/files/import/iso20022").Handler(httptransport.NewServer(
+		importISO20022Endpoint(s, logger),
+		decodeImportISO20022Request,
+		encodeResponse,
+		options...,
+	))
+}
+
+func unmappedFields(report *iso20022.Report) []iso20022.Unmapped {
+	if report.Empty() {
+		return []iso20022.Unmapped{}
+	}
+	return report.Unmapped
+}
+
+type getISO20022Request struct {
+	fileID    string
+	requestID string
+}
+
+type getISO20022Response struct {
+	XML      string              `json:"xml"`
+	Unmapped []iso20022.Unmapped `json:"unmapped"`
+	Err      error               `json:"error"`
+}
+
+func (r getISO20022Response) error() error { return r.Err }
+
+// iso20022Writer converts an ach.File into an ISO 20022 message, such as iso20022.WritePain001.
+type iso20022Writer func(w io.Writer, file *ach.File) (*iso20022.Report, error)
+
+func getISO20022Endpoint(s Service, message string, write iso20022Writer, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getISO20022Request)
+		if !ok {
+			return getISO20022Response{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		file, err := s.GetFile(req.fileID)
+		if err != nil {
+			return getISO20022Response{Err: err}, nil
+		}
+
+		var buf bytes.Buffer
+		report, err := write(&buf, file)
+		if err != nil {
+			err = fmt.Errorf("%v: %v", errInvalidFile, err)
+		}
+		if logger != nil {
+			logger.Log("files", "getISO20022", "message", message, "fileID", req.fileID, "requestID", req.requestID, "error", err)
+		}
+		if err != nil {
+			return getISO20022Response{Err: err}, nil
+		}
+		return getISO20022Response{
+			XML:      buf.String(),
+			Unmapped: unmappedFields(report),
+		}, nil
+	}
+}
+
+func decodeGetISO20022Request(_ context.Context, r *http.Request) (interface{}, error) {
+	fileID, ok := mux.Vars(r)["fileID"]
+	if !ok {
+		return nil, ErrBadRouting
+	}
+	return getISO20022Request{
+		fileID:    fileID,
+		requestID: moovhttp.GetRequestID(r),
+	}, nil
+}
+
+type importISO20022Request struct {
+	file      *ach.File
+	report    *iso20022.Report
+	err       error
+	requestID string
+}
+
+type importISO20022Response struct {
+	ID       string              `json:"id"`
+	Unmapped []iso20022.Unmapped `json:"unmapped"`
+	Err      error               `json:"error"`
+}
+
+func (r importISO20022Response) error() error { return r.Err }
+
+func importISO20022Endpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(importISO20022Request)
+		if !ok {
+			return importISO20022Response{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		err := req.err
+		if err == nil {
+			req.file.ID = base.ID()
+			err = s.StoreFile(req.file)
+		}
+		if logger != nil {
+			logger.Log("files", "importISO20022", "requestID", req.requestID, "error", err)
+		}
+
+		resp := importISO20022Response{
+			Unmapped: unmappedFields(req.report),
+			Err:      err,
+		}
+		if err == nil {
+			resp.ID = req.file.ID
+		}
+		return resp, nil
+	}
+}
+
+// decodeImportISO20022Request reads a pain.001 or pacs.008 message from the request body. The
+// immediateOrigin and immediateDestination query parameters fill in FileHeader fields which
+// aren't part of the message.
+func decodeImportISO20022Request(_ context.Context, r *http.Request) (interface{}, error) {
+	q := r.URL.Query()
+	opts := &iso20022.Options{
+		ImmediateOrigin:          q.Get("immediateOrigin"),
+		ImmediateOriginName:      q.Get("immediateOriginName"),
+		ImmediateDestination:     q.Get("immediateDestination"),
+		ImmediateDestinationName: q.Get("immediateDestinationName"),
+	}
+	req := importISO20022Request{
+		requestID: moovhttp.GetRequestID(r),
+	}
+	req.file, req.report, req.err = iso20022.Read(r.Body, opts)
+	if req.err != nil {
+		req.err = fmt.Errorf("%v: %v", errInvalidISO20022, req.err)
+	}
+	return req, nil
+}
@@ -0,0 +1,132 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/iso20022"
+
+	"github.com/go-kit/kit/log"
+)
+
+func TestISO20022__routes(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger())
+
+	do := func(method, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("x-request-id", "test")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	var resp struct {
+		ID       string              `json:"id"`
+		XML      string              `json:"xml"`
+		Unmapped []iso20022.Unmapped `json:"unmapped"`
+		Error    string              `json:"error"`
+	}
+
+	// import a pain.001 message
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "pain001.xml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := do("POST", "/files/import/iso20022?immediateDestination=231380104", string(bs))
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.ID == "" || len(resp.Unmapped) == 0 {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+	file, err := repo.FindFile(resp.ID)
+	if err != nil || file == nil {
+		t.Fatalf("file not stored: %v", err)
+	}
+	if len(file.Batches) != 2 {
+		t.Errorf("got %d batches", len(file.Batches))
+	}
+
+	// convert the stored file back to pain.001
+	w = do("GET", "/files/"+resp.ID+"/pain.001", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	resp.XML, resp.Unmapped = "", nil
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(resp.XML, "<CstmrCdtTrfInitn>") || !strings.Contains(resp.XML, "<NbOfTxs>3</NbOfTxs>") {
+		t.Errorf("unexpected XML: %s", resp.XML)
+	}
+	if len(resp.Unmapped) == 0 {
+		t.Error("expected unmapped fields")
+	}
+
+	// returns are converted to camt.054
+	bs, err = ioutil.ReadFile(filepath.Join("..", "test", "testdata", "return-WEB.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	returns, err := ach.NewReader(bytes.NewReader(bs)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	returns.ID = "returns"
+	if err := repo.StoreFile(&returns); err != nil {
+		t.Fatal(err)
+	}
+	w = do("GET", "/files/returns/camt.054", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	resp.XML = ""
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(resp.XML, "<BkToCstmrDbtCdtNtfctn>") || !strings.Contains(resp.XML, "<Cd>AM04</Cd>") {
+		t.Errorf("unexpected XML: %s", resp.XML)
+	}
+	// the pain.001 file has no returns
+	if w := do("GET", "/files/"+resp.ID+"/camt.054", ""); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// invalid messages
+	if w := do("POST", "/files/import/iso20022", "<Document>"); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if w := do("GET", "/files/missing/pain.001", ""); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+}
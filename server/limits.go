@@ -0,0 +1,267 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/moov-io/ach/limits"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+var (
+	errInvalidLimit = errors.New("invalid limit")
+)
+
+func addLimitsRoutes(r *mux.Router, s Service, checker *limits.Checker, logger log.Logger, options []httptransport.ServerOption) {
+	r.Methods("GET").Path("/limits").Handler(httptransport.NewServer(
+		getLimitsEndpoint(checker, logger),
+		decodeGetLimitsRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("PUT").Path("/limits/{companyID}").Handler(httptransport.NewServer(
+		updateLimitEndpoint(checker, logger),
+		decodeUpdateLimitRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("DELETE").Path("/limits/{companyID}").Handler(httptransport.NewServer(
+		deleteLimitEndpoint(checker, logger),
+		decodeDeleteLimitRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path("/files/{fileID}/limits").Handler(httptransport.NewServer(
+		checkFileLimitsEndpoint(s, checker, logger),
+		decodeCheckFileLimitsRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+type getLimitsRequest struct {
+	requestID string
+}
+
+type getLimitsResponse struct {
+	Limits []*limits.Limit `json:"limits"`
+	Err    error           `json:"error"`
+}
+
+func (r getLimitsResponse) count() int { return len(r.Limits) }
+
+func (r getLimitsResponse) error() error { return r.Err }
+
+func getLimitsEndpoint(checker *limits.Checker, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getLimitsRequest)
+		if !ok {
+			return getLimitsResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		ls, err := checker.Store().ListLimits()
+		if logger != nil {
+			logger.Log("limits", "getLimits", "requestID", req.requestID, "error", err)
+		}
+		return getLimitsResponse{
+			Limits: ls,
+			Err:    err,
+		}, nil
+	}
+}
+
+func decodeGetLimitsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	return getLimitsRequest{
+		requestID: moovhttp.GetRequestID(r),
+	}, nil
+}
+
+type updateLimitRequest struct {
+	limit     *limits.Limit
+	requestID string
+}
+
+type updateLimitResponse struct {
+	Limit *limits.Limit `json:"limit"`
+	Err   error         `json:"error"`
+}
+
+func (r updateLimitResponse) error() error { return r.Err }
+
+func updateLimitEndpoint(checker *limits.Checker, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updateLimitRequest)
+		if !ok {
+			return updateLimitResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		err := req.limit.Validate()
+		if err != nil {
+			err = fmt.Errorf("%v: %v", errInvalidLimit, err)
+		} else {
+			err = checker.Store().SetLimit(req.limit)
+		}
+		if logger != nil {
+			logger.Log("limits", "updateLimit", "companyID", req.limit.CompanyIdentification, "requestID", req.requestID, "error", err)
+		}
+		if err != nil {
+			return updateLimitResponse{Err: err}, nil
+		}
+		return updateLimitResponse{
+			Limit: req.limit,
+		}, nil
+	}
+}
+
+func decodeUpdateLimitRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	companyID, ok := mux.Vars(r)["companyID"]
+	if !ok {
+		return nil, ErrBadRouting
+	}
+	req := updateLimitRequest{
+		requestID: moovhttp.GetRequestID(r),
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.limit); err != nil {
+		return nil, err
+	}
+	if req.limit == nil {
+		return nil, errors.New("no Limit provided")
+	}
+	req.limit.CompanyIdentification = companyID
+	return req, nil
+}
+
+type deleteLimitRequest struct {
+	companyID string
+	requestID string
+}
+
+type deleteLimitResponse struct {
+	Err error `json:"error"`
+}
+
+func (r deleteLimitResponse) error() error { return r.Err }
+
+func deleteLimitEndpoint(checker *limits.Checker, logger log.Logger) endpoint.Endpoint {
+	return func(_ context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(deleteLimitRequest)
+		if !ok {
+			return deleteLimitResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		err := checker.Store().DeleteLimit(req.companyID)
+		if logger != nil {
+			logger.Log("limits", "deleteLimit", "companyID", req.companyID, "requestID", req.requestID, "error", err)
+		}
+		return deleteLimitResponse{Err: err}, nil
+	}
+}
+
+func decodeDeleteLimitRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	companyID, ok := mux.Vars(r)["companyID"]
+	if !ok {
+		return nil, ErrBadRouting
+	}
+	return deleteLimitRequest{
+		companyID: companyID,
+		requestID: moovhttp.GetRequestID(r),
+	}, nil
+}
+
+type checkFileLimitsRequest struct {
+	fileID    string
+	release   bool
+	requestID string
+}
+
+type checkFileLimitsResponse struct {
+	// Violations are the batches which exceed an originator's limits
+	Violations []limits.Violation `json:"violations"`
+	// Released is true when usage from the file was recorded, which blocked violations prevent
+	Released bool  `json:"released"`
+	Err      error `json:"error"`
+}
+
+func (r checkFileLimitsResponse) count() int { return len(r.Violations) }
+
+func (r checkFileLimitsResponse) error() error { return r.Err }
+
+func checkFileLimitsEndpoint(s Service, checker *limits.Checker, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(checkFileLimitsRequest)
+		if !ok {
+			return checkFileLimitsResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		file, err := s.GetFile(req.fileID)
+		if err != nil {
+			return checkFileLimitsResponse{Err: err}, nil
+		}
+
+		var violations []limits.Violation
+		if req.release {
+			// files are released once by their ID, which is only unique with the tenant's prefix
+			if tenant := tenantOf(ctx); tenant != "" {
+				f := *file
+				f.ID = tenantPrefix(tenant) + file.ID
+				file = &f
+			}
+			violations, err = checker.Release(file)
+		} else {
+			violations, err = checker.Check(file)
+		}
+		if logger != nil {
+			logger.Log("limits", "checkFileLimits", "file", req.fileID, "violations", len(violations), "requestID", req.requestID, "error", err)
+		}
+		return checkFileLimitsResponse{
+			Violations: violations,
+			Released:   req.release && err == nil && !limits.Blocked(violations),
+			Err:        err,
+		}, nil
+	}
+}
+
+func decodeCheckFileLimitsRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	fileID, ok := mux.Vars(r)["fileID"]
+	if !ok {
+		return nil, ErrBadRouting
+	}
+	req := checkFileLimitsRequest{
+		fileID:    fileID,
+		requestID: moovhttp.GetRequestID(r),
+	}
+	if v := r.URL.Query().Get("release"); v != "" {
+		release, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, errors.New("invalid release parameter")
+		}
+		req.release = release
+	}
+	return req, nil
+}
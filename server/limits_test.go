@@ -0,0 +1,145 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/limits"
+
+	"github.com/go-kit/kit/log"
+)
+
+func TestLimits__routes(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+
+	checker := limits.NewChecker(limits.NewStoreInMemory())
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger(), CheckLimits(checker))
+
+	do := func(method, path string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("x-request-id", "test")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		w.Flush()
+		return w
+	}
+
+	// configure a limit
+	w := do("PUT", "/limits/121042882", `{"dailyDebit": 150000000}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if w := do("PUT", "/limits/121042882", `{"dailyDebit": -1}`); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	w = do("GET", "/limits", "")
+	if w.Code != http.StatusOK || w.Header().Get("X-Total-Count") != "1" {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// store a file
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := ach.NewReader(bytes.NewReader(bs)).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.ID = "limits"
+	if err := repo.StoreFile(&file); err != nil {
+		t.Fatal(err)
+	}
+
+	checkFile := func(path string) checkFileLimitsResponse {
+		t.Helper()
+		w := do("POST", path, "")
+		if w.Code != http.StatusOK {
+			t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+		}
+		var resp checkFileLimitsResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	if resp := checkFile("/files/limits/limits?release=true"); len(resp.Violations) != 0 || !resp.Released {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+	if resp := checkFile("/files/limits/limits"); len(resp.Violations) != 1 || resp.Released {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+	if w := do("POST", "/files/limits/limits?release=true", ""); w.Code != http.StatusConflict {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// blocked files are released once their limit is raised
+	blocked := file
+	blocked.ID = "blocked"
+	if err := repo.StoreFile(&blocked); err != nil {
+		t.Fatal(err)
+	}
+	if resp := checkFile("/files/blocked/limits?release=true"); len(resp.Violations) != 1 || resp.Released {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+	if w := do("PUT", "/limits/121042882", `{"dailyDebit": 200000000}`); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if resp := checkFile("/files/blocked/limits?release=true"); len(resp.Violations) != 0 || !resp.Released {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+
+	if w := do("POST", "/files/missing/limits", ""); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if w := do("POST", "/files/limits/limits?release=other", ""); w.Code == http.StatusOK {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+
+	// remove the limit
+	if w := do("DELETE", "/limits/121042882", ""); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if resp := checkFile("/files/limits/limits"); len(resp.Violations) != 0 {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+}
+
+func TestLimits__notConfigured(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	req := httptest.NewRequest("GET", "/limits", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code == http.StatusOK {
+		t.Errorf("bogus HTTP status code: %d", w.Code)
+	}
+}
@@ -0,0 +1,334 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+var (
+	errInvalidMerge  = errors.New("invalid merge")
+	errInvalidImport = errors.New("invalid import")
+)
+
+const (
+	// maxImportFileSize is the most bytes read of each imported file (after decompressing zip
+	// archives). NACHA files have at most 10,000 lines so even their JSON is well under this.
+	maxImportFileSize = 20 * 1024 * 1024
+
+	// maxImportSize is the most bytes an import reads, counting zip archives and every file in them
+	maxImportSize = 100 * 1024 * 1024
+)
+
+func addMergeRoutes(r *mux.Router, s Service, strict bool, logger log.Logger, options []httptransport.ServerOption) {
+	r.Methods("POST").Path("/files/merge").Handler(httptransport.NewServer(
+		mergeFilesEndpoint(s, logger),
+		decodeMergeFilesRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path("/files/import").Handler(httptransport.NewServer(
+		importFilesEndpoint(s, strict, logger),
+		decodeImportFilesRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+type mergeFilesRequest struct {
+	FileIDs    []string       `json:"fileIDs"`
+	Conditions ach.Conditions `json:"conditions"`
+
+	requestID string
+}
+
+type mergeFilesResponse struct {
+	FileIDs []string `json:"fileIDs"`
+	Err     error    `json:"error"`
+}
+
+func (r mergeFilesResponse) error() error { return r.Err }
+
+func mergeFilesEndpoint(s Service, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(mergeFilesRequest)
+		if !ok {
+			return mergeFilesResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		files, err := s.MergeFiles(req.FileIDs, req.Conditions)
+		if logger != nil {
+			logger.Log("files", "mergeFiles", "requestID", req.requestID, "error", err)
+		}
+		if err != nil {
+			return mergeFilesResponse{Err: err}, nil
+		}
+
+		resp := mergeFilesResponse{FileIDs: make([]string, 0, len(files))}
+		for i := range files {
+			if err := s.StoreFile(files[i]); err != nil {
+				if logger != nil {
+					logger.Log("files", "storeMergedFile", "requestID", req.requestID, "error", err)
+				}
+				resp.Err = err
+				return resp, nil
+			}
+			resp.FileIDs = append(resp.FileIDs, files[i].ID)
+		}
+		return resp, nil
+	}
+}
+
+func decodeMergeFilesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	var req mergeFilesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidMerge, err)
+	}
+	req.requestID = moovhttp.GetRequestID(r)
+	return req, nil
+}
+
+// importedFile is one file read from a multipart or zip upload to POST /files/import
+type importedFile struct {
+	name     string
+	contents []byte
+}
+
+type importFilesRequest struct {
+	files     []importedFile
+	requestID string
+}
+
+type importFileResult struct {
+	Name  string `json:"name"`
+	ID    string `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+type importFilesResponse struct {
+	Files []importFileResult `json:"files"`
+	Err   error              `json:"error"`
+}
+
+func (r importFilesResponse) count() int { return len(r.Files) }
+
+func (r importFilesResponse) error() error { return r.Err }
+
+// importFilesEndpoint stores each file which is read and not a duplicate. One file failing
+// doesn't stop the others from being stored, instead its error is included in the results.
+func importFilesEndpoint(s Service, strict bool, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(importFilesRequest)
+		if !ok {
+			return importFilesResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		s := forRequest(ctx, s)
+
+		resp := importFilesResponse{Files: make([]importFileResult, 0, len(req.files))}
+		for _, imported := range req.files {
+			result := importFileResult{Name: imported.name}
+
+			file, err := readImportedFile(imported.contents, strict)
+			if err == nil {
+				if file.ID == "" {
+					file.ID = base.ID()
+				}
+				setMissingIDs(file)
+				err = s.StoreFile(file)
+			}
+			if err != nil {
+				result.Error = err.Error()
+			} else {
+				result.ID = file.ID
+				filesCreated.With("destination", file.Header.ImmediateDestination, "origin", file.Header.ImmediateOrigin).Add(1)
+			}
+			if logger != nil {
+				logger.Log("files", "importFile", "name", imported.name, "requestID", req.requestID, "error", err)
+			}
+			resp.Files = append(resp.Files, result)
+		}
+		return resp, nil
+	}
+}
+
+// readImportedFile reads contents as an ACH file in JSON when it starts with '{' and
+// as NACHA formatted text otherwise.
+func readImportedFile(contents []byte, strict bool) (*ach.File, error) {
+	if bytes.HasPrefix(bytes.TrimSpace(contents), []byte("{")) {
+		if strict {
+			return ach.FileFromJSONStrict(contents)
+		}
+		return ach.FileFromJSON(contents)
+	}
+	f, err := ach.NewReader(bytes.NewReader(contents)).Read()
+	if err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// decodeImportFilesRequest reads every file from a 'multipart/form-data' request or an 'application/zip'
+// body. Zip archives uploaded as a part of the multipart request are expanded as well.
+func decodeImportFilesRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := importFilesRequest{
+		requestID: moovhttp.GetRequestID(r),
+	}
+	limit := &importLimit{remaining: maxImportSize}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch {
+	case mediaType == "multipart/form-data":
+		mr, err := r.MultipartReader()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", errInvalidImport, err)
+		}
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%v: %v", errInvalidImport, err)
+			}
+			name := part.FileName()
+			if name == "" {
+				name = part.FormName()
+			}
+			zipped := isZip(part.Header.Get("Content-Type"), name)
+			max := int64(maxImportFileSize)
+			if zipped {
+				max = maxImportSize
+			}
+			bs, err := limit.read(name, part, max)
+			if err != nil {
+				return nil, err
+			}
+			if zipped {
+				files, err := readZip(bs, limit)
+				if err != nil {
+					return nil, err
+				}
+				req.files = append(req.files, files...)
+			} else {
+				req.files = append(req.files, importedFile{name: name, contents: bs})
+			}
+		}
+
+	case isZip(mediaType, ""):
+		bs, err := limit.read("request body", r.Body, maxImportSize)
+		if err != nil {
+			return nil, err
+		}
+		if req.files, err = readZip(bs, limit); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("%v: unsupported Content-Type %q", errInvalidImport, mediaType)
+	}
+
+	if len(req.files) == 0 {
+		return nil, fmt.Errorf("%v: no files found", errInvalidImport)
+	}
+	return req, nil
+}
+
+func isZip(contentType string, name string) bool {
+	switch strings.ToLower(contentType) {
+	case "application/zip", "application/x-zip-compressed":
+		return true
+	}
+	return strings.EqualFold(path.Ext(name), ".zip")
+}
+
+// importLimit is the number of bytes an import has left to read, see maxImportSize
+type importLimit struct {
+	remaining int64
+}
+
+// read returns the contents of r unless they're larger than max or the bytes remaining
+func (l *importLimit) read(name string, r io.Reader, max int64) ([]byte, error) {
+	if max > l.remaining {
+		max = l.remaining
+	}
+	bs, err := ioutil.ReadAll(io.LimitReader(r, max+1))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %s: %v", errInvalidImport, name, err)
+	}
+	if int64(len(bs)) > max {
+		return nil, l.tooLarge(name)
+	}
+	l.remaining -= int64(len(bs))
+	return bs, nil
+}
+
+func (l *importLimit) tooLarge(name string) error {
+	return fmt.Errorf("%v: %s is too large, files can be %d bytes and imports %d bytes", errInvalidImport, name, maxImportFileSize, maxImportSize)
+}
+
+// readZip returns each file of a zip archive, skipping directories
+func readZip(bs []byte, limit *importLimit) ([]importedFile, error) {
+	zr, err := zip.NewReader(bytes.NewReader(bs), int64(len(bs)))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidImport, err)
+	}
+	var files []importedFile
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+		if zf.UncompressedSize64 > maxImportFileSize || zf.UncompressedSize64 > uint64(limit.remaining) {
+			return nil, limit.tooLarge(zf.Name)
+		}
+		fd, err := zf.Open()
+		if err != nil {
+			return nil, fmt.Errorf("%v: %s: %v", errInvalidImport, zf.Name, err)
+		}
+		// the archive's sizes can be wrong, so still limit what's read
+		contents, err := limit.read(zf.Name, fd, maxImportFileSize)
+		fd.Close()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, importedFile{name: zf.Name, contents: contents})
+	}
+	return files, nil
+}
@@ -0,0 +1,286 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"encoding/json"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+
+	"github.com/go-kit/kit/log"
+)
+
+func readTestdata(t *testing.T, name string) []byte {
+	t.Helper()
+
+	bs, err := ioutil.ReadFile(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
+
+func TestFiles__mergeFiles(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	svc := NewService(repo)
+	handler := MakeHTTPHandler(svc, repo, log.NewNopLogger())
+
+	f1, err := ach.NewReader(bytes.NewReader(readTestdata(t, "ppd-debit.ach"))).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f1.ID = "f1"
+	f2, err := ach.NewReader(bytes.NewReader(readTestdata(t, "web-debit.ach"))).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f2.ID = "f2"
+	f2.Header = f1.Header // merge into one file
+	if err := repo.StoreFile(&f1); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.StoreFile(&f2); err != nil {
+		t.Fatal(err)
+	}
+
+	merge := func(req mergeFilesRequest) mergeFilesResponse {
+		w := serveJSON(t, handler, "POST", "/files/merge", req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+		}
+		var resp mergeFilesResponse
+		if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	resp := merge(mergeFilesRequest{FileIDs: []string{"f1", "f2"}})
+	if len(resp.FileIDs) != 1 {
+		t.Fatalf("fileIDs=%v", resp.FileIDs)
+	}
+	merged, err := svc.GetFile(resp.FileIDs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(merged.Batches) != 4 || merged.ID == "f1" {
+		t.Errorf("merged file %s has %d batches", merged.ID, len(merged.Batches))
+	}
+	if err := merged.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	// the original files are unchanged
+	if f, _ := svc.GetFile("f1"); len(f.Batches) != 1 {
+		t.Errorf("f1 has %d batches", len(f.Batches))
+	}
+
+	// keep the merged files small
+	resp = merge(mergeFilesRequest{FileIDs: []string{"f1", "f2"}, Conditions: ach.Conditions{MaxLines: 10}})
+	if len(resp.FileIDs) < 2 {
+		t.Errorf("fileIDs=%v", resp.FileIDs)
+	}
+
+	// errors
+	if w := serveJSON(t, handler, "POST", "/files/merge", mergeFilesRequest{FileIDs: []string{"f1", "missing"}}); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveJSON(t, handler, "POST", "/files/merge", mergeFilesRequest{}); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveJSON(t, handler, "POST", "/files/merge", "not an object"); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+}
+
+func TestFiles__importFiles(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	for name, contents := range map[string][]byte{
+		"nested/web-debit.ach": readTestdata(t, "web-debit.ach"),
+		"invalid.ach":          []byte("invalid"),
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(contents)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for name, contents := range map[string][]byte{
+		"ppd-debit.ach":  readTestdata(t, "ppd-debit.ach"),
+		"ppd-valid.json": readTestdata(t, "ppd-valid.json"),
+		"more.zip":       archive.Bytes(),
+	} {
+		w, err := mw.CreateFormFile("files", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(contents)
+	}
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest("POST", "/files/import", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	var resp importFilesResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Files) != 4 || w.Header().Get("X-Total-Count") != "4" {
+		t.Fatalf("unexpected results: %#v", resp.Files)
+	}
+	for _, result := range resp.Files {
+		switch result.Name {
+		case "invalid.ach":
+			if result.ID != "" || result.Error == "" {
+				t.Errorf("expected error: %#v", result)
+			}
+		default:
+			if result.ID == "" || result.Error != "" {
+				t.Errorf("unexpected error: %#v", result)
+			}
+			if _, err := repo.FindFile(result.ID); err != nil {
+				t.Errorf("%s: %v", result.Name, err)
+			}
+		}
+	}
+	if n := len(repo.FindAllFiles()); n != 3 {
+		t.Errorf("found %d files", n)
+	}
+
+	// upload the zip archive by itself
+	req = httptest.NewRequest("POST", "/files/import", bytes.NewReader(archive.Bytes()))
+	req.Header.Set("Content-Type", "application/zip")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || w.Header().Get("X-Total-Count") != "2" {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// errors
+	for contentType, body := range map[string]string{
+		"text/plain":      "invalid",
+		"application/zip": "not a zip",
+	} {
+		req = httptest.NewRequest("POST", "/files/import", bytes.NewReader([]byte(body)))
+		req.Header.Set("Content-Type", contentType)
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: bogus HTTP status: %d: %s", contentType, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestFiles__importFilesTooLarge(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+	zeros := make([]byte, maxImportFileSize+1)
+
+	// a file larger than the limit
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create("large.ach")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(zeros)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	large := archive.Bytes()
+
+	// a file whose header understates its size, which archive/zip refuses to read past
+	var compressed bytes.Buffer
+	fw, _ := flate.NewWriter(&compressed, flate.BestSpeed)
+	fw.Write(zeros)
+	fw.Close()
+	archive = bytes.Buffer{}
+	zw = zip.NewWriter(&archive)
+	w, err = zw.CreateRaw(&zip.FileHeader{
+		Name:               "bomb.ach",
+		Method:             zip.Deflate,
+		CompressedSize64:   uint64(compressed.Len()),
+		UncompressedSize64: 100,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write(compressed.Bytes())
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	bomb := archive.Bytes()
+
+	for name, body := range map[string][]byte{"large": large, "bomb": bomb} {
+		req := httptest.NewRequest("POST", "/files/import", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/zip")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: bogus HTTP status: %d: %s", name, w.Code, w.Body.String())
+		}
+	}
+
+	// a part larger than the limit
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	pw, err := mw.CreateFormFile("files", "large.ach")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pw.Write(zeros)
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", "/files/import", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest || !strings.Contains(rec.Body.String(), "too large") {
+		t.Errorf("bogus HTTP status: %d: %s", rec.Code, rec.Body.String())
+	}
+	if n := len(repo.FindAllFiles()); n != 0 {
+		t.Errorf("found %d files", n)
+	}
+}
@@ -0,0 +1,5874 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        (unknown)
+// source: ach.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type CreateFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*CreateFileRequest_File
+	//	*CreateFileRequest_Contents
+	Source isCreateFileRequest_Source `protobuf_oneof:"source"`
+}
+
+func (x *CreateFileRequest) Reset() {
+	*x = CreateFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileRequest) ProtoMessage() {}
+
+func (x *CreateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileRequest.ProtoReflect.Descriptor instead.
+func (*CreateFileRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *CreateFileRequest) GetSource() isCreateFileRequest_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *CreateFileRequest) GetFile() *File {
+	if x, ok := x.GetSource().(*CreateFileRequest_File); ok {
+		return x.File
+	}
+	return nil
+}
+
+func (x *CreateFileRequest) GetContents() []byte {
+	if x, ok := x.GetSource().(*CreateFileRequest_Contents); ok {
+		return x.Contents
+	}
+	return nil
+}
+
+type isCreateFileRequest_Source interface {
+	isCreateFileRequest_Source()
+}
+
+type CreateFileRequest_File struct {
+	File *File `protobuf:"bytes,1,opt,name=file,proto3,oneof"`
+}
+
+type CreateFileRequest_Contents struct {
+	// contents is a NACHA formatted file
+	Contents []byte `protobuf:"bytes,2,opt,name=contents,proto3,oneof"`
+}
+
+func (*CreateFileRequest_File) isCreateFileRequest_Source() {}
+
+func (*CreateFileRequest_Contents) isCreateFileRequest_Source() {}
+
+type CreateFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateFileResponse) Reset() {
+	*x = CreateFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileResponse) ProtoMessage() {}
+
+func (x *CreateFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileResponse.ProtoReflect.Descriptor instead.
+func (*CreateFileResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateFileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// mask redacts sensitive fields with ach.DefaultMaskingPolicy
+	Mask bool `protobuf:"varint,2,opt,name=mask,proto3" json:"mask,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetMask() bool {
+	if x != nil {
+		return x.Mask
+	}
+	return false
+}
+
+type ListFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFilesRequest) Reset() {
+	*x = ListFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesRequest) ProtoMessage() {}
+
+func (x *ListFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesRequest.ProtoReflect.Descriptor instead.
+func (*ListFilesRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{3}
+}
+
+type ListFilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Files []*File `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+}
+
+func (x *ListFilesResponse) Reset() {
+	*x = ListFilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFilesResponse) ProtoMessage() {}
+
+func (x *ListFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFilesResponse.ProtoReflect.Descriptor instead.
+func (*ListFilesResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListFilesResponse) GetFiles() []*File {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type DeleteFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteFileRequest) Reset() {
+	*x = DeleteFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFileRequest) ProtoMessage() {}
+
+func (x *DeleteFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteFileResponse) Reset() {
+	*x = DeleteFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFileResponse) ProtoMessage() {}
+
+func (x *DeleteFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFileResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{6}
+}
+
+type GetFileContentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetFileContentsRequest) Reset() {
+	*x = GetFileContentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileContentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileContentsRequest) ProtoMessage() {}
+
+func (x *GetFileContentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileContentsRequest.ProtoReflect.Descriptor instead.
+func (*GetFileContentsRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetFileContentsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type FileContents struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contents []byte `protobuf:"bytes,1,opt,name=contents,proto3" json:"contents,omitempty"`
+}
+
+func (x *FileContents) Reset() {
+	*x = FileContents{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileContents) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileContents) ProtoMessage() {}
+
+func (x *FileContents) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileContents.ProtoReflect.Descriptor instead.
+func (*FileContents) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *FileContents) GetContents() []byte {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+type ValidateFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Opts *ValidateOpts `protobuf:"bytes,2,opt,name=opts,proto3" json:"opts,omitempty"`
+}
+
+func (x *ValidateFileRequest) Reset() {
+	*x = ValidateFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFileRequest) ProtoMessage() {}
+
+func (x *ValidateFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFileRequest.ProtoReflect.Descriptor instead.
+func (*ValidateFileRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateFileRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ValidateFileRequest) GetOpts() *ValidateOpts {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+type ValidateFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ValidateFileResponse) Reset() {
+	*x = ValidateFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateFileResponse) ProtoMessage() {}
+
+func (x *ValidateFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateFileResponse.ProtoReflect.Descriptor instead.
+func (*ValidateFileResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{10}
+}
+
+type BalanceFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string  `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Offset *Offset `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *BalanceFileRequest) Reset() {
+	*x = BalanceFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceFileRequest) ProtoMessage() {}
+
+func (x *BalanceFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceFileRequest.ProtoReflect.Descriptor instead.
+func (*BalanceFileRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BalanceFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *BalanceFileRequest) GetOffset() *Offset {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+type BalanceFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BalanceFileResponse) Reset() {
+	*x = BalanceFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceFileResponse) ProtoMessage() {}
+
+func (x *BalanceFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceFileResponse.ProtoReflect.Descriptor instead.
+func (*BalanceFileResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BalanceFileResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SegmentFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *SegmentFileRequest) Reset() {
+	*x = SegmentFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentFileRequest) ProtoMessage() {}
+
+func (x *SegmentFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentFileRequest.ProtoReflect.Descriptor instead.
+func (*SegmentFileRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SegmentFileRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type SegmentFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CreditFileId string `protobuf:"bytes,1,opt,name=credit_file_id,json=creditFileId,proto3" json:"credit_file_id,omitempty"`
+	DebitFileId  string `protobuf:"bytes,2,opt,name=debit_file_id,json=debitFileId,proto3" json:"debit_file_id,omitempty"`
+}
+
+func (x *SegmentFileResponse) Reset() {
+	*x = SegmentFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentFileResponse) ProtoMessage() {}
+
+func (x *SegmentFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentFileResponse.ProtoReflect.Descriptor instead.
+func (*SegmentFileResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SegmentFileResponse) GetCreditFileId() string {
+	if x != nil {
+		return x.CreditFileId
+	}
+	return ""
+}
+
+func (x *SegmentFileResponse) GetDebitFileId() string {
+	if x != nil {
+		return x.DebitFileId
+	}
+	return ""
+}
+
+type FlattenBatchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *FlattenBatchesRequest) Reset() {
+	*x = FlattenBatchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlattenBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlattenBatchesRequest) ProtoMessage() {}
+
+func (x *FlattenBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlattenBatchesRequest.ProtoReflect.Descriptor instead.
+func (*FlattenBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FlattenBatchesRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type FlattenBatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FlattenBatchesResponse) Reset() {
+	*x = FlattenBatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlattenBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlattenBatchesResponse) ProtoMessage() {}
+
+func (x *FlattenBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlattenBatchesResponse.ProtoReflect.Descriptor instead.
+func (*FlattenBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FlattenBatchesResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Batch  *Batch `protobuf:"bytes,2,opt,name=batch,proto3" json:"batch,omitempty"`
+}
+
+func (x *CreateBatchRequest) Reset() {
+	*x = CreateBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchRequest) ProtoMessage() {}
+
+func (x *CreateBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateBatchRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateBatchRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *CreateBatchRequest) GetBatch() *Batch {
+	if x != nil {
+		return x.Batch
+	}
+	return nil
+}
+
+type CreateBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CreateBatchResponse) Reset() {
+	*x = CreateBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBatchResponse) ProtoMessage() {}
+
+func (x *CreateBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreateBatchResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateBatchResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId  string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	BatchId string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *GetBatchRequest) Reset() {
+	*x = GetBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBatchRequest) ProtoMessage() {}
+
+func (x *GetBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetBatchRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetBatchRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *GetBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type ListBatchesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+}
+
+func (x *ListBatchesRequest) Reset() {
+	*x = ListBatchesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchesRequest) ProtoMessage() {}
+
+func (x *ListBatchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchesRequest.ProtoReflect.Descriptor instead.
+func (*ListBatchesRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListBatchesRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+type ListBatchesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Batches []*Batch `protobuf:"bytes,1,rep,name=batches,proto3" json:"batches,omitempty"`
+}
+
+func (x *ListBatchesResponse) Reset() {
+	*x = ListBatchesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBatchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBatchesResponse) ProtoMessage() {}
+
+func (x *ListBatchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBatchesResponse.ProtoReflect.Descriptor instead.
+func (*ListBatchesResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListBatchesResponse) GetBatches() []*Batch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+type DeleteBatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FileId  string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	BatchId string `protobuf:"bytes,2,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"`
+}
+
+func (x *DeleteBatchRequest) Reset() {
+	*x = DeleteBatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchRequest) ProtoMessage() {}
+
+func (x *DeleteBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBatchRequest) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteBatchRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *DeleteBatchRequest) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+type DeleteBatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteBatchResponse) Reset() {
+	*x = DeleteBatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBatchResponse) ProtoMessage() {}
+
+func (x *DeleteBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBatchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBatchResponse) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{23}
+}
+
+// File mirrors ach.File. Fields use the same JSON names as ach.File so messages
+// can be converted with protojson.
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Header               *FileHeader     `protobuf:"bytes,2,opt,name=header,json=fileHeader,proto3" json:"header,omitempty"`
+	Batches              []*Batch        `protobuf:"bytes,3,rep,name=batches,proto3" json:"batches,omitempty"`
+	IatBatches           []*IATBatch     `protobuf:"bytes,4,rep,name=iat_batches,json=IATBatches,proto3" json:"iat_batches,omitempty"`
+	Control              *FileControl    `protobuf:"bytes,5,opt,name=control,json=fileControl,proto3" json:"control,omitempty"`
+	AdvControl           *ADVFileControl `protobuf:"bytes,6,opt,name=adv_control,json=fileADVControl,proto3" json:"adv_control,omitempty"`
+	NotificationOfChange []*Batch        `protobuf:"bytes,7,rep,name=notification_of_change,json=NotificationOfChange,proto3" json:"notification_of_change,omitempty"`
+	ReturnEntries        []*Batch        `protobuf:"bytes,8,rep,name=return_entries,json=ReturnEntries,proto3" json:"return_entries,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *File) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *File) GetHeader() *FileHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *File) GetBatches() []*Batch {
+	if x != nil {
+		return x.Batches
+	}
+	return nil
+}
+
+func (x *File) GetIatBatches() []*IATBatch {
+	if x != nil {
+		return x.IatBatches
+	}
+	return nil
+}
+
+func (x *File) GetControl() *FileControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+func (x *File) GetAdvControl() *ADVFileControl {
+	if x != nil {
+		return x.AdvControl
+	}
+	return nil
+}
+
+func (x *File) GetNotificationOfChange() []*Batch {
+	if x != nil {
+		return x.NotificationOfChange
+	}
+	return nil
+}
+
+func (x *File) GetReturnEntries() []*Batch {
+	if x != nil {
+		return x.ReturnEntries
+	}
+	return nil
+}
+
+type FileHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ImmediateDestination     string `protobuf:"bytes,2,opt,name=immediate_destination,json=immediateDestination,proto3" json:"immediate_destination,omitempty"`
+	ImmediateOrigin          string `protobuf:"bytes,3,opt,name=immediate_origin,json=immediateOrigin,proto3" json:"immediate_origin,omitempty"`
+	FileCreationDate         string `protobuf:"bytes,4,opt,name=file_creation_date,json=fileCreationDate,proto3" json:"file_creation_date,omitempty"`
+	FileCreationTime         string `protobuf:"bytes,5,opt,name=file_creation_time,json=fileCreationTime,proto3" json:"file_creation_time,omitempty"`
+	FileIdModifier           string `protobuf:"bytes,6,opt,name=file_id_modifier,json=fileIDModifier,proto3" json:"file_id_modifier,omitempty"`
+	ImmediateDestinationName string `protobuf:"bytes,7,opt,name=immediate_destination_name,json=immediateDestinationName,proto3" json:"immediate_destination_name,omitempty"`
+	ImmediateOriginName      string `protobuf:"bytes,8,opt,name=immediate_origin_name,json=immediateOriginName,proto3" json:"immediate_origin_name,omitempty"`
+	ReferenceCode            string `protobuf:"bytes,9,opt,name=reference_code,json=referenceCode,proto3" json:"reference_code,omitempty"`
+}
+
+func (x *FileHeader) Reset() {
+	*x = FileHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileHeader) ProtoMessage() {}
+
+func (x *FileHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileHeader.ProtoReflect.Descriptor instead.
+func (*FileHeader) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FileHeader) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FileHeader) GetImmediateDestination() string {
+	if x != nil {
+		return x.ImmediateDestination
+	}
+	return ""
+}
+
+func (x *FileHeader) GetImmediateOrigin() string {
+	if x != nil {
+		return x.ImmediateOrigin
+	}
+	return ""
+}
+
+func (x *FileHeader) GetFileCreationDate() string {
+	if x != nil {
+		return x.FileCreationDate
+	}
+	return ""
+}
+
+func (x *FileHeader) GetFileCreationTime() string {
+	if x != nil {
+		return x.FileCreationTime
+	}
+	return ""
+}
+
+func (x *FileHeader) GetFileIdModifier() string {
+	if x != nil {
+		return x.FileIdModifier
+	}
+	return ""
+}
+
+func (x *FileHeader) GetImmediateDestinationName() string {
+	if x != nil {
+		return x.ImmediateDestinationName
+	}
+	return ""
+}
+
+func (x *FileHeader) GetImmediateOriginName() string {
+	if x != nil {
+		return x.ImmediateOriginName
+	}
+	return ""
+}
+
+func (x *FileHeader) GetReferenceCode() string {
+	if x != nil {
+		return x.ReferenceCode
+	}
+	return ""
+}
+
+type FileControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BatchCount                         int64  `protobuf:"varint,2,opt,name=batch_count,json=batchCount,proto3" json:"batch_count,omitempty"`
+	BlockCount                         int64  `protobuf:"varint,3,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	EntryAddendaCount                  int64  `protobuf:"varint,4,opt,name=entry_addenda_count,json=entryAddendaCount,proto3" json:"entry_addenda_count,omitempty"`
+	EntryHash                          int64  `protobuf:"varint,5,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+	TotalDebitEntryDollarAmountInFile  int64  `protobuf:"varint,6,opt,name=total_debit_entry_dollar_amount_in_file,json=totalDebit,proto3" json:"total_debit_entry_dollar_amount_in_file,omitempty"`
+	TotalCreditEntryDollarAmountInFile int64  `protobuf:"varint,7,opt,name=total_credit_entry_dollar_amount_in_file,json=totalCredit,proto3" json:"total_credit_entry_dollar_amount_in_file,omitempty"`
+}
+
+func (x *FileControl) Reset() {
+	*x = FileControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileControl) ProtoMessage() {}
+
+func (x *FileControl) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileControl.ProtoReflect.Descriptor instead.
+func (*FileControl) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FileControl) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FileControl) GetBatchCount() int64 {
+	if x != nil {
+		return x.BatchCount
+	}
+	return 0
+}
+
+func (x *FileControl) GetBlockCount() int64 {
+	if x != nil {
+		return x.BlockCount
+	}
+	return 0
+}
+
+func (x *FileControl) GetEntryAddendaCount() int64 {
+	if x != nil {
+		return x.EntryAddendaCount
+	}
+	return 0
+}
+
+func (x *FileControl) GetEntryHash() int64 {
+	if x != nil {
+		return x.EntryHash
+	}
+	return 0
+}
+
+func (x *FileControl) GetTotalDebitEntryDollarAmountInFile() int64 {
+	if x != nil {
+		return x.TotalDebitEntryDollarAmountInFile
+	}
+	return 0
+}
+
+func (x *FileControl) GetTotalCreditEntryDollarAmountInFile() int64 {
+	if x != nil {
+		return x.TotalCreditEntryDollarAmountInFile
+	}
+	return 0
+}
+
+type ADVFileControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BatchCount                         int64  `protobuf:"varint,2,opt,name=batch_count,json=batchCount,proto3" json:"batch_count,omitempty"`
+	BlockCount                         int64  `protobuf:"varint,3,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	EntryAddendaCount                  int64  `protobuf:"varint,4,opt,name=entry_addenda_count,json=entryAddendaCount,proto3" json:"entry_addenda_count,omitempty"`
+	EntryHash                          int64  `protobuf:"varint,5,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+	TotalDebitEntryDollarAmountInFile  int64  `protobuf:"varint,6,opt,name=total_debit_entry_dollar_amount_in_file,json=totalDebit,proto3" json:"total_debit_entry_dollar_amount_in_file,omitempty"`
+	TotalCreditEntryDollarAmountInFile int64  `protobuf:"varint,7,opt,name=total_credit_entry_dollar_amount_in_file,json=totalCredit,proto3" json:"total_credit_entry_dollar_amount_in_file,omitempty"`
+}
+
+func (x *ADVFileControl) Reset() {
+	*x = ADVFileControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ADVFileControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ADVFileControl) ProtoMessage() {}
+
+func (x *ADVFileControl) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ADVFileControl.ProtoReflect.Descriptor instead.
+func (*ADVFileControl) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ADVFileControl) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ADVFileControl) GetBatchCount() int64 {
+	if x != nil {
+		return x.BatchCount
+	}
+	return 0
+}
+
+func (x *ADVFileControl) GetBlockCount() int64 {
+	if x != nil {
+		return x.BlockCount
+	}
+	return 0
+}
+
+func (x *ADVFileControl) GetEntryAddendaCount() int64 {
+	if x != nil {
+		return x.EntryAddendaCount
+	}
+	return 0
+}
+
+func (x *ADVFileControl) GetEntryHash() int64 {
+	if x != nil {
+		return x.EntryHash
+	}
+	return 0
+}
+
+func (x *ADVFileControl) GetTotalDebitEntryDollarAmountInFile() int64 {
+	if x != nil {
+		return x.TotalDebitEntryDollarAmountInFile
+	}
+	return 0
+}
+
+func (x *ADVFileControl) GetTotalCreditEntryDollarAmountInFile() int64 {
+	if x != nil {
+		return x.TotalCreditEntryDollarAmountInFile
+	}
+	return 0
+}
+
+// Batch mirrors ach.Batch which is used for every SEC code other than IAT.
+type Batch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header     *BatchHeader      `protobuf:"bytes,1,opt,name=header,json=batchHeader,proto3" json:"header,omitempty"`
+	Entries    []*EntryDetail    `protobuf:"bytes,2,rep,name=entries,json=entryDetails,proto3" json:"entries,omitempty"`
+	Control    *BatchControl     `protobuf:"bytes,3,opt,name=control,json=batchControl,proto3" json:"control,omitempty"`
+	AdvEntries []*ADVEntryDetail `protobuf:"bytes,4,rep,name=adv_entries,json=advEntryDetails,proto3" json:"adv_entries,omitempty"`
+	AdvControl *ADVBatchControl  `protobuf:"bytes,5,opt,name=adv_control,json=advBatchControl,proto3" json:"adv_control,omitempty"`
+	Offset     *Offset           `protobuf:"bytes,6,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *Batch) Reset() {
+	*x = Batch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Batch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Batch) ProtoMessage() {}
+
+func (x *Batch) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Batch.ProtoReflect.Descriptor instead.
+func (*Batch) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Batch) GetHeader() *BatchHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Batch) GetEntries() []*EntryDetail {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *Batch) GetControl() *BatchControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+func (x *Batch) GetAdvEntries() []*ADVEntryDetail {
+	if x != nil {
+		return x.AdvEntries
+	}
+	return nil
+}
+
+func (x *Batch) GetAdvControl() *ADVBatchControl {
+	if x != nil {
+		return x.AdvControl
+	}
+	return nil
+}
+
+func (x *Batch) GetOffset() *Offset {
+	if x != nil {
+		return x.Offset
+	}
+	return nil
+}
+
+type BatchHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceClassCode         int64  `protobuf:"varint,2,opt,name=service_class_code,json=serviceClassCode,proto3" json:"service_class_code,omitempty"`
+	CompanyName              string `protobuf:"bytes,3,opt,name=company_name,json=companyName,proto3" json:"company_name,omitempty"`
+	CompanyDiscretionaryData string `protobuf:"bytes,4,opt,name=company_discretionary_data,json=companyDiscretionaryData,proto3" json:"company_discretionary_data,omitempty"`
+	CompanyIdentification    string `protobuf:"bytes,5,opt,name=company_identification,json=companyIdentification,proto3" json:"company_identification,omitempty"`
+	StandardEntryClassCode   string `protobuf:"bytes,6,opt,name=standard_entry_class_code,json=standardEntryClassCode,proto3" json:"standard_entry_class_code,omitempty"`
+	CompanyEntryDescription  string `protobuf:"bytes,7,opt,name=company_entry_description,json=companyEntryDescription,proto3" json:"company_entry_description,omitempty"`
+	CompanyDescriptiveDate   string `protobuf:"bytes,8,opt,name=company_descriptive_date,json=companyDescriptiveDate,proto3" json:"company_descriptive_date,omitempty"`
+	EffectiveEntryDate       string `protobuf:"bytes,9,opt,name=effective_entry_date,json=effectiveEntryDate,proto3" json:"effective_entry_date,omitempty"`
+	OriginatorStatusCode     int64  `protobuf:"varint,10,opt,name=originator_status_code,json=originatorStatusCode,proto3" json:"originator_status_code,omitempty"`
+	OdfiIdentification       string `protobuf:"bytes,11,opt,name=odfi_identification,json=ODFIIdentification,proto3" json:"odfi_identification,omitempty"`
+	BatchNumber              int64  `protobuf:"varint,12,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+}
+
+func (x *BatchHeader) Reset() {
+	*x = BatchHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchHeader) ProtoMessage() {}
+
+func (x *BatchHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchHeader.ProtoReflect.Descriptor instead.
+func (*BatchHeader) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchHeader) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetServiceClassCode() int64 {
+	if x != nil {
+		return x.ServiceClassCode
+	}
+	return 0
+}
+
+func (x *BatchHeader) GetCompanyName() string {
+	if x != nil {
+		return x.CompanyName
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetCompanyDiscretionaryData() string {
+	if x != nil {
+		return x.CompanyDiscretionaryData
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetCompanyIdentification() string {
+	if x != nil {
+		return x.CompanyIdentification
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetStandardEntryClassCode() string {
+	if x != nil {
+		return x.StandardEntryClassCode
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetCompanyEntryDescription() string {
+	if x != nil {
+		return x.CompanyEntryDescription
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetCompanyDescriptiveDate() string {
+	if x != nil {
+		return x.CompanyDescriptiveDate
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetEffectiveEntryDate() string {
+	if x != nil {
+		return x.EffectiveEntryDate
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetOriginatorStatusCode() int64 {
+	if x != nil {
+		return x.OriginatorStatusCode
+	}
+	return 0
+}
+
+func (x *BatchHeader) GetOdfiIdentification() string {
+	if x != nil {
+		return x.OdfiIdentification
+	}
+	return ""
+}
+
+func (x *BatchHeader) GetBatchNumber() int64 {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return 0
+}
+
+type BatchControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceClassCode             int64  `protobuf:"varint,2,opt,name=service_class_code,json=serviceClassCode,proto3" json:"service_class_code,omitempty"`
+	EntryAddendaCount            int64  `protobuf:"varint,3,opt,name=entry_addenda_count,json=entryAddendaCount,proto3" json:"entry_addenda_count,omitempty"`
+	EntryHash                    int64  `protobuf:"varint,4,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+	TotalDebitEntryDollarAmount  int64  `protobuf:"varint,5,opt,name=total_debit_entry_dollar_amount,json=totalDebit,proto3" json:"total_debit_entry_dollar_amount,omitempty"`
+	TotalCreditEntryDollarAmount int64  `protobuf:"varint,6,opt,name=total_credit_entry_dollar_amount,json=totalCredit,proto3" json:"total_credit_entry_dollar_amount,omitempty"`
+	CompanyIdentification        string `protobuf:"bytes,7,opt,name=company_identification,json=companyIdentification,proto3" json:"company_identification,omitempty"`
+	MessageAuthenticationCode    string `protobuf:"bytes,8,opt,name=message_authentication_code,json=messageAuthentication,proto3" json:"message_authentication_code,omitempty"`
+	OdfiIdentification           string `protobuf:"bytes,9,opt,name=odfi_identification,json=ODFIIdentification,proto3" json:"odfi_identification,omitempty"`
+	BatchNumber                  int64  `protobuf:"varint,10,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+}
+
+func (x *BatchControl) Reset() {
+	*x = BatchControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchControl) ProtoMessage() {}
+
+func (x *BatchControl) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchControl.ProtoReflect.Descriptor instead.
+func (*BatchControl) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchControl) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BatchControl) GetServiceClassCode() int64 {
+	if x != nil {
+		return x.ServiceClassCode
+	}
+	return 0
+}
+
+func (x *BatchControl) GetEntryAddendaCount() int64 {
+	if x != nil {
+		return x.EntryAddendaCount
+	}
+	return 0
+}
+
+func (x *BatchControl) GetEntryHash() int64 {
+	if x != nil {
+		return x.EntryHash
+	}
+	return 0
+}
+
+func (x *BatchControl) GetTotalDebitEntryDollarAmount() int64 {
+	if x != nil {
+		return x.TotalDebitEntryDollarAmount
+	}
+	return 0
+}
+
+func (x *BatchControl) GetTotalCreditEntryDollarAmount() int64 {
+	if x != nil {
+		return x.TotalCreditEntryDollarAmount
+	}
+	return 0
+}
+
+func (x *BatchControl) GetCompanyIdentification() string {
+	if x != nil {
+		return x.CompanyIdentification
+	}
+	return ""
+}
+
+func (x *BatchControl) GetMessageAuthenticationCode() string {
+	if x != nil {
+		return x.MessageAuthenticationCode
+	}
+	return ""
+}
+
+func (x *BatchControl) GetOdfiIdentification() string {
+	if x != nil {
+		return x.OdfiIdentification
+	}
+	return ""
+}
+
+func (x *BatchControl) GetBatchNumber() int64 {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return 0
+}
+
+type ADVBatchControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceClassCode             int64  `protobuf:"varint,2,opt,name=service_class_code,json=serviceClassCode,proto3" json:"service_class_code,omitempty"`
+	EntryAddendaCount            int64  `protobuf:"varint,3,opt,name=entry_addenda_count,json=entryAddendaCount,proto3" json:"entry_addenda_count,omitempty"`
+	EntryHash                    int64  `protobuf:"varint,4,opt,name=entry_hash,json=entryHash,proto3" json:"entry_hash,omitempty"`
+	TotalDebitEntryDollarAmount  int64  `protobuf:"varint,5,opt,name=total_debit_entry_dollar_amount,json=totalDebit,proto3" json:"total_debit_entry_dollar_amount,omitempty"`
+	TotalCreditEntryDollarAmount int64  `protobuf:"varint,6,opt,name=total_credit_entry_dollar_amount,json=totalCredit,proto3" json:"total_credit_entry_dollar_amount,omitempty"`
+	AchOperatorData              string `protobuf:"bytes,7,opt,name=ach_operator_data,json=achOperatorData,proto3" json:"ach_operator_data,omitempty"`
+	OdfiIdentification           string `protobuf:"bytes,8,opt,name=odfi_identification,json=ODFIIdentification,proto3" json:"odfi_identification,omitempty"`
+	BatchNumber                  int64  `protobuf:"varint,9,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+}
+
+func (x *ADVBatchControl) Reset() {
+	*x = ADVBatchControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ADVBatchControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ADVBatchControl) ProtoMessage() {}
+
+func (x *ADVBatchControl) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ADVBatchControl.ProtoReflect.Descriptor instead.
+func (*ADVBatchControl) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ADVBatchControl) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ADVBatchControl) GetServiceClassCode() int64 {
+	if x != nil {
+		return x.ServiceClassCode
+	}
+	return 0
+}
+
+func (x *ADVBatchControl) GetEntryAddendaCount() int64 {
+	if x != nil {
+		return x.EntryAddendaCount
+	}
+	return 0
+}
+
+func (x *ADVBatchControl) GetEntryHash() int64 {
+	if x != nil {
+		return x.EntryHash
+	}
+	return 0
+}
+
+func (x *ADVBatchControl) GetTotalDebitEntryDollarAmount() int64 {
+	if x != nil {
+		return x.TotalDebitEntryDollarAmount
+	}
+	return 0
+}
+
+func (x *ADVBatchControl) GetTotalCreditEntryDollarAmount() int64 {
+	if x != nil {
+		return x.TotalCreditEntryDollarAmount
+	}
+	return 0
+}
+
+func (x *ADVBatchControl) GetAchOperatorData() string {
+	if x != nil {
+		return x.AchOperatorData
+	}
+	return ""
+}
+
+func (x *ADVBatchControl) GetOdfiIdentification() string {
+	if x != nil {
+		return x.OdfiIdentification
+	}
+	return ""
+}
+
+func (x *ADVBatchControl) GetBatchNumber() int64 {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return 0
+}
+
+type EntryDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                     string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionCode        int64        `protobuf:"varint,2,opt,name=transaction_code,json=transactionCode,proto3" json:"transaction_code,omitempty"`
+	RdfiIdentification     string       `protobuf:"bytes,3,opt,name=rdfi_identification,json=RDFIIdentification,proto3" json:"rdfi_identification,omitempty"`
+	CheckDigit             string       `protobuf:"bytes,4,opt,name=check_digit,json=checkDigit,proto3" json:"check_digit,omitempty"`
+	DfiAccountNumber       string       `protobuf:"bytes,5,opt,name=dfi_account_number,json=DFIAccountNumber,proto3" json:"dfi_account_number,omitempty"`
+	Amount                 int64        `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	IdentificationNumber   string       `protobuf:"bytes,7,opt,name=identification_number,json=identificationNumber,proto3" json:"identification_number,omitempty"`
+	IndividualName         string       `protobuf:"bytes,8,opt,name=individual_name,json=individualName,proto3" json:"individual_name,omitempty"`
+	DiscretionaryData      string       `protobuf:"bytes,9,opt,name=discretionary_data,json=discretionaryData,proto3" json:"discretionary_data,omitempty"`
+	AddendaRecordIndicator int64        `protobuf:"varint,10,opt,name=addenda_record_indicator,json=addendaRecordIndicator,proto3" json:"addenda_record_indicator,omitempty"`
+	TraceNumber            string       `protobuf:"bytes,11,opt,name=trace_number,json=traceNumber,proto3" json:"trace_number,omitempty"`
+	Addenda02              *Addenda02   `protobuf:"bytes,12,opt,name=addenda02,proto3" json:"addenda02,omitempty"`
+	Addenda05              []*Addenda05 `protobuf:"bytes,13,rep,name=addenda05,proto3" json:"addenda05,omitempty"`
+	Addenda98              *Addenda98   `protobuf:"bytes,14,opt,name=addenda98,proto3" json:"addenda98,omitempty"`
+	Addenda99              *Addenda99   `protobuf:"bytes,15,opt,name=addenda99,proto3" json:"addenda99,omitempty"`
+	Category               string       `protobuf:"bytes,16,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *EntryDetail) Reset() {
+	*x = EntryDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntryDetail) ProtoMessage() {}
+
+func (x *EntryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EntryDetail.ProtoReflect.Descriptor instead.
+func (*EntryDetail) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EntryDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetTransactionCode() int64 {
+	if x != nil {
+		return x.TransactionCode
+	}
+	return 0
+}
+
+func (x *EntryDetail) GetRdfiIdentification() string {
+	if x != nil {
+		return x.RdfiIdentification
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetCheckDigit() string {
+	if x != nil {
+		return x.CheckDigit
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetDfiAccountNumber() string {
+	if x != nil {
+		return x.DfiAccountNumber
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *EntryDetail) GetIdentificationNumber() string {
+	if x != nil {
+		return x.IdentificationNumber
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetIndividualName() string {
+	if x != nil {
+		return x.IndividualName
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetDiscretionaryData() string {
+	if x != nil {
+		return x.DiscretionaryData
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetAddendaRecordIndicator() int64 {
+	if x != nil {
+		return x.AddendaRecordIndicator
+	}
+	return 0
+}
+
+func (x *EntryDetail) GetTraceNumber() string {
+	if x != nil {
+		return x.TraceNumber
+	}
+	return ""
+}
+
+func (x *EntryDetail) GetAddenda02() *Addenda02 {
+	if x != nil {
+		return x.Addenda02
+	}
+	return nil
+}
+
+func (x *EntryDetail) GetAddenda05() []*Addenda05 {
+	if x != nil {
+		return x.Addenda05
+	}
+	return nil
+}
+
+func (x *EntryDetail) GetAddenda98() *Addenda98 {
+	if x != nil {
+		return x.Addenda98
+	}
+	return nil
+}
+
+func (x *EntryDetail) GetAddenda99() *Addenda99 {
+	if x != nil {
+		return x.Addenda99
+	}
+	return nil
+}
+
+func (x *EntryDetail) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ADVEntryDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                       string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionCode          int64      `protobuf:"varint,2,opt,name=transaction_code,json=transactionCode,proto3" json:"transaction_code,omitempty"`
+	RdfiIdentification       string     `protobuf:"bytes,3,opt,name=rdfi_identification,json=RDFIIdentification,proto3" json:"rdfi_identification,omitempty"`
+	CheckDigit               string     `protobuf:"bytes,4,opt,name=check_digit,json=checkDigit,proto3" json:"check_digit,omitempty"`
+	DfiAccountNumber         string     `protobuf:"bytes,5,opt,name=dfi_account_number,json=DFIAccountNumber,proto3" json:"dfi_account_number,omitempty"`
+	Amount                   int64      `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	AdviceRoutingNumber      string     `protobuf:"bytes,7,opt,name=advice_routing_number,json=adviceRoutingNumber,proto3" json:"advice_routing_number,omitempty"`
+	FileIdentification       string     `protobuf:"bytes,8,opt,name=file_identification,json=fileIdentification,proto3" json:"file_identification,omitempty"`
+	AchOperatorData          string     `protobuf:"bytes,9,opt,name=ach_operator_data,json=achOperatorData,proto3" json:"ach_operator_data,omitempty"`
+	IndividualName           string     `protobuf:"bytes,10,opt,name=individual_name,json=individualName,proto3" json:"individual_name,omitempty"`
+	DiscretionaryData        string     `protobuf:"bytes,11,opt,name=discretionary_data,json=discretionaryData,proto3" json:"discretionary_data,omitempty"`
+	AddendaRecordIndicator   int64      `protobuf:"varint,12,opt,name=addenda_record_indicator,json=addendaRecordIndicator,proto3" json:"addenda_record_indicator,omitempty"`
+	AchOperatorRoutingNumber string     `protobuf:"bytes,13,opt,name=ach_operator_routing_number,json=achOperatorRoutingNumber,proto3" json:"ach_operator_routing_number,omitempty"`
+	JulianDay                int64      `protobuf:"varint,14,opt,name=julian_day,json=julianDay,proto3" json:"julian_day,omitempty"`
+	SequenceNumber           int64      `protobuf:"varint,15,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	Addenda99                *Addenda99 `protobuf:"bytes,16,opt,name=addenda99,proto3" json:"addenda99,omitempty"`
+	Category                 string     `protobuf:"bytes,17,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *ADVEntryDetail) Reset() {
+	*x = ADVEntryDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ADVEntryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ADVEntryDetail) ProtoMessage() {}
+
+func (x *ADVEntryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ADVEntryDetail.ProtoReflect.Descriptor instead.
+func (*ADVEntryDetail) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ADVEntryDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetTransactionCode() int64 {
+	if x != nil {
+		return x.TransactionCode
+	}
+	return 0
+}
+
+func (x *ADVEntryDetail) GetRdfiIdentification() string {
+	if x != nil {
+		return x.RdfiIdentification
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetCheckDigit() string {
+	if x != nil {
+		return x.CheckDigit
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetDfiAccountNumber() string {
+	if x != nil {
+		return x.DfiAccountNumber
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ADVEntryDetail) GetAdviceRoutingNumber() string {
+	if x != nil {
+		return x.AdviceRoutingNumber
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetFileIdentification() string {
+	if x != nil {
+		return x.FileIdentification
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetAchOperatorData() string {
+	if x != nil {
+		return x.AchOperatorData
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetIndividualName() string {
+	if x != nil {
+		return x.IndividualName
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetDiscretionaryData() string {
+	if x != nil {
+		return x.DiscretionaryData
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetAddendaRecordIndicator() int64 {
+	if x != nil {
+		return x.AddendaRecordIndicator
+	}
+	return 0
+}
+
+func (x *ADVEntryDetail) GetAchOperatorRoutingNumber() string {
+	if x != nil {
+		return x.AchOperatorRoutingNumber
+	}
+	return ""
+}
+
+func (x *ADVEntryDetail) GetJulianDay() int64 {
+	if x != nil {
+		return x.JulianDay
+	}
+	return 0
+}
+
+func (x *ADVEntryDetail) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *ADVEntryDetail) GetAddenda99() *Addenda99 {
+	if x != nil {
+		return x.Addenda99
+	}
+	return nil
+}
+
+func (x *ADVEntryDetail) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type Addenda02 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                      string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	ReferenceInformationOne       string `protobuf:"bytes,3,opt,name=reference_information_one,json=referenceInformationOne,proto3" json:"reference_information_one,omitempty"`
+	ReferenceInformationTwo       string `protobuf:"bytes,4,opt,name=reference_information_two,json=referenceInformationTwo,proto3" json:"reference_information_two,omitempty"`
+	TerminalIdentificationCode    string `protobuf:"bytes,5,opt,name=terminal_identification_code,json=terminalIdentificationCode,proto3" json:"terminal_identification_code,omitempty"`
+	TransactionSerialNumber       string `protobuf:"bytes,6,opt,name=transaction_serial_number,json=transactionSerialNumber,proto3" json:"transaction_serial_number,omitempty"`
+	TransactionDate               string `protobuf:"bytes,7,opt,name=transaction_date,json=transactionDate,proto3" json:"transaction_date,omitempty"`
+	AuthorizationCodeOrExpireDate string `protobuf:"bytes,8,opt,name=authorization_code_or_expire_date,json=authorizationCodeOrExpireDate,proto3" json:"authorization_code_or_expire_date,omitempty"`
+	TerminalLocation              string `protobuf:"bytes,9,opt,name=terminal_location,json=terminalLocation,proto3" json:"terminal_location,omitempty"`
+	TerminalCity                  string `protobuf:"bytes,10,opt,name=terminal_city,json=terminalCity,proto3" json:"terminal_city,omitempty"`
+	TerminalState                 string `protobuf:"bytes,11,opt,name=terminal_state,json=terminalState,proto3" json:"terminal_state,omitempty"`
+	TraceNumber                   string `protobuf:"bytes,12,opt,name=trace_number,json=traceNumber,proto3" json:"trace_number,omitempty"`
+}
+
+func (x *Addenda02) Reset() {
+	*x = Addenda02{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda02) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda02) ProtoMessage() {}
+
+func (x *Addenda02) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda02.ProtoReflect.Descriptor instead.
+func (*Addenda02) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Addenda02) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda02) GetReferenceInformationOne() string {
+	if x != nil {
+		return x.ReferenceInformationOne
+	}
+	return ""
+}
+
+func (x *Addenda02) GetReferenceInformationTwo() string {
+	if x != nil {
+		return x.ReferenceInformationTwo
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTerminalIdentificationCode() string {
+	if x != nil {
+		return x.TerminalIdentificationCode
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTransactionSerialNumber() string {
+	if x != nil {
+		return x.TransactionSerialNumber
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTransactionDate() string {
+	if x != nil {
+		return x.TransactionDate
+	}
+	return ""
+}
+
+func (x *Addenda02) GetAuthorizationCodeOrExpireDate() string {
+	if x != nil {
+		return x.AuthorizationCodeOrExpireDate
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTerminalLocation() string {
+	if x != nil {
+		return x.TerminalLocation
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTerminalCity() string {
+	if x != nil {
+		return x.TerminalCity
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTerminalState() string {
+	if x != nil {
+		return x.TerminalState
+	}
+	return ""
+}
+
+func (x *Addenda02) GetTraceNumber() string {
+	if x != nil {
+		return x.TraceNumber
+	}
+	return ""
+}
+
+type Addenda05 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	PaymentRelatedInformation string `protobuf:"bytes,3,opt,name=payment_related_information,json=paymentRelatedInformation,proto3" json:"payment_related_information,omitempty"`
+	SequenceNumber            int64  `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,5,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda05) Reset() {
+	*x = Addenda05{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda05) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda05) ProtoMessage() {}
+
+func (x *Addenda05) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda05.ProtoReflect.Descriptor instead.
+func (*Addenda05) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Addenda05) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda05) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda05) GetPaymentRelatedInformation() string {
+	if x != nil {
+		return x.PaymentRelatedInformation
+	}
+	return ""
+}
+
+func (x *Addenda05) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *Addenda05) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda98 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode      string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	ChangeCode    string `protobuf:"bytes,3,opt,name=change_code,json=changeCode,proto3" json:"change_code,omitempty"`
+	OriginalTrace string `protobuf:"bytes,4,opt,name=original_trace,json=originalTrace,proto3" json:"original_trace,omitempty"`
+	OriginalDfi   string `protobuf:"bytes,5,opt,name=original_dfi,json=originalDFI,proto3" json:"original_dfi,omitempty"`
+	CorrectedData string `protobuf:"bytes,6,opt,name=corrected_data,json=correctedData,proto3" json:"corrected_data,omitempty"`
+	TraceNumber   string `protobuf:"bytes,7,opt,name=trace_number,json=traceNumber,proto3" json:"trace_number,omitempty"`
+}
+
+func (x *Addenda98) Reset() {
+	*x = Addenda98{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda98) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda98) ProtoMessage() {}
+
+func (x *Addenda98) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda98.ProtoReflect.Descriptor instead.
+func (*Addenda98) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Addenda98) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda98) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda98) GetChangeCode() string {
+	if x != nil {
+		return x.ChangeCode
+	}
+	return ""
+}
+
+func (x *Addenda98) GetOriginalTrace() string {
+	if x != nil {
+		return x.OriginalTrace
+	}
+	return ""
+}
+
+func (x *Addenda98) GetOriginalDfi() string {
+	if x != nil {
+		return x.OriginalDfi
+	}
+	return ""
+}
+
+func (x *Addenda98) GetCorrectedData() string {
+	if x != nil {
+		return x.CorrectedData
+	}
+	return ""
+}
+
+func (x *Addenda98) GetTraceNumber() string {
+	if x != nil {
+		return x.TraceNumber
+	}
+	return ""
+}
+
+type Addenda99 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode           string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	ReturnCode         string `protobuf:"bytes,3,opt,name=return_code,json=returnCode,proto3" json:"return_code,omitempty"`
+	OriginalTrace      string `protobuf:"bytes,4,opt,name=original_trace,json=originalTrace,proto3" json:"original_trace,omitempty"`
+	DateOfDeath        string `protobuf:"bytes,5,opt,name=date_of_death,json=dateOfDeath,proto3" json:"date_of_death,omitempty"`
+	OriginalDfi        string `protobuf:"bytes,6,opt,name=original_dfi,json=originalDFI,proto3" json:"original_dfi,omitempty"`
+	AddendaInformation string `protobuf:"bytes,7,opt,name=addenda_information,json=addendaInformation,proto3" json:"addenda_information,omitempty"`
+	TraceNumber        string `protobuf:"bytes,8,opt,name=trace_number,json=traceNumber,proto3" json:"trace_number,omitempty"`
+}
+
+func (x *Addenda99) Reset() {
+	*x = Addenda99{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda99) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda99) ProtoMessage() {}
+
+func (x *Addenda99) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda99.ProtoReflect.Descriptor instead.
+func (*Addenda99) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Addenda99) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda99) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda99) GetReturnCode() string {
+	if x != nil {
+		return x.ReturnCode
+	}
+	return ""
+}
+
+func (x *Addenda99) GetOriginalTrace() string {
+	if x != nil {
+		return x.OriginalTrace
+	}
+	return ""
+}
+
+func (x *Addenda99) GetDateOfDeath() string {
+	if x != nil {
+		return x.DateOfDeath
+	}
+	return ""
+}
+
+func (x *Addenda99) GetOriginalDfi() string {
+	if x != nil {
+		return x.OriginalDfi
+	}
+	return ""
+}
+
+func (x *Addenda99) GetAddendaInformation() string {
+	if x != nil {
+		return x.AddendaInformation
+	}
+	return ""
+}
+
+func (x *Addenda99) GetTraceNumber() string {
+	if x != nil {
+		return x.TraceNumber
+	}
+	return ""
+}
+
+// IATBatch mirrors ach.IATBatch
+type IATBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Header  *IATBatchHeader   `protobuf:"bytes,2,opt,name=header,json=IATBatchHeader,proto3" json:"header,omitempty"`
+	Entries []*IATEntryDetail `protobuf:"bytes,3,rep,name=entries,json=IATEntryDetails,proto3" json:"entries,omitempty"`
+	Control *BatchControl     `protobuf:"bytes,4,opt,name=control,json=batchControl,proto3" json:"control,omitempty"`
+}
+
+func (x *IATBatch) Reset() {
+	*x = IATBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IATBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IATBatch) ProtoMessage() {}
+
+func (x *IATBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IATBatch.ProtoReflect.Descriptor instead.
+func (*IATBatch) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *IATBatch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IATBatch) GetHeader() *IATBatchHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *IATBatch) GetEntries() []*IATEntryDetail {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *IATBatch) GetControl() *BatchControl {
+	if x != nil {
+		return x.Control
+	}
+	return nil
+}
+
+type IATBatchHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ServiceClassCode                  int64  `protobuf:"varint,2,opt,name=service_class_code,json=serviceClassCode,proto3" json:"service_class_code,omitempty"`
+	IatIndicator                      string `protobuf:"bytes,3,opt,name=iat_indicator,json=IATIndicator,proto3" json:"iat_indicator,omitempty"`
+	ForeignExchangeIndicator          string `protobuf:"bytes,4,opt,name=foreign_exchange_indicator,json=foreignExchangeIndicator,proto3" json:"foreign_exchange_indicator,omitempty"`
+	ForeignExchangeReferenceIndicator int64  `protobuf:"varint,5,opt,name=foreign_exchange_reference_indicator,json=foreignExchangeReferenceIndicator,proto3" json:"foreign_exchange_reference_indicator,omitempty"`
+	ForeignExchangeReference          string `protobuf:"bytes,6,opt,name=foreign_exchange_reference,json=foreignExchangeReference,proto3" json:"foreign_exchange_reference,omitempty"`
+	IsoDestinationCountryCode         string `protobuf:"bytes,7,opt,name=iso_destination_country_code,json=ISODestinationCountryCode,proto3" json:"iso_destination_country_code,omitempty"`
+	OriginatorIdentification          string `protobuf:"bytes,8,opt,name=originator_identification,json=originatorIdentification,proto3" json:"originator_identification,omitempty"`
+	StandardEntryClassCode            string `protobuf:"bytes,9,opt,name=standard_entry_class_code,json=standardEntryClassCode,proto3" json:"standard_entry_class_code,omitempty"`
+	CompanyEntryDescription           string `protobuf:"bytes,10,opt,name=company_entry_description,json=companyEntryDescription,proto3" json:"company_entry_description,omitempty"`
+	IsoOriginatingCurrencyCode        string `protobuf:"bytes,11,opt,name=iso_originating_currency_code,json=ISOOriginatingCurrencyCode,proto3" json:"iso_originating_currency_code,omitempty"`
+	IsoDestinationCurrencyCode        string `protobuf:"bytes,12,opt,name=iso_destination_currency_code,json=ISODestinationCurrencyCode,proto3" json:"iso_destination_currency_code,omitempty"`
+	EffectiveEntryDate                string `protobuf:"bytes,13,opt,name=effective_entry_date,json=effectiveEntryDate,proto3" json:"effective_entry_date,omitempty"`
+	OriginatorStatusCode              int64  `protobuf:"varint,14,opt,name=originator_status_code,json=originatorStatusCode,proto3" json:"originator_status_code,omitempty"`
+	OdfiIdentification                string `protobuf:"bytes,15,opt,name=odfi_identification,json=ODFIIdentification,proto3" json:"odfi_identification,omitempty"`
+	BatchNumber                       int64  `protobuf:"varint,16,opt,name=batch_number,json=batchNumber,proto3" json:"batch_number,omitempty"`
+}
+
+func (x *IATBatchHeader) Reset() {
+	*x = IATBatchHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IATBatchHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IATBatchHeader) ProtoMessage() {}
+
+func (x *IATBatchHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IATBatchHeader.ProtoReflect.Descriptor instead.
+func (*IATBatchHeader) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *IATBatchHeader) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetServiceClassCode() int64 {
+	if x != nil {
+		return x.ServiceClassCode
+	}
+	return 0
+}
+
+func (x *IATBatchHeader) GetIatIndicator() string {
+	if x != nil {
+		return x.IatIndicator
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetForeignExchangeIndicator() string {
+	if x != nil {
+		return x.ForeignExchangeIndicator
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetForeignExchangeReferenceIndicator() int64 {
+	if x != nil {
+		return x.ForeignExchangeReferenceIndicator
+	}
+	return 0
+}
+
+func (x *IATBatchHeader) GetForeignExchangeReference() string {
+	if x != nil {
+		return x.ForeignExchangeReference
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetIsoDestinationCountryCode() string {
+	if x != nil {
+		return x.IsoDestinationCountryCode
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetOriginatorIdentification() string {
+	if x != nil {
+		return x.OriginatorIdentification
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetStandardEntryClassCode() string {
+	if x != nil {
+		return x.StandardEntryClassCode
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetCompanyEntryDescription() string {
+	if x != nil {
+		return x.CompanyEntryDescription
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetIsoOriginatingCurrencyCode() string {
+	if x != nil {
+		return x.IsoOriginatingCurrencyCode
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetIsoDestinationCurrencyCode() string {
+	if x != nil {
+		return x.IsoDestinationCurrencyCode
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetEffectiveEntryDate() string {
+	if x != nil {
+		return x.EffectiveEntryDate
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetOriginatorStatusCode() int64 {
+	if x != nil {
+		return x.OriginatorStatusCode
+	}
+	return 0
+}
+
+func (x *IATBatchHeader) GetOdfiIdentification() string {
+	if x != nil {
+		return x.OdfiIdentification
+	}
+	return ""
+}
+
+func (x *IATBatchHeader) GetBatchNumber() int64 {
+	if x != nil {
+		return x.BatchNumber
+	}
+	return 0
+}
+
+type IATEntryDetail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                              string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TransactionCode                 int64        `protobuf:"varint,2,opt,name=transaction_code,json=transactionCode,proto3" json:"transaction_code,omitempty"`
+	RdfiIdentification              string       `protobuf:"bytes,3,opt,name=rdfi_identification,json=RDFIIdentification,proto3" json:"rdfi_identification,omitempty"`
+	CheckDigit                      string       `protobuf:"bytes,4,opt,name=check_digit,json=checkDigit,proto3" json:"check_digit,omitempty"`
+	AddendaRecords                  int64        `protobuf:"varint,5,opt,name=addenda_records,json=AddendaRecords,proto3" json:"addenda_records,omitempty"`
+	Amount                          int64        `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	DfiAccountNumber                string       `protobuf:"bytes,7,opt,name=dfi_account_number,json=DFIAccountNumber,proto3" json:"dfi_account_number,omitempty"`
+	OfacScreeningIndicator          string       `protobuf:"bytes,8,opt,name=ofac_screening_indicator,json=OFACScreeningIndicator,proto3" json:"ofac_screening_indicator,omitempty"`
+	SecondaryOfacScreeningIndicator string       `protobuf:"bytes,9,opt,name=secondary_ofac_screening_indicator,json=SecondaryOFACScreeningIndicator,proto3" json:"secondary_ofac_screening_indicator,omitempty"`
+	AddendaRecordIndicator          int64        `protobuf:"varint,10,opt,name=addenda_record_indicator,json=addendaRecordIndicator,proto3" json:"addenda_record_indicator,omitempty"`
+	TraceNumber                     string       `protobuf:"bytes,11,opt,name=trace_number,json=traceNumber,proto3" json:"trace_number,omitempty"`
+	Addenda10                       *Addenda10   `protobuf:"bytes,12,opt,name=addenda10,proto3" json:"addenda10,omitempty"`
+	Addenda11                       *Addenda11   `protobuf:"bytes,13,opt,name=addenda11,proto3" json:"addenda11,omitempty"`
+	Addenda12                       *Addenda12   `protobuf:"bytes,14,opt,name=addenda12,proto3" json:"addenda12,omitempty"`
+	Addenda13                       *Addenda13   `protobuf:"bytes,15,opt,name=addenda13,proto3" json:"addenda13,omitempty"`
+	Addenda14                       *Addenda14   `protobuf:"bytes,16,opt,name=addenda14,proto3" json:"addenda14,omitempty"`
+	Addenda15                       *Addenda15   `protobuf:"bytes,17,opt,name=addenda15,proto3" json:"addenda15,omitempty"`
+	Addenda16                       *Addenda16   `protobuf:"bytes,18,opt,name=addenda16,proto3" json:"addenda16,omitempty"`
+	Addenda17                       []*Addenda17 `protobuf:"bytes,19,rep,name=addenda17,proto3" json:"addenda17,omitempty"`
+	Addenda18                       []*Addenda18 `protobuf:"bytes,20,rep,name=addenda18,proto3" json:"addenda18,omitempty"`
+	Addenda98                       *Addenda98   `protobuf:"bytes,21,opt,name=addenda98,proto3" json:"addenda98,omitempty"`
+	Addenda99                       *Addenda99   `protobuf:"bytes,22,opt,name=addenda99,proto3" json:"addenda99,omitempty"`
+	Category                        string       `protobuf:"bytes,23,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *IATEntryDetail) Reset() {
+	*x = IATEntryDetail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IATEntryDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IATEntryDetail) ProtoMessage() {}
+
+func (x *IATEntryDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IATEntryDetail.ProtoReflect.Descriptor instead.
+func (*IATEntryDetail) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *IATEntryDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetTransactionCode() int64 {
+	if x != nil {
+		return x.TransactionCode
+	}
+	return 0
+}
+
+func (x *IATEntryDetail) GetRdfiIdentification() string {
+	if x != nil {
+		return x.RdfiIdentification
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetCheckDigit() string {
+	if x != nil {
+		return x.CheckDigit
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetAddendaRecords() int64 {
+	if x != nil {
+		return x.AddendaRecords
+	}
+	return 0
+}
+
+func (x *IATEntryDetail) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *IATEntryDetail) GetDfiAccountNumber() string {
+	if x != nil {
+		return x.DfiAccountNumber
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetOfacScreeningIndicator() string {
+	if x != nil {
+		return x.OfacScreeningIndicator
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetSecondaryOfacScreeningIndicator() string {
+	if x != nil {
+		return x.SecondaryOfacScreeningIndicator
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetAddendaRecordIndicator() int64 {
+	if x != nil {
+		return x.AddendaRecordIndicator
+	}
+	return 0
+}
+
+func (x *IATEntryDetail) GetTraceNumber() string {
+	if x != nil {
+		return x.TraceNumber
+	}
+	return ""
+}
+
+func (x *IATEntryDetail) GetAddenda10() *Addenda10 {
+	if x != nil {
+		return x.Addenda10
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda11() *Addenda11 {
+	if x != nil {
+		return x.Addenda11
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda12() *Addenda12 {
+	if x != nil {
+		return x.Addenda12
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda13() *Addenda13 {
+	if x != nil {
+		return x.Addenda13
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda14() *Addenda14 {
+	if x != nil {
+		return x.Addenda14
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda15() *Addenda15 {
+	if x != nil {
+		return x.Addenda15
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda16() *Addenda16 {
+	if x != nil {
+		return x.Addenda16
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda17() []*Addenda17 {
+	if x != nil {
+		return x.Addenda17
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda18() []*Addenda18 {
+	if x != nil {
+		return x.Addenda18
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda98() *Addenda98 {
+	if x != nil {
+		return x.Addenda98
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetAddenda99() *Addenda99 {
+	if x != nil {
+		return x.Addenda99
+	}
+	return nil
+}
+
+func (x *IATEntryDetail) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type Addenda10 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	TransactionTypeCode       string `protobuf:"bytes,3,opt,name=transaction_type_code,json=transactionTypeCode,proto3" json:"transaction_type_code,omitempty"`
+	ForeignPaymentAmount      int64  `protobuf:"varint,4,opt,name=foreign_payment_amount,json=foreignPaymentAmount,proto3" json:"foreign_payment_amount,omitempty"`
+	ForeignTraceNumber        string `protobuf:"bytes,5,opt,name=foreign_trace_number,json=foreignTraceNumber,proto3" json:"foreign_trace_number,omitempty"`
+	Name                      string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,7,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda10) Reset() {
+	*x = Addenda10{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda10) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda10) ProtoMessage() {}
+
+func (x *Addenda10) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda10.ProtoReflect.Descriptor instead.
+func (*Addenda10) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Addenda10) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda10) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda10) GetTransactionTypeCode() string {
+	if x != nil {
+		return x.TransactionTypeCode
+	}
+	return ""
+}
+
+func (x *Addenda10) GetForeignPaymentAmount() int64 {
+	if x != nil {
+		return x.ForeignPaymentAmount
+	}
+	return 0
+}
+
+func (x *Addenda10) GetForeignTraceNumber() string {
+	if x != nil {
+		return x.ForeignTraceNumber
+	}
+	return ""
+}
+
+func (x *Addenda10) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Addenda10) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda11 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	OriginatorName            string `protobuf:"bytes,3,opt,name=originator_name,json=originatorName,proto3" json:"originator_name,omitempty"`
+	OriginatorStreetAddress   string `protobuf:"bytes,4,opt,name=originator_street_address,json=originatorStreetAddress,proto3" json:"originator_street_address,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,5,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda11) Reset() {
+	*x = Addenda11{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda11) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda11) ProtoMessage() {}
+
+func (x *Addenda11) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda11.ProtoReflect.Descriptor instead.
+func (*Addenda11) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Addenda11) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda11) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda11) GetOriginatorName() string {
+	if x != nil {
+		return x.OriginatorName
+	}
+	return ""
+}
+
+func (x *Addenda11) GetOriginatorStreetAddress() string {
+	if x != nil {
+		return x.OriginatorStreetAddress
+	}
+	return ""
+}
+
+func (x *Addenda11) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda12 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                    string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	OriginatorCityStateProvince string `protobuf:"bytes,3,opt,name=originator_city_state_province,json=originatorCityStateProvince,proto3" json:"originator_city_state_province,omitempty"`
+	OriginatorCountryPostalCode string `protobuf:"bytes,4,opt,name=originator_country_postal_code,json=originatorCountryPostalCode,proto3" json:"originator_country_postal_code,omitempty"`
+	EntryDetailSequenceNumber   int64  `protobuf:"varint,5,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda12) Reset() {
+	*x = Addenda12{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda12) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda12) ProtoMessage() {}
+
+func (x *Addenda12) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda12.ProtoReflect.Descriptor instead.
+func (*Addenda12) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Addenda12) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda12) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda12) GetOriginatorCityStateProvince() string {
+	if x != nil {
+		return x.OriginatorCityStateProvince
+	}
+	return ""
+}
+
+func (x *Addenda12) GetOriginatorCountryPostalCode() string {
+	if x != nil {
+		return x.OriginatorCountryPostalCode
+	}
+	return ""
+}
+
+func (x *Addenda12) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda13 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	OdfiName                  string `protobuf:"bytes,3,opt,name=odfi_name,json=ODFIName,proto3" json:"odfi_name,omitempty"`
+	OdfiIdNumberQualifier     string `protobuf:"bytes,4,opt,name=odfi_id_number_qualifier,json=ODFIIDNumberQualifier,proto3" json:"odfi_id_number_qualifier,omitempty"`
+	OdfiIdentification        string `protobuf:"bytes,5,opt,name=odfi_identification,json=ODFIIdentification,proto3" json:"odfi_identification,omitempty"`
+	OdfiBranchCountryCode     string `protobuf:"bytes,6,opt,name=odfi_branch_country_code,json=ODFIBranchCountryCode,proto3" json:"odfi_branch_country_code,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,7,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda13) Reset() {
+	*x = Addenda13{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda13) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda13) ProtoMessage() {}
+
+func (x *Addenda13) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda13.ProtoReflect.Descriptor instead.
+func (*Addenda13) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Addenda13) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda13) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda13) GetOdfiName() string {
+	if x != nil {
+		return x.OdfiName
+	}
+	return ""
+}
+
+func (x *Addenda13) GetOdfiIdNumberQualifier() string {
+	if x != nil {
+		return x.OdfiIdNumberQualifier
+	}
+	return ""
+}
+
+func (x *Addenda13) GetOdfiIdentification() string {
+	if x != nil {
+		return x.OdfiIdentification
+	}
+	return ""
+}
+
+func (x *Addenda13) GetOdfiBranchCountryCode() string {
+	if x != nil {
+		return x.OdfiBranchCountryCode
+	}
+	return ""
+}
+
+func (x *Addenda13) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda14 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	RdfiName                  string `protobuf:"bytes,3,opt,name=rdfi_name,json=RDFIName,proto3" json:"rdfi_name,omitempty"`
+	RdfiIdNumberQualifier     string `protobuf:"bytes,4,opt,name=rdfi_id_number_qualifier,json=RDFIIDNumberQualifier,proto3" json:"rdfi_id_number_qualifier,omitempty"`
+	RdfiIdentification        string `protobuf:"bytes,5,opt,name=rdfi_identification,json=RDFIIdentification,proto3" json:"rdfi_identification,omitempty"`
+	RdfiBranchCountryCode     string `protobuf:"bytes,6,opt,name=rdfi_branch_country_code,json=RDFIBranchCountryCode,proto3" json:"rdfi_branch_country_code,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,7,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda14) Reset() {
+	*x = Addenda14{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda14) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda14) ProtoMessage() {}
+
+func (x *Addenda14) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda14.ProtoReflect.Descriptor instead.
+func (*Addenda14) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Addenda14) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda14) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda14) GetRdfiName() string {
+	if x != nil {
+		return x.RdfiName
+	}
+	return ""
+}
+
+func (x *Addenda14) GetRdfiIdNumberQualifier() string {
+	if x != nil {
+		return x.RdfiIdNumberQualifier
+	}
+	return ""
+}
+
+func (x *Addenda14) GetRdfiIdentification() string {
+	if x != nil {
+		return x.RdfiIdentification
+	}
+	return ""
+}
+
+func (x *Addenda14) GetRdfiBranchCountryCode() string {
+	if x != nil {
+		return x.RdfiBranchCountryCode
+	}
+	return ""
+}
+
+func (x *Addenda14) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda15 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	ReceiverIdNumber          string `protobuf:"bytes,3,opt,name=receiver_id_number,json=receiverIDNumber,proto3" json:"receiver_id_number,omitempty"`
+	ReceiverStreetAddress     string `protobuf:"bytes,4,opt,name=receiver_street_address,json=receiverStreetAddress,proto3" json:"receiver_street_address,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,5,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda15) Reset() {
+	*x = Addenda15{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda15) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda15) ProtoMessage() {}
+
+func (x *Addenda15) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda15.ProtoReflect.Descriptor instead.
+func (*Addenda15) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Addenda15) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda15) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda15) GetReceiverIdNumber() string {
+	if x != nil {
+		return x.ReceiverIdNumber
+	}
+	return ""
+}
+
+func (x *Addenda15) GetReceiverStreetAddress() string {
+	if x != nil {
+		return x.ReceiverStreetAddress
+	}
+	return ""
+}
+
+func (x *Addenda15) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda16 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	ReceiverCityStateProvince string `protobuf:"bytes,3,opt,name=receiver_city_state_province,json=receiverCityStateProvince,proto3" json:"receiver_city_state_province,omitempty"`
+	ReceiverCountryPostalCode string `protobuf:"bytes,4,opt,name=receiver_country_postal_code,json=receiverCountryPostalCode,proto3" json:"receiver_country_postal_code,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,5,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda16) Reset() {
+	*x = Addenda16{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda16) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda16) ProtoMessage() {}
+
+func (x *Addenda16) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda16.ProtoReflect.Descriptor instead.
+func (*Addenda16) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Addenda16) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda16) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda16) GetReceiverCityStateProvince() string {
+	if x != nil {
+		return x.ReceiverCityStateProvince
+	}
+	return ""
+}
+
+func (x *Addenda16) GetReceiverCountryPostalCode() string {
+	if x != nil {
+		return x.ReceiverCountryPostalCode
+	}
+	return ""
+}
+
+func (x *Addenda16) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda17 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	PaymentRelatedInformation string `protobuf:"bytes,3,opt,name=payment_related_information,json=paymentRelatedInformation,proto3" json:"payment_related_information,omitempty"`
+	SequenceNumber            int64  `protobuf:"varint,4,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	EntryDetailSequenceNumber int64  `protobuf:"varint,5,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda17) Reset() {
+	*x = Addenda17{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda17) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda17) ProtoMessage() {}
+
+func (x *Addenda17) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda17.ProtoReflect.Descriptor instead.
+func (*Addenda17) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Addenda17) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda17) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda17) GetPaymentRelatedInformation() string {
+	if x != nil {
+		return x.PaymentRelatedInformation
+	}
+	return ""
+}
+
+func (x *Addenda17) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *Addenda17) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+type Addenda18 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TypeCode                                  string `protobuf:"bytes,2,opt,name=type_code,json=typeCode,proto3" json:"type_code,omitempty"`
+	ForeignCorrespondentBankName              string `protobuf:"bytes,3,opt,name=foreign_correspondent_bank_name,json=foreignCorrespondentBankName,proto3" json:"foreign_correspondent_bank_name,omitempty"`
+	ForeignCorrespondentBankIdNumberQualifier string `protobuf:"bytes,4,opt,name=foreign_correspondent_bank_id_number_qualifier,json=foreignCorrespondentBankIDNumberQualifier,proto3" json:"foreign_correspondent_bank_id_number_qualifier,omitempty"`
+	ForeignCorrespondentBankIdNumber          string `protobuf:"bytes,5,opt,name=foreign_correspondent_bank_id_number,json=foreignCorrespondentBankIDNumber,proto3" json:"foreign_correspondent_bank_id_number,omitempty"`
+	ForeignCorrespondentBankBranchCountryCode string `protobuf:"bytes,6,opt,name=foreign_correspondent_bank_branch_country_code,json=foreignCorrespondentBankBranchCountryCode,proto3" json:"foreign_correspondent_bank_branch_country_code,omitempty"`
+	SequenceNumber                            int64  `protobuf:"varint,7,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	EntryDetailSequenceNumber                 int64  `protobuf:"varint,8,opt,name=entry_detail_sequence_number,json=entryDetailSequenceNumber,proto3" json:"entry_detail_sequence_number,omitempty"`
+}
+
+func (x *Addenda18) Reset() {
+	*x = Addenda18{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Addenda18) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Addenda18) ProtoMessage() {}
+
+func (x *Addenda18) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Addenda18.ProtoReflect.Descriptor instead.
+func (*Addenda18) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Addenda18) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Addenda18) GetTypeCode() string {
+	if x != nil {
+		return x.TypeCode
+	}
+	return ""
+}
+
+func (x *Addenda18) GetForeignCorrespondentBankName() string {
+	if x != nil {
+		return x.ForeignCorrespondentBankName
+	}
+	return ""
+}
+
+func (x *Addenda18) GetForeignCorrespondentBankIdNumberQualifier() string {
+	if x != nil {
+		return x.ForeignCorrespondentBankIdNumberQualifier
+	}
+	return ""
+}
+
+func (x *Addenda18) GetForeignCorrespondentBankIdNumber() string {
+	if x != nil {
+		return x.ForeignCorrespondentBankIdNumber
+	}
+	return ""
+}
+
+func (x *Addenda18) GetForeignCorrespondentBankBranchCountryCode() string {
+	if x != nil {
+		return x.ForeignCorrespondentBankBranchCountryCode
+	}
+	return ""
+}
+
+func (x *Addenda18) GetSequenceNumber() int64 {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return 0
+}
+
+func (x *Addenda18) GetEntryDetailSequenceNumber() int64 {
+	if x != nil {
+		return x.EntryDetailSequenceNumber
+	}
+	return 0
+}
+
+// Offset mirrors ach.Offset, account_type is "checking" or "savings".
+type Offset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoutingNumber string `protobuf:"bytes,1,opt,name=routing_number,json=routingNumber,proto3" json:"routing_number,omitempty"`
+	AccountNumber string `protobuf:"bytes,2,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountType   string `protobuf:"bytes,3,opt,name=account_type,json=accountType,proto3" json:"account_type,omitempty"`
+	Description   string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *Offset) Reset() {
+	*x = Offset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Offset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Offset) ProtoMessage() {}
+
+func (x *Offset) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Offset.ProtoReflect.Descriptor instead.
+func (*Offset) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Offset) GetRoutingNumber() string {
+	if x != nil {
+		return x.RoutingNumber
+	}
+	return ""
+}
+
+func (x *Offset) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *Offset) GetAccountType() string {
+	if x != nil {
+		return x.AccountType
+	}
+	return ""
+}
+
+func (x *Offset) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// ValidateOpts mirrors ach.ValidateOpts
+type ValidateOpts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RequireAbaOrigin            bool `protobuf:"varint,1,opt,name=require_aba_origin,json=requireABAOrigin,proto3" json:"require_aba_origin,omitempty"`
+	BypassOriginValidation      bool `protobuf:"varint,2,opt,name=bypass_origin_validation,json=bypassOriginValidation,proto3" json:"bypass_origin_validation,omitempty"`
+	BypassDestinationValidation bool `protobuf:"varint,3,opt,name=bypass_destination_validation,json=bypassDestinationValidation,proto3" json:"bypass_destination_validation,omitempty"`
+}
+
+func (x *ValidateOpts) Reset() {
+	*x = ValidateOpts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ach_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateOpts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateOpts) ProtoMessage() {}
+
+func (x *ValidateOpts) ProtoReflect() protoreflect.Message {
+	mi := &file_ach_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateOpts.ProtoReflect.Descriptor instead.
+func (*ValidateOpts) Descriptor() ([]byte, []int) {
+	return file_ach_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ValidateOpts) GetRequireAbaOrigin() bool {
+	if x != nil {
+		return x.RequireAbaOrigin
+	}
+	return false
+}
+
+func (x *ValidateOpts) GetBypassOriginValidation() bool {
+	if x != nil {
+		return x.BypassOriginValidation
+	}
+	return false
+}
+
+func (x *ValidateOpts) GetBypassDestinationValidation() bool {
+	if x != nil {
+		return x.BypassDestinationValidation
+	}
+	return false
+}
+
+var File_ach_proto protoreflect.FileDescriptor
+
+var file_ach_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x63, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6d, 0x6f, 0x6f,
+	0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x22, 0x64, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x00,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x24,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x34, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x6b, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3c,
+	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x23, 0x0a, 0x11,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x2a, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x54, 0x0a,
+	0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x73, 0x52, 0x04, 0x6f,
+	0x70, 0x74, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x12, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x25, 0x0a, 0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2d,
+	0x0a, 0x12, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x5f, 0x0a,
+	0x13, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65,
+	0x62, 0x69, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x30,
+	0x0a, 0x15, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x28, 0x0a, 0x16, 0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e,
+	0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x05, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x25, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x0f, 0x47, 0x65,
+	0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x64, 0x22, 0x2d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64,
+	0x22, 0x43, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e,
+	0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x48, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x22,
+	0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb0, 0x03, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x33, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0a, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x12, 0x36, 0x0a, 0x0b, 0x69, 0x61, 0x74, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x41, 0x54, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0a,
+	0x49, 0x41, 0x54, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f,
+	0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0b, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x64, 0x76, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x44, 0x56, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0e, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x44, 0x56, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x12, 0x48, 0x0a, 0x16, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x39,
+	0x0a, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x0d, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x9b, 0x03, 0x0a, 0x0a, 0x46, 0x69,
+	0x6c, 0x65, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x15, 0x69, 0x6d, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
+	0x10, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x74, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x66, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x3c,
+	0x0a, 0x1a, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x15,
+	0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6d, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3b, 0x0a, 0x27, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x28, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x0e, 0x41, 0x44, 0x56, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3b, 0x0a, 0x27, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f,
+	0x6c, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x28, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f, 0x6c, 0x6c,
+	0x61, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x22, 0xe5, 0x02, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x35,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x0c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x38,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a, 0x0b, 0x61, 0x64, 0x76, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x44, 0x56, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0f, 0x61, 0x64, 0x76, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x61,
+	0x64, 0x76, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x44, 0x56, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0f,
+	0x61, 0x64, 0x76, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12,
+	0x2b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0xd0, 0x04, 0x0a,
+	0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
+	0x1a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x18, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x16, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x65,
+	0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a,
+	0x19, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x6f,
+	0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x44, 0x46, 0x49, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0xce, 0x03, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2e,
+	0x0a, 0x13, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a,
+	0x1f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x5f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x65, 0x62,
+	0x69, 0x74, 0x12, 0x35, 0x0a, 0x20, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3a, 0x0a, 0x1b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x13,
+	0x6f, 0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x44, 0x46, 0x49, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x8a, 0x03, 0x0a, 0x0f, 0x41, 0x44, 0x56, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x61, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x33, 0x0a, 0x1f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x62, 0x69, 0x74,
+	0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f, 0x6c, 0x6c, 0x61, 0x72, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x44, 0x65, 0x62, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x20, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x6f, 0x6c,
+	0x6c, 0x61, 0x72, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x2a, 0x0a,
+	0x11, 0x61, 0x63, 0x68, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x68, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x64, 0x66,
+	0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x44, 0x46, 0x49, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xbe, 0x05,
+	0x0a, 0x0b, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x64, 0x66, 0x69,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x52, 0x44, 0x46, 0x49, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x66,
+	0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x46, 0x49, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x33, 0x0a, 0x15, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64,
+	0x75, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x18, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64,
+	0x64, 0x65, 0x6e, 0x64, 0x61, 0x30, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x30, 0x32, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x30, 0x32,
+	0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x30, 0x35, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x30, 0x35, 0x52, 0x09, 0x61, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x30, 0x35, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64,
+	0x61, 0x39, 0x38, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76,
+	0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39,
+	0x38, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x38, 0x12, 0x34, 0x0a, 0x09,
+	0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61,
+	0x39, 0x39, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xdf,
+	0x05, 0x0a, 0x0e, 0x41, 0x44, 0x56, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f, 0x0a, 0x13,
+	0x72, 0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x52, 0x44, 0x46, 0x49, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x67, 0x69, 0x74, 0x12, 0x2c,
+	0x0a, 0x12, 0x64, 0x66, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x46, 0x49, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x52, 0x6f, 0x75, 0x74, 0x69,
+	0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x63, 0x68,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64,
+	0x75, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x64, 0x69, 0x76, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d,
+	0x0a, 0x12, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x64, 0x69, 0x73, 0x63,
+	0x72, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a,
+	0x18, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x16, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x63, 0x68, 0x5f, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x61, 0x63,
+	0x68, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6a, 0x75, 0x6c, 0x69, 0x61, 0x6e,
+	0x5f, 0x64, 0x61, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6a, 0x75, 0x6c, 0x69,
+	0x61, 0x6e, 0x44, 0x61, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e,
+	0x64, 0x61, 0x39, 0x39, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x22, 0xbf, 0x04, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x30, 0x32, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x6e, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x77, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x77, 0x6f, 0x12, 0x40, 0x0a, 0x1c, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x74, 0x65, 0x72, 0x6d, 0x69,
+	0x6e, 0x61, 0x6c, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x21,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x5f, 0x6f, 0x72, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x4f, 0x72, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
+	0x61, 0x6c, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x43, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0xe2, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x30, 0x35,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a,
+	0x1b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x19, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xed, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x39, 0x38, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x66, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x46, 0x49, 0x12, 0x25, 0x0a,
+	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x9b, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x39, 0x39, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x64, 0x65, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x44, 0x65, 0x61, 0x74, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x66, 0x69, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x44, 0x46,
+	0x49, 0x12, 0x2f, 0x0a, 0x13, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x08, 0x49, 0x41, 0x54, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x41, 0x54, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x0e, 0x49, 0x41, 0x54, 0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x3d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x41, 0x54, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0f, 0x49,
+	0x41, 0x54, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x38,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x0c, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x22, 0xf7, 0x06, 0x0a, 0x0e, 0x49, 0x41, 0x54,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x61, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x49, 0x41, 0x54, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3c,
+	0x0a, 0x1a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4f, 0x0a, 0x24,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x21, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a,
+	0x1a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x18, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x69,
+	0x73, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x19, 0x49, 0x53, 0x4f, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x19,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x18, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x73, 0x74, 0x61,
+	0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x1d, 0x69, 0x73, 0x6f, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x49, 0x53, 0x4f, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x41, 0x0a, 0x1d, 0x69, 0x73, 0x6f, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1a, 0x49, 0x53, 0x4f, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2f,
+	0x0a, 0x13, 0x6f, 0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x44, 0x46,
+	0x49, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0xde, 0x08, 0x0a, 0x0e, 0x49, 0x41, 0x54, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2f, 0x0a, 0x13, 0x72, 0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x52,
+	0x44, 0x46, 0x49, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x67, 0x69, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x67,
+	0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x5f, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x41, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x66, 0x69, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x44, 0x46, 0x49, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x18, 0x6f, 0x66, 0x61, 0x63, 0x5f, 0x73, 0x63, 0x72, 0x65, 0x65, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x16, 0x4f, 0x46, 0x41, 0x43, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69,
+	0x6e, 0x67, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x22, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x6f, 0x66, 0x61, 0x63, 0x5f, 0x73, 0x63,
+	0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1f, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61,
+	0x72, 0x79, 0x4f, 0x46, 0x41, 0x43, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x18, 0x61, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x61, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61,
+	0x31, 0x30, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e,
+	0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x30,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x30, 0x12, 0x34, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x31, 0x31, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31,
+	0x31, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x32, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x32, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x32, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e,
+	0x64, 0x61, 0x31, 0x33, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f,
+	0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61,
+	0x31, 0x33, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x33, 0x12, 0x34, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x34, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x34, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64,
+	0x61, 0x31, 0x34, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x35,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x35, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x35, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x31, 0x36, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e,
+	0x64, 0x61, 0x31, 0x36, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x36, 0x12,
+	0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x37, 0x18, 0x13, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x37, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65,
+	0x6e, 0x64, 0x61, 0x31, 0x37, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61,
+	0x31, 0x38, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e,
+	0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x38,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x38, 0x12, 0x34, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x38, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64,
+	0x65, 0x6e, 0x64, 0x61, 0x39, 0x38, 0x52, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39,
+	0x38, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x65, 0x6e, 0x64, 0x61, 0x39, 0x39, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x22, 0xa9, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31,
+	0x30, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x32,
+	0x0a, 0x15, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f,
+	0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0xde, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x31, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f,
+	0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x6f, 0x72, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x83, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x32, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x43, 0x0a, 0x1e, 0x6f,
+	0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x43,
+	0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x43, 0x0a, 0x1e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xb9, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e,
+	0x64, 0x61, 0x31, 0x33, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x64, 0x66, 0x69, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x44, 0x46, 0x49, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37,
+	0x0a, 0x18, 0x6f, 0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x5f, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x4f, 0x44, 0x46, 0x49, 0x49, 0x44, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x6f, 0x64, 0x66, 0x69, 0x5f,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4f, 0x44, 0x46, 0x49, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x6f, 0x64, 0x66, 0x69,
+	0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x4f, 0x44, 0x46, 0x49,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x22, 0xb9, 0x02, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x34,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x64, 0x66, 0x69, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x52, 0x44, 0x46, 0x49, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x64,
+	0x66, 0x69, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x52, 0x44,
+	0x46, 0x49, 0x49, 0x44, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x64, 0x66, 0x69, 0x5f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x12, 0x52, 0x44, 0x46, 0x49, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x18, 0x72, 0x64, 0x66, 0x69, 0x5f, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x52, 0x44, 0x46, 0x49, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a,
+	0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xdf,
+	0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x35, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x49,
+	0x44, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0xfb, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x36, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x19, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x43, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x3f, 0x0a, 0x1c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x5f, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x19, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3f, 0x0a,
+	0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xe2,
+	0x01, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31, 0x37, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3e, 0x0a, 0x1b, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x19,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x22, 0xff, 0x03, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x6e, 0x64, 0x61, 0x31,
+	0x38, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x45,
+	0x0a, 0x1f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1c, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x43, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e,
+	0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x61, 0x0a, 0x2e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x62,
+	0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x29, 0x66,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64,
+	0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x49, 0x44, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x24, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x69, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x20, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43,
+	0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b,
+	0x49, 0x44, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x2e, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x64, 0x65, 0x6e,
+	0x74, 0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x29, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6e, 0x6b, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x1c, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x9b, 0x01, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e,
+	0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x4f, 0x70, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f,
+	0x61, 0x62, 0x61, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x41, 0x42, 0x41, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x4f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1d,
+	0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x1b, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x32, 0x87, 0x08, 0x0a, 0x03, 0x41, 0x43, 0x48, 0x12, 0x4d, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x1d, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d,
+	0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x30, 0x01, 0x12, 0x53, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e,
+	0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76,
+	0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0e, 0x46,
+	0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x22, 0x2e,
+	0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x61, 0x74,
+	0x74, 0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x50, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63, 0x68,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61, 0x63,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e, 0x61,
+	0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x6f, 0x6f, 0x76, 0x2e,
+	0x61, 0x63, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x6f, 0x6f, 0x76, 0x2d, 0x69, 0x6f,
+	0x2f, 0x61, 0x63, 0x68, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ach_proto_rawDescOnce sync.Once
+	file_ach_proto_rawDescData = file_ach_proto_rawDesc
+)
+
+func file_ach_proto_rawDescGZIP() []byte {
+	file_ach_proto_rawDescOnce.Do(func() {
+		file_ach_proto_rawDescData = protoimpl.X.CompressGZIP(file_ach_proto_rawDescData)
+	})
+	return file_ach_proto_rawDescData
+}
+
+var file_ach_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
+var file_ach_proto_goTypes = []interface{}{
+	(*CreateFileRequest)(nil),      // 0: moov.ach.v1.CreateFileRequest
+	(*CreateFileResponse)(nil),     // 1: moov.ach.v1.CreateFileResponse
+	(*GetFileRequest)(nil),         // 2: moov.ach.v1.GetFileRequest
+	(*ListFilesRequest)(nil),       // 3: moov.ach.v1.ListFilesRequest
+	(*ListFilesResponse)(nil),      // 4: moov.ach.v1.ListFilesResponse
+	(*DeleteFileRequest)(nil),      // 5: moov.ach.v1.DeleteFileRequest
+	(*DeleteFileResponse)(nil),     // 6: moov.ach.v1.DeleteFileResponse
+	(*GetFileContentsRequest)(nil), // 7: moov.ach.v1.GetFileContentsRequest
+	(*FileContents)(nil),           // 8: moov.ach.v1.FileContents
+	(*ValidateFileRequest)(nil),    // 9: moov.ach.v1.ValidateFileRequest
+	(*ValidateFileResponse)(nil),   // 10: moov.ach.v1.ValidateFileResponse
+	(*BalanceFileRequest)(nil),     // 11: moov.ach.v1.BalanceFileRequest
+	(*BalanceFileResponse)(nil),    // 12: moov.ach.v1.BalanceFileResponse
+	(*SegmentFileRequest)(nil),     // 13: moov.ach.v1.SegmentFileRequest
+	(*SegmentFileResponse)(nil),    // 14: moov.ach.v1.SegmentFileResponse
+	(*FlattenBatchesRequest)(nil),  // 15: moov.ach.v1.FlattenBatchesRequest
+	(*FlattenBatchesResponse)(nil), // 16: moov.ach.v1.FlattenBatchesResponse
+	(*CreateBatchRequest)(nil),     // 17: moov.ach.v1.CreateBatchRequest
+	(*CreateBatchResponse)(nil),    // 18: moov.ach.v1.CreateBatchResponse
+	(*GetBatchRequest)(nil),        // 19: moov.ach.v1.GetBatchRequest
+	(*ListBatchesRequest)(nil),     // 20: moov.ach.v1.ListBatchesRequest
+	(*ListBatchesResponse)(nil),    // 21: moov.ach.v1.ListBatchesResponse
+	(*DeleteBatchRequest)(nil),     // 22: moov.ach.v1.DeleteBatchRequest
+	(*DeleteBatchResponse)(nil),    // 23: moov.ach.v1.DeleteBatchResponse
+	(*File)(nil),                   // 24: moov.ach.v1.File
+	(*FileHeader)(nil),             // 25: moov.ach.v1.FileHeader
+	(*FileControl)(nil),            // 26: moov.ach.v1.FileControl
+	(*ADVFileControl)(nil),         // 27: moov.ach.v1.ADVFileControl
+	(*Batch)(nil),                  // 28: moov.ach.v1.Batch
+	(*BatchHeader)(nil),            // 29: moov.ach.v1.BatchHeader
+	(*BatchControl)(nil),           // 30: moov.ach.v1.BatchControl
+	(*ADVBatchControl)(nil),        // 31: moov.ach.v1.ADVBatchControl
+	(*EntryDetail)(nil),            // 32: moov.ach.v1.EntryDetail
+	(*ADVEntryDetail)(nil),         // 33: moov.ach.v1.ADVEntryDetail
+	(*Addenda02)(nil),              // 34: moov.ach.v1.Addenda02
+	(*Addenda05)(nil),              // 35: moov.ach.v1.Addenda05
+	(*Addenda98)(nil),              // 36: moov.ach.v1.Addenda98
+	(*Addenda99)(nil),              // 37: moov.ach.v1.Addenda99
+	(*IATBatch)(nil),               // 38: moov.ach.v1.IATBatch
+	(*IATBatchHeader)(nil),         // 39: moov.ach.v1.IATBatchHeader
+	(*IATEntryDetail)(nil),         // 40: moov.ach.v1.IATEntryDetail
+	(*Addenda10)(nil),              // 41: moov.ach.v1.Addenda10
+	(*Addenda11)(nil),              // 42: moov.ach.v1.Addenda11
+	(*Addenda12)(nil),              // 43: moov.ach.v1.Addenda12
+	(*Addenda13)(nil),              // 44: moov.ach.v1.Addenda13
+	(*Addenda14)(nil),              // 45: moov.ach.v1.Addenda14
+	(*Addenda15)(nil),              // 46: moov.ach.v1.Addenda15
+	(*Addenda16)(nil),              // 47: moov.ach.v1.Addenda16
+	(*Addenda17)(nil),              // 48: moov.ach.v1.Addenda17
+	(*Addenda18)(nil),              // 49: moov.ach.v1.Addenda18
+	(*Offset)(nil),                 // 50: moov.ach.v1.Offset
+	(*ValidateOpts)(nil),           // 51: moov.ach.v1.ValidateOpts
+}
+var file_ach_proto_depIdxs = []int32{
+	24, // 0: moov.ach.v1.CreateFileRequest.file:type_name -> moov.ach.v1.File
+	24, // 1: moov.ach.v1.ListFilesResponse.files:type_name -> moov.ach.v1.File
+	51, // 2: moov.ach.v1.ValidateFileRequest.opts:type_name -> moov.ach.v1.ValidateOpts
+	50, // 3: moov.ach.v1.BalanceFileRequest.offset:type_name -> moov.ach.v1.Offset
+	28, // 4: moov.ach.v1.CreateBatchRequest.batch:type_name -> moov.ach.v1.Batch
+	28, // 5: moov.ach.v1.ListBatchesResponse.batches:type_name -> moov.ach.v1.Batch
+	25, // 6: moov.ach.v1.File.header:type_name -> moov.ach.v1.FileHeader
+	28, // 7: moov.ach.v1.File.batches:type_name -> moov.ach.v1.Batch
+	38, // 8: moov.ach.v1.File.iat_batches:type_name -> moov.ach.v1.IATBatch
+	26, // 9: moov.ach.v1.File.control:type_name -> moov.ach.v1.FileControl
+	27, // 10: moov.ach.v1.File.adv_control:type_name -> moov.ach.v1.ADVFileControl
+	28, // 11: moov.ach.v1.File.notification_of_change:type_name -> moov.ach.v1.Batch
+	28, // 12: moov.ach.v1.File.return_entries:type_name -> moov.ach.v1.Batch
+	29, // 13: moov.ach.v1.Batch.header:type_name -> moov.ach.v1.BatchHeader
+	32, // 14: moov.ach.v1.Batch.entries:type_name -> moov.ach.v1.EntryDetail
+	30, // 15: moov.ach.v1.Batch.control:type_name -> moov.ach.v1.BatchControl
+	33, // 16: moov.ach.v1.Batch.adv_entries:type_name -> moov.ach.v1.ADVEntryDetail
+	31, // 17: moov.ach.v1.Batch.adv_control:type_name -> moov.ach.v1.ADVBatchControl
+	50, // 18: moov.ach.v1.Batch.offset:type_name -> moov.ach.v1.Offset
+	34, // 19: moov.ach.v1.EntryDetail.addenda02:type_name -> moov.ach.v1.Addenda02
+	35, // 20: moov.ach.v1.EntryDetail.addenda05:type_name -> moov.ach.v1.Addenda05
+	36, // 21: moov.ach.v1.EntryDetail.addenda98:type_name -> moov.ach.v1.Addenda98
+	37, // 22: moov.ach.v1.EntryDetail.addenda99:type_name -> moov.ach.v1.Addenda99
+	37, // 23: moov.ach.v1.ADVEntryDetail.addenda99:type_name -> moov.ach.v1.Addenda99
+	39, // 24: moov.ach.v1.IATBatch.header:type_name -> moov.ach.v1.IATBatchHeader
+	40, // 25: moov.ach.v1.IATBatch.entries:type_name -> moov.ach.v1.IATEntryDetail
+	30, // 26: moov.ach.v1.IATBatch.control:type_name -> moov.ach.v1.BatchControl
+	41, // 27: moov.ach.v1.IATEntryDetail.addenda10:type_name -> moov.ach.v1.Addenda10
+	42, // 28: moov.ach.v1.IATEntryDetail.addenda11:type_name -> moov.ach.v1.Addenda11
+	43, // 29: moov.ach.v1.IATEntryDetail.addenda12:type_name -> moov.ach.v1.Addenda12
+	44, // 30: moov.ach.v1.IATEntryDetail.addenda13:type_name -> moov.ach.v1.Addenda13
+	45, // 31: moov.ach.v1.IATEntryDetail.addenda14:type_name -> moov.ach.v1.Addenda14
+	46, // 32: moov.ach.v1.IATEntryDetail.addenda15:type_name -> moov.ach.v1.Addenda15
+	47, // 33: moov.ach.v1.IATEntryDetail.addenda16:type_name -> moov.ach.v1.Addenda16
+	48, // 34: moov.ach.v1.IATEntryDetail.addenda17:type_name -> moov.ach.v1.Addenda17
+	49, // 35: moov.ach.v1.IATEntryDetail.addenda18:type_name -> moov.ach.v1.Addenda18
+	36, // 36: moov.ach.v1.IATEntryDetail.addenda98:type_name -> moov.ach.v1.Addenda98
+	37, // 37: moov.ach.v1.IATEntryDetail.addenda99:type_name -> moov.ach.v1.Addenda99
+	0,  // 38: moov.ach.v1.ACH.CreateFile:input_type -> moov.ach.v1.CreateFileRequest
+	2,  // 39: moov.ach.v1.ACH.GetFile:input_type -> moov.ach.v1.GetFileRequest
+	3,  // 40: moov.ach.v1.ACH.ListFiles:input_type -> moov.ach.v1.ListFilesRequest
+	5,  // 41: moov.ach.v1.ACH.DeleteFile:input_type -> moov.ach.v1.DeleteFileRequest
+	7,  // 42: moov.ach.v1.ACH.GetFileContents:input_type -> moov.ach.v1.GetFileContentsRequest
+	9,  // 43: moov.ach.v1.ACH.ValidateFile:input_type -> moov.ach.v1.ValidateFileRequest
+	11, // 44: moov.ach.v1.ACH.BalanceFile:input_type -> moov.ach.v1.BalanceFileRequest
+	13, // 45: moov.ach.v1.ACH.SegmentFile:input_type -> moov.ach.v1.SegmentFileRequest
+	15, // 46: moov.ach.v1.ACH.FlattenBatches:input_type -> moov.ach.v1.FlattenBatchesRequest
+	17, // 47: moov.ach.v1.ACH.CreateBatch:input_type -> moov.ach.v1.CreateBatchRequest
+	19, // 48: moov.ach.v1.ACH.GetBatch:input_type -> moov.ach.v1.GetBatchRequest
+	20, // 49: moov.ach.v1.ACH.ListBatches:input_type -> moov.ach.v1.ListBatchesRequest
+	22, // 50: moov.ach.v1.ACH.DeleteBatch:input_type -> moov.ach.v1.DeleteBatchRequest
+	1,  // 51: moov.ach.v1.ACH.CreateFile:output_type -> moov.ach.v1.CreateFileResponse
+	24, // 52: moov.ach.v1.ACH.GetFile:output_type -> moov.ach.v1.File
+	4,  // 53: moov.ach.v1.ACH.ListFiles:output_type -> moov.ach.v1.ListFilesResponse
+	6,  // 54: moov.ach.v1.ACH.DeleteFile:output_type -> moov.ach.v1.DeleteFileResponse
+	8,  // 55: moov.ach.v1.ACH.GetFileContents:output_type -> moov.ach.v1.FileContents
+	10, // 56: moov.ach.v1.ACH.ValidateFile:output_type -> moov.ach.v1.ValidateFileResponse
+	12, // 57: moov.ach.v1.ACH.BalanceFile:output_type -> moov.ach.v1.BalanceFileResponse
+	14, // 58: moov.ach.v1.ACH.SegmentFile:output_type -> moov.ach.v1.SegmentFileResponse
+	16, // 59: moov.ach.v1.ACH.FlattenBatches:output_type -> moov.ach.v1.FlattenBatchesResponse
+	18, // 60: moov.ach.v1.ACH.CreateBatch:output_type -> moov.ach.v1.CreateBatchResponse
+	28, // 61: moov.ach.v1.ACH.GetBatch:output_type -> moov.ach.v1.Batch
+	21, // 62: moov.ach.v1.ACH.ListBatches:output_type -> moov.ach.v1.ListBatchesResponse
+	23, // 63: moov.ach.v1.ACH.DeleteBatch:output_type -> moov.ach.v1.DeleteBatchResponse
+	51, // [51:64] is the sub-list for method output_type
+	38, // [38:51] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_ach_proto_init() }
+func file_ach_proto_init() {
+	if File_ach_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ach_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileContentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileContents); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlattenBatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlattenBatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBatchesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADVFileControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Batch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADVBatchControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntryDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ADVEntryDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda02); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda05); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda98); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda99); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IATBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IATBatchHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IATEntryDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda10); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda11); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda12); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda13); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda14); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda15); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda16); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda17); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Addenda18); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Offset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ach_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateOpts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ach_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*CreateFileRequest_File)(nil),
+		(*CreateFileRequest_Contents)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ach_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   52,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ach_proto_goTypes,
+		DependencyIndexes: file_ach_proto_depIdxs,
+		MessageInfos:      file_ach_proto_msgTypes,
+	}.Build()
+	File_ach_proto = out.File
+	file_ach_proto_rawDesc = nil
+	file_ach_proto_goTypes = nil
+	file_ach_proto_depIdxs = nil
+}
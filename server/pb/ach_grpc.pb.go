@@ -0,0 +1,559 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// ACHClient is the client API for ACH service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ACHClient interface {
+	CreateFile(ctx context.Context, in *CreateFileRequest, opts ...grpc.CallOption) (*CreateFileResponse, error)
+	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*File, error)
+	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error)
+	// GetFileContents streams the NACHA formatted file in chunks
+	GetFileContents(ctx context.Context, in *GetFileContentsRequest, opts ...grpc.CallOption) (ACH_GetFileContentsClient, error)
+	ValidateFile(ctx context.Context, in *ValidateFileRequest, opts ...grpc.CallOption) (*ValidateFileResponse, error)
+	BalanceFile(ctx context.Context, in *BalanceFileRequest, opts ...grpc.CallOption) (*BalanceFileResponse, error)
+	SegmentFile(ctx context.Context, in *SegmentFileRequest, opts ...grpc.CallOption) (*SegmentFileResponse, error)
+	FlattenBatches(ctx context.Context, in *FlattenBatchesRequest, opts ...grpc.CallOption) (*FlattenBatchesResponse, error)
+	CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error)
+	GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*Batch, error)
+	ListBatches(ctx context.Context, in *ListBatchesRequest, opts ...grpc.CallOption) (*ListBatchesResponse, error)
+	DeleteBatch(ctx context.Context, in *DeleteBatchRequest, opts ...grpc.CallOption) (*DeleteBatchResponse, error)
+}
+
+type aCHClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewACHClient(cc grpc.ClientConnInterface) ACHClient {
+	return &aCHClient{cc}
+}
+
+func (c *aCHClient) CreateFile(ctx context.Context, in *CreateFileRequest, opts ...grpc.CallOption) (*CreateFileResponse, error) {
+	out := new(CreateFileResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/CreateFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*File, error) {
+	out := new(File)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/GetFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
+	out := new(ListFilesResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/ListFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*DeleteFileResponse, error) {
+	out := new(DeleteFileResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/DeleteFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) GetFileContents(ctx context.Context, in *GetFileContentsRequest, opts ...grpc.CallOption) (ACH_GetFileContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ACH_serviceDesc.Streams[0], "/moov.ach.v1.ACH/GetFileContents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aCHGetFileContentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ACH_GetFileContentsClient interface {
+	Recv() (*FileContents, error)
+	grpc.ClientStream
+}
+
+type aCHGetFileContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aCHGetFileContentsClient) Recv() (*FileContents, error) {
+	m := new(FileContents)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aCHClient) ValidateFile(ctx context.Context, in *ValidateFileRequest, opts ...grpc.CallOption) (*ValidateFileResponse, error) {
+	out := new(ValidateFileResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/ValidateFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) BalanceFile(ctx context.Context, in *BalanceFileRequest, opts ...grpc.CallOption) (*BalanceFileResponse, error) {
+	out := new(BalanceFileResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/BalanceFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) SegmentFile(ctx context.Context, in *SegmentFileRequest, opts ...grpc.CallOption) (*SegmentFileResponse, error) {
+	out := new(SegmentFileResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/SegmentFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) FlattenBatches(ctx context.Context, in *FlattenBatchesRequest, opts ...grpc.CallOption) (*FlattenBatchesResponse, error) {
+	out := new(FlattenBatchesResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/FlattenBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) CreateBatch(ctx context.Context, in *CreateBatchRequest, opts ...grpc.CallOption) (*CreateBatchResponse, error) {
+	out := new(CreateBatchResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/CreateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) GetBatch(ctx context.Context, in *GetBatchRequest, opts ...grpc.CallOption) (*Batch, error) {
+	out := new(Batch)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/GetBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) ListBatches(ctx context.Context, in *ListBatchesRequest, opts ...grpc.CallOption) (*ListBatchesResponse, error) {
+	out := new(ListBatchesResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/ListBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aCHClient) DeleteBatch(ctx context.Context, in *DeleteBatchRequest, opts ...grpc.CallOption) (*DeleteBatchResponse, error) {
+	out := new(DeleteBatchResponse)
+	err := c.cc.Invoke(ctx, "/moov.ach.v1.ACH/DeleteBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ACHServer is the server API for ACH service.
+// All implementations must embed UnimplementedACHServer
+// for forward compatibility
+type ACHServer interface {
+	CreateFile(context.Context, *CreateFileRequest) (*CreateFileResponse, error)
+	GetFile(context.Context, *GetFileRequest) (*File, error)
+	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
+	DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error)
+	// GetFileContents streams the NACHA formatted file in chunks
+	GetFileContents(*GetFileContentsRequest, ACH_GetFileContentsServer) error
+	ValidateFile(context.Context, *ValidateFileRequest) (*ValidateFileResponse, error)
+	BalanceFile(context.Context, *BalanceFileRequest) (*BalanceFileResponse, error)
+	SegmentFile(context.Context, *SegmentFileRequest) (*SegmentFileResponse, error)
+	FlattenBatches(context.Context, *FlattenBatchesRequest) (*FlattenBatchesResponse, error)
+	CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error)
+	GetBatch(context.Context, *GetBatchRequest) (*Batch, error)
+	ListBatches(context.Context, *ListBatchesRequest) (*ListBatchesResponse, error)
+	DeleteBatch(context.Context, *DeleteBatchRequest) (*DeleteBatchResponse, error)
+	mustEmbedUnimplementedACHServer()
+}
+
+// UnimplementedACHServer must be embedded to have forward compatible implementations.
+type UnimplementedACHServer struct {
+}
+
+func (UnimplementedACHServer) CreateFile(context.Context, *CreateFileRequest) (*CreateFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFile not implemented")
+}
+func (UnimplementedACHServer) GetFile(context.Context, *GetFileRequest) (*File, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedACHServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
+}
+func (UnimplementedACHServer) DeleteFile(context.Context, *DeleteFileRequest) (*DeleteFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFile not implemented")
+}
+func (UnimplementedACHServer) GetFileContents(*GetFileContentsRequest, ACH_GetFileContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFileContents not implemented")
+}
+func (UnimplementedACHServer) ValidateFile(context.Context, *ValidateFileRequest) (*ValidateFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateFile not implemented")
+}
+func (UnimplementedACHServer) BalanceFile(context.Context, *BalanceFileRequest) (*BalanceFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalanceFile not implemented")
+}
+func (UnimplementedACHServer) SegmentFile(context.Context, *SegmentFileRequest) (*SegmentFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SegmentFile not implemented")
+}
+func (UnimplementedACHServer) FlattenBatches(context.Context, *FlattenBatchesRequest) (*FlattenBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlattenBatches not implemented")
+}
+func (UnimplementedACHServer) CreateBatch(context.Context, *CreateBatchRequest) (*CreateBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatch not implemented")
+}
+func (UnimplementedACHServer) GetBatch(context.Context, *GetBatchRequest) (*Batch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatch not implemented")
+}
+func (UnimplementedACHServer) ListBatches(context.Context, *ListBatchesRequest) (*ListBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatches not implemented")
+}
+func (UnimplementedACHServer) DeleteBatch(context.Context, *DeleteBatchRequest) (*DeleteBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBatch not implemented")
+}
+func (UnimplementedACHServer) mustEmbedUnimplementedACHServer() {}
+
+// UnsafeACHServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ACHServer will
+// result in compilation errors.
+type UnsafeACHServer interface {
+	mustEmbedUnimplementedACHServer()
+}
+
+func RegisterACHServer(s grpc.ServiceRegistrar, srv ACHServer) {
+	s.RegisterService(&_ACH_serviceDesc, srv)
+}
+
+func _ACH_CreateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).CreateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/CreateFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).CreateFile(ctx, req.(*CreateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_GetFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).GetFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/GetFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).GetFile(ctx, req.(*GetFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/ListFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).ListFiles(ctx, req.(*ListFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).DeleteFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/DeleteFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).DeleteFile(ctx, req.(*DeleteFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_GetFileContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFileContentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ACHServer).GetFileContents(m, &aCHGetFileContentsServer{stream})
+}
+
+type ACH_GetFileContentsServer interface {
+	Send(*FileContents) error
+	grpc.ServerStream
+}
+
+type aCHGetFileContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aCHGetFileContentsServer) Send(m *FileContents) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ACH_ValidateFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).ValidateFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/ValidateFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).ValidateFile(ctx, req.(*ValidateFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_BalanceFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BalanceFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).BalanceFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/BalanceFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).BalanceFile(ctx, req.(*BalanceFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_SegmentFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).SegmentFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/SegmentFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).SegmentFile(ctx, req.(*SegmentFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_FlattenBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlattenBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).FlattenBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/FlattenBatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).FlattenBatches(ctx, req.(*FlattenBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).CreateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/CreateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).CreateBatch(ctx, req.(*CreateBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_GetBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).GetBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/GetBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).GetBatch(ctx, req.(*GetBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_ListBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).ListBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/ListBatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).ListBatches(ctx, req.(*ListBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ACH_DeleteBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ACHServer).DeleteBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/moov.ach.v1.ACH/DeleteBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ACHServer).DeleteBatch(ctx, req.(*DeleteBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ACH_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "moov.ach.v1.ACH",
+	HandlerType: (*ACHServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateFile",
+			Handler:    _ACH_CreateFile_Handler,
+		},
+		{
+			MethodName: "GetFile",
+			Handler:    _ACH_GetFile_Handler,
+		},
+		{
+			MethodName: "ListFiles",
+			Handler:    _ACH_ListFiles_Handler,
+		},
+		{
+			MethodName: "DeleteFile",
+			Handler:    _ACH_DeleteFile_Handler,
+		},
+		{
+			MethodName: "ValidateFile",
+			Handler:    _ACH_ValidateFile_Handler,
+		},
+		{
+			MethodName: "BalanceFile",
+			Handler:    _ACH_BalanceFile_Handler,
+		},
+		{
+			MethodName: "SegmentFile",
+			Handler:    _ACH_SegmentFile_Handler,
+		},
+		{
+			MethodName: "FlattenBatches",
+			Handler:    _ACH_FlattenBatches_Handler,
+		},
+		{
+			MethodName: "CreateBatch",
+			Handler:    _ACH_CreateBatch_Handler,
+		},
+		{
+			MethodName: "GetBatch",
+			Handler:    _ACH_GetBatch_Handler,
+		},
+		{
+			MethodName: "ListBatches",
+			Handler:    _ACH_ListBatches_Handler,
+		},
+		{
+			MethodName: "DeleteBatch",
+			Handler:    _ACH_DeleteBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetFileContents",
+			Handler:       _ACH_GetFileContents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ach.proto",
+}
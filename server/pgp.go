@@ -0,0 +1,64 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/ach/pgp"
+)
+
+// pgpContentType is used for OpenPGP encrypted files (RFC 3156)
+const pgpContentType = "application/pgp-encrypted"
+
+var errPGPNotConfigured = errors.New("OpenPGP keys are not configured")
+
+// decryptFile returns the decrypted contents of an uploaded file
+func decryptFile(keys *pgp.Config, bs []byte) ([]byte, error) {
+	if keys == nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidFile, errPGPNotConfigured)
+	}
+	out, err := keys.Decrypt(bytes.NewReader(bs))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidFile, err)
+	}
+	return out, nil
+}
+
+// getFileContentsPGP writes a file encrypted for the public keys, see pgp.Writer
+func getFileContentsPGP(s Service, keys *pgp.Config, id string) (io.Reader, error) {
+	if keys == nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidFile, errPGPNotConfigured)
+	}
+	f, err := s.GetFile(id)
+	if err != nil {
+		return nil, fmt.Errorf("problem reading file %s: %v", id, err)
+	}
+	if err := f.Create(); err != nil {
+		return nil, fmt.Errorf("problem creating file %s: %v", id, err)
+	}
+
+	var buf bytes.Buffer
+	if err := pgp.NewWriter(&buf, keys).Write(f); err != nil {
+		return nil, fmt.Errorf("problem encrypting file %s: %v", id, err)
+	}
+	return fileContents{Reader: &buf, contentType: pgpContentType}, nil
+}
@@ -0,0 +1,152 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/pgp"
+
+	"github.com/go-kit/kit/log"
+)
+
+func testPGPConfig(t *testing.T) *pgp.Config {
+	t.Helper()
+
+	dir := filepath.Join("..", "test", "testdata")
+	cfg, err := pgp.LoadConfig(filepath.Join(dir, "pgp-originator.pub"), filepath.Join(dir, "pgp-originator.key"), []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.RequireSignature = true
+	return cfg
+}
+
+func TestFiles__EncryptFiles(t *testing.T) {
+	keys := testPGPConfig(t)
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), EncryptFiles(keys))
+
+	file, err := ach.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var encrypted bytes.Buffer
+	if err := pgp.NewWriter(&encrypted, keys).Write(file); err != nil {
+		t.Fatal(err)
+	}
+
+	// upload the encrypted file
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(encrypted.Bytes()))
+	req.Header.Set("Content-Type", "application/pgp-encrypted")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := repo.FindFile(created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.Header.ImmediateOrigin != file.Header.ImmediateOrigin || len(stored.Batches) != 1 {
+		t.Errorf("unexpected file: %#v", stored)
+	}
+
+	// download it encrypted
+	req = httptest.NewRequest("GET", "/files/"+created.ID+"/contents?format=pgp", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if v := w.Header().Get("Content-Type"); v != "application/pgp-encrypted" {
+		t.Errorf("unexpected Content-Type: %s", v)
+	}
+	got, err := pgp.NewReader(w.Body, keys).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Header.ImmediateOrigin != file.Header.ImmediateOrigin {
+		t.Errorf("unexpected file: %#v", got)
+	}
+
+	// unsigned uploads are rejected
+	unsigned := &pgp.Config{PublicKeys: keys.PublicKeys}
+	encrypted.Reset()
+	if err := pgp.NewWriter(&encrypted, unsigned).Write(file); err != nil {
+		t.Fatal(err)
+	}
+	req = httptest.NewRequest("POST", "/files/create", &encrypted)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "not signed") {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestFiles__EncryptFilesNotConfigured(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+
+	req := httptest.NewRequest("POST", "/files/create", strings.NewReader("-----BEGIN PGP MESSAGE-----\n"))
+	req.Header.Set("Content-Type", "application/pgp-encrypted")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "OpenPGP keys are not configured") {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+	if files := repo.FindAllFiles(); len(files) != 0 {
+		t.Errorf("unexpected files: %d", len(files))
+	}
+
+	file, err := ach.ReadFile(filepath.Join("..", "test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.ID = "plain"
+	if err := repo.StoreFile(file); err != nil {
+		t.Fatal(err)
+	}
+	req = httptest.NewRequest("GET", "/files/plain/contents?format=pgp", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status code: %d: %s", w.Code, w.Body.String())
+	}
+}
@@ -33,10 +33,12 @@ type Repository interface {
 	StoreFile(file *ach.File) error
 	FindFile(id string) (*ach.File, error)
 	FindAllFiles() []*ach.File
+	FindFiles(query FileQuery) ([]*ach.File, string, error)
 	DeleteFile(id string) error
 	StoreBatch(fileID string, batch ach.Batcher) error
 	FindBatch(fileID string, batchID string) (ach.Batcher, error)
 	FindAllBatches(fileID string) []ach.Batcher
+	UpdateBatch(fileID string, batch ach.Batcher) error
 	DeleteBatch(fileID string, batchID string) error
 }
 
@@ -47,6 +49,7 @@ type repositoryInMemory struct {
 	ttl time.Duration
 
 	logger log.Logger
+	expiryHooks
 }
 
 // NewRepositoryInMemory is an in memory ach storage repository for files
@@ -108,6 +111,11 @@ func (r *repositoryInMemory) FindAllFiles() []*ach.File {
 	return files
 }
 
+// FindFiles returns a page of files selected by the query and the cursor of the next page
+func (r *repositoryInMemory) FindFiles(query FileQuery) ([]*ach.File, string, error) {
+	return queryFiles(r.FindAllFiles(), query)
+}
+
 func (r *repositoryInMemory) DeleteFile(id string) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
@@ -174,6 +182,24 @@ func (r *repositoryInMemory) FindAllBatches(fileID string) []ach.Batcher {
 	return batches
 }
 
+// UpdateBatch replaces the batch with the same ID, keeping its position in the file
+func (r *repositoryInMemory) UpdateBatch(fileID string, batch ach.Batcher) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	file, ok := r.files[fileID]
+	if !ok || file == nil {
+		return ErrNotFound
+	}
+	for i := range file.Batches {
+		if file.Batches[i].ID() == batch.ID() {
+			file.Batches[i] = batch
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
 func (r *repositoryInMemory) DeleteBatch(fileID string, batchID string) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
@@ -207,6 +233,7 @@ func (r *repositoryInMemory) cleanupOldFiles() {
 		if r.files[i].Header.FileCreationDate < tooOldStr {
 			removed++
 			delete(r.files, i)
+			r.expired(i)
 		}
 	}
 
@@ -214,3 +241,24 @@ func (r *repositoryInMemory) cleanupOldFiles() {
 		r.logger.Log("files", fmt.Sprintf("removed %d ACH files older than %v", removed, tooOld.Format(time.RFC3339)))
 	}
 }
+
+// expiryHooks holds the functions called with the ID of each file a repository removes after its TTL
+type expiryHooks struct {
+	mu  sync.Mutex
+	fns []func(fileID string)
+}
+
+func (h *expiryHooks) onExpired(fn func(fileID string)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fns = append(h.fns, fn)
+}
+
+func (h *expiryHooks) expired(fileID string) {
+	h.mu.Lock()
+	fns := h.fns
+	h.mu.Unlock()
+	for i := range fns {
+		fns[i](fileID)
+	}
+}
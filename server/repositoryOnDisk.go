@@ -0,0 +1,391 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/ach"
+
+	"github.com/go-kit/kit/log"
+)
+
+const (
+	diskFileExt     = ".json"
+	diskTempPrefix  = ".tmp-"
+	diskCorruptExt  = ".corrupt"
+	diskLockName    = ".lock"
+	diskPermissions = 0600
+)
+
+// repositoryOnDisk stores each file as JSON in a directory. Writes are atomic (a temporary
+// file is renamed over the old one) and a lock file serializes access between processes
+// sharing the directory, so several servers can use the same (local or network) volume.
+type repositoryOnDisk struct {
+	dir string
+
+	// mu serializes access within this process, lock between processes
+	mu   sync.RWMutex
+	lock *fileLock
+
+	ttl    time.Duration
+	logger log.Logger
+	expiryHooks
+}
+
+// NewRepositoryOnDisk returns a Repository which stores files in dir so they survive restarts.
+// Files are written as JSON and read with ach.FileFromJSON. Like NewRepositoryInMemory, files
+// whose FileCreationDate is older than ttl are removed (a ttl of zero keeps files forever).
+//
+// Temporary files left by a crash are removed and files which aren't valid JSON are renamed
+// with a '.corrupt' extension when the repository is opened. Files which are JSON but can't be
+// read as an ach.File, such as those failing newer validation rules, are logged and left alone.
+func NewRepositoryOnDisk(dir string, ttl time.Duration, logger log.Logger) (Repository, error) {
+	if dir == "" {
+		return nil, errors.New("no directory provided")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("problem creating %s: %v", dir, err)
+	}
+	lock, err := openFileLock(filepath.Join(dir, diskLockName))
+	if err != nil {
+		return nil, fmt.Errorf("problem opening lock file: %v", err)
+	}
+	repo := &repositoryOnDisk{
+		dir:    dir,
+		lock:   lock,
+		ttl:    ttl,
+		logger: logger,
+	}
+	if err := repo.recover(); err != nil {
+		return nil, err
+	}
+
+	if ttl <= 0*time.Second {
+		// Don't run the cleanup if we've disabled the TTL
+		return repo, nil
+	}
+
+	// Run our anon goroutine to cleanup old ACH files
+	go func() {
+		t := time.NewTicker(1 * time.Minute)
+		for range t.C {
+			repo.cleanupOldFiles()
+		}
+	}()
+
+	return repo, nil
+}
+
+func (r *repositoryOnDisk) log(keyvals ...interface{}) {
+	if r.logger != nil {
+		r.logger.Log(keyvals...)
+	}
+}
+
+// rLock and wLock take the in-process and cross-process locks
+func (r *repositoryOnDisk) rLock() func() {
+	r.mu.RLock()
+	if err := r.lock.rLock(); err != nil {
+		r.log("files", fmt.Sprintf("problem locking %s: %v", r.dir, err))
+	}
+	return func() {
+		r.lock.unlock()
+		r.mu.RUnlock()
+	}
+}
+
+func (r *repositoryOnDisk) wLock() func() {
+	r.mu.Lock()
+	if err := r.lock.wLock(); err != nil {
+		r.log("files", fmt.Sprintf("problem locking %s: %v", r.dir, err))
+	}
+	return func() {
+		r.lock.unlock()
+		r.mu.Unlock()
+	}
+}
+
+// recover removes temporary files from interrupted writes and sets aside files which can't be parsed
+func (r *repositoryOnDisk) recover() error {
+	defer r.wLock()()
+
+	entries, err := ioutil.ReadDir(r.dir)
+	if err != nil {
+		return fmt.Errorf("problem reading %s: %v", r.dir, err)
+	}
+	for _, e := range entries {
+		path := filepath.Join(r.dir, e.Name())
+		switch {
+		case strings.HasPrefix(e.Name(), diskTempPrefix):
+			if err := os.Remove(path); err != nil {
+				return err
+			}
+			r.log("files", fmt.Sprintf("removed incomplete write %s", path))
+
+		case strings.HasSuffix(e.Name(), diskFileExt):
+			bs, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			if !json.Valid(bs) {
+				if err := os.Rename(path, path+diskCorruptExt); err != nil {
+					return err
+				}
+				r.log("files", fmt.Sprintf("moved unparsable file %s aside", path))
+				continue
+			}
+			if _, err := r.decode(path, bs); err != nil {
+				r.log("files", fmt.Sprintf("skipping unreadable file %s: %v", path, err))
+			}
+		}
+	}
+	return nil
+}
+
+// path returns where the file with id is stored. IDs are encoded so they're always a valid filename.
+func (r *repositoryOnDisk) path(id string) string {
+	return filepath.Join(r.dir, base64.RawURLEncoding.EncodeToString([]byte(id))+diskFileExt)
+}
+
+func (r *repositoryOnDisk) read(id string) (*ach.File, error) {
+	f, err := r.readPath(r.path(id))
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+func (r *repositoryOnDisk) readPath(path string) (*ach.File, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return r.decode(path, bs)
+}
+
+func (r *repositoryOnDisk) decode(path string, bs []byte) (*ach.File, error) {
+	// Invalid files are stored (see createFileEndpoint) so only fail if nothing was read
+	file, err := ach.FileFromJSON(bs)
+	if file == nil {
+		return nil, fmt.Errorf("problem reading %s: %v", path, err)
+	}
+	// Batch IDs aren't written in JSON, but match their header (see Service.CreateBatch)
+	for _, b := range file.Batches {
+		if b.ID() == "" {
+			b.SetID(b.GetHeader().ID)
+		}
+	}
+	return file, nil
+}
+
+// write atomically replaces the stored file
+func (r *repositoryOnDisk) write(f *ach.File) error {
+	bs, err := json.Marshal(f)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(r.dir, diskTempPrefix)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(bs); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), diskPermissions); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), r.path(f.ID))
+}
+
+func (r *repositoryOnDisk) StoreFile(f *ach.File) error {
+	if f == nil {
+		return errors.New("nil ACH file provided")
+	}
+
+	defer r.wLock()()
+	if _, err := os.Stat(r.path(f.ID)); err == nil {
+		return ErrAlreadyExists
+	}
+	return r.write(f)
+}
+
+// FindFile retrieves a ach.File based on the supplied ID
+func (r *repositoryOnDisk) FindFile(id string) (*ach.File, error) {
+	defer r.rLock()()
+	return r.read(id)
+}
+
+// FindAllFiles returns all files stored in the directory
+func (r *repositoryOnDisk) FindAllFiles() []*ach.File {
+	defer r.rLock()()
+	return r.readAll()
+}
+
+// FindFiles returns a page of files selected by the query and the cursor of the next page
+func (r *repositoryOnDisk) FindFiles(query FileQuery) ([]*ach.File, string, error) {
+	return queryFiles(r.FindAllFiles(), query)
+}
+
+func (r *repositoryOnDisk) readAll() []*ach.File {
+	paths, err := filepath.Glob(filepath.Join(r.dir, "*"+diskFileExt))
+	if err != nil {
+		r.log("files", fmt.Sprintf("problem listing %s: %v", r.dir, err))
+		return nil
+	}
+	files := make([]*ach.File, 0, len(paths))
+	for _, path := range paths {
+		f, err := r.readPath(path)
+		if err != nil {
+			// the file might have been deleted since listing the directory
+			if !os.IsNotExist(err) {
+				r.log("files", fmt.Sprintf("problem reading %s: %v", path, err))
+			}
+			continue
+		}
+		files = append(files, f)
+	}
+	return files
+}
+
+func (r *repositoryOnDisk) DeleteFile(id string) error {
+	defer r.wLock()()
+	if err := os.Remove(r.path(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (r *repositoryOnDisk) StoreBatch(fileID string, batch ach.Batcher) error {
+	defer r.wLock()()
+
+	file, err := r.read(fileID)
+	if err != nil {
+		return err
+	}
+	// ensure the batch does not already exist
+	for _, val := range file.Batches {
+		if val.ID() == batch.ID() {
+			return ErrAlreadyExists
+		}
+	}
+	file.AddBatch(batch)
+	return r.write(file)
+}
+
+// FindBatch retrieves a ach.Batcher based on the supplied ID
+func (r *repositoryOnDisk) FindBatch(fileID string, batchID string) (ach.Batcher, error) {
+	defer r.rLock()()
+
+	file, err := r.read(fileID)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range file.Batches {
+		if val.ID() == batchID {
+			return val, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *repositoryOnDisk) FindAllBatches(fileID string) []ach.Batcher {
+	defer r.rLock()()
+
+	file, err := r.read(fileID)
+	if err != nil {
+		return nil
+	}
+	return file.Batches
+}
+
+// UpdateBatch replaces the batch with the same ID, keeping its position in the file
+func (r *repositoryOnDisk) UpdateBatch(fileID string, batch ach.Batcher) error {
+	defer r.wLock()()
+
+	file, err := r.read(fileID)
+	if err != nil {
+		return err
+	}
+	for i := range file.Batches {
+		if file.Batches[i].ID() == batch.ID() {
+			file.Batches[i] = batch
+			return r.write(file)
+		}
+	}
+	return ErrNotFound
+}
+
+func (r *repositoryOnDisk) DeleteBatch(fileID string, batchID string) error {
+	defer r.wLock()()
+
+	file, err := r.read(fileID)
+	if err != nil {
+		return fmt.Errorf("%v: no file %s with batch %s found", ErrNotFound, fileID, batchID)
+	}
+	for i := len(file.Batches) - 1; i >= 0; i-- {
+		if file.Batches[i].ID() == batchID {
+			file.Batches = append(file.Batches[:i], file.Batches[i+1:]...)
+			return r.write(file)
+		}
+	}
+	return ErrNotFound
+}
+
+// cleanupOldFiles removes files whose FileCreationDate is older than the TTL, see repositoryInMemory.cleanupOldFiles
+func (r *repositoryOnDisk) cleanupOldFiles() {
+	defer r.wLock()()
+
+	removed := 0
+	tooOld := time.Now().Add(-1 * r.ttl)
+	tooOldStr := tooOld.Format("060102") // YYMMDD
+
+	for _, f := range r.readAll() {
+		if f.Header.FileCreationDate < tooOldStr {
+			if err := os.Remove(r.path(f.ID)); err != nil {
+				r.log("files", fmt.Sprintf("problem removing file %s: %v", f.ID, err))
+				continue
+			}
+			removed++
+			r.expired(f.ID)
+		}
+	}
+
+	r.log("files", fmt.Sprintf("removed %d ACH files older than %v", removed, tooOld.Format(time.RFC3339)))
+}
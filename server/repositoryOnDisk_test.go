@@ -0,0 +1,219 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+
+	"github.com/go-kit/kit/log"
+)
+
+func testRepositoryOnDisk(t *testing.T) (*repositoryOnDisk, string) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "ach-repository")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	r, err := NewRepositoryOnDisk(dir, testTTLDuration, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.(*repositoryOnDisk), dir
+}
+
+func TestRepositoryOnDisk__files(t *testing.T) {
+	r, dir := testRepositoryOnDisk(t)
+
+	if v := len(r.FindAllFiles()); v != 0 {
+		t.Errorf("unexpected length: %d", v)
+	}
+
+	f := &ach.File{
+		ID:     base.ID(),
+		Header: *mockFileHeader(),
+	}
+	if err := r.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.StoreFile(f); err != ErrAlreadyExists {
+		t.Errorf("expected ErrAlreadyExists: %v", err)
+	}
+
+	found, err := r.FindFile(f.ID)
+	if err != nil || found == nil {
+		t.Fatalf("found=%v, err=%v", found, err)
+	}
+	if found.Header.ImmediateOrigin != f.Header.ImmediateOrigin {
+		t.Errorf("ImmediateOrigin=%q", found.Header.ImmediateOrigin)
+	}
+
+	// reopen the directory and find our file again
+	other, err := NewRepositoryOnDisk(dir, testTTLDuration, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := len(other.FindAllFiles()); v != 1 {
+		t.Errorf("unexpected length: %d", v)
+	}
+
+	if err := r.DeleteFile(f.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.FindFile(f.ID); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+	if err := r.DeleteFile(f.ID); err != nil {
+		t.Errorf("unexpected error deleting twice: %v", err)
+	}
+}
+
+func TestRepositoryOnDisk__fileID(t *testing.T) {
+	r, dir := testRepositoryOnDisk(t)
+
+	f := &ach.File{
+		ID:     "../escape/me",
+		Header: *mockFileHeader(),
+	}
+	if err := r.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+	if found, err := r.FindFile(f.ID); err != nil || found.ID != f.ID {
+		t.Errorf("found=%v, err=%v", found, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "..", "escape")); !os.IsNotExist(err) {
+		t.Errorf("file written outside of directory: %v", err)
+	}
+}
+
+func TestRepositoryOnDisk__batches(t *testing.T) {
+	r, dir := testRepositoryOnDisk(t)
+
+	f := &ach.File{
+		ID:     base.ID(),
+		Header: *mockFileHeader(),
+	}
+	if err := r.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+	if v := len(r.FindAllBatches(f.ID)); v != 0 {
+		t.Errorf("unexpected length: %d", v)
+	}
+
+	batch := mockBatchWEB()
+	if b, err := r.FindBatch(f.ID, batch.ID()); err == nil || b != nil {
+		t.Errorf("b=%v, err=%v", b, err)
+	}
+	if err := r.StoreBatch(f.ID, batch); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.StoreBatch(f.ID, batch); err != ErrAlreadyExists {
+		t.Errorf("expected ErrAlreadyExists: %v", err)
+	}
+	if err := r.StoreBatch("missing", batch); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+
+	// batches are found by ID after reopening
+	other, err := NewRepositoryOnDisk(dir, testTTLDuration, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := len(other.FindAllBatches(f.ID)); v != 1 {
+		t.Errorf("unexpected length: %d", v)
+	}
+	if b, err := other.FindBatch(f.ID, batch.ID()); err != nil || b == nil {
+		t.Errorf("b=%v, err=%v", b, err)
+	}
+
+	if err := r.DeleteBatch(f.ID, batch.ID()); err != nil {
+		t.Fatal(err)
+	}
+	if v := len(other.FindAllBatches(f.ID)); v != 0 {
+		t.Errorf("unexpected length: %d", v)
+	}
+	if err := r.DeleteBatch(f.ID, batch.ID()); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+}
+
+func TestRepositoryOnDisk__recover(t *testing.T) {
+	r, dir := testRepositoryOnDisk(t)
+
+	f := &ach.File{
+		ID:     base.ID(),
+		Header: *mockFileHeader(),
+	}
+	if err := r.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+
+	// leave behind an interrupted write and a corrupt file
+	tmp := filepath.Join(dir, diskTempPrefix+"123")
+	if err := ioutil.WriteFile(tmp, []byte(`{"id":`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	corrupt := filepath.Join(dir, "corrupt"+diskFileExt)
+	if err := ioutil.WriteFile(corrupt, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// and a file which parses, but can't be read with today's rules
+	invalid := filepath.Join(dir, "invalid"+diskFileExt)
+	if err := ioutil.WriteFile(invalid, []byte(`{"id":"invalid","batches":[{"batchHeader":{"standardEntryClassCode":"ZZZ"}}]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.readPath(invalid); err == nil {
+		t.Fatal("expected error")
+	}
+
+	other, err := NewRepositoryOnDisk(dir, testTTLDuration, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(tmp); !os.IsNotExist(err) {
+		t.Errorf("expected %s to be removed: %v", tmp, err)
+	}
+	if _, err := os.Stat(corrupt + diskCorruptExt); err != nil {
+		t.Errorf("expected corrupt file to be moved aside: %v", err)
+	}
+	if _, err := os.Stat(invalid); err != nil {
+		t.Errorf("expected invalid file to be left alone: %v", err)
+	}
+	if v := len(other.FindAllFiles()); v != 1 {
+		t.Errorf("unexpected length: %d", v)
+	}
+}
+
+func TestRepositoryOnDisk__errors(t *testing.T) {
+	if _, err := NewRepositoryOnDisk("", testTTLDuration, nil); err == nil {
+		t.Error("expected error")
+	}
+
+	r, _ := testRepositoryOnDisk(t)
+	if err := r.StoreFile(nil); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -0,0 +1,637 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/moov-io/ach"
+
+	"github.com/go-kit/kit/log"
+)
+
+// sqlMigrations are applied in order and recorded in ach_migrations. Only append to this list,
+// the statements need to work on both SQLite and Postgres.
+var sqlMigrations = []string{
+	// v1: files, batches and entries
+	`create table if not exists ach_files(
+  file_id text primary key not null,
+  immediate_destination text not null,
+  immediate_origin text not null,
+  immediate_destination_name text not null,
+  immediate_origin_name text not null,
+  file_creation_date text not null,
+  file_creation_time text not null,
+  file_id_modifier text not null,
+  reference_code text not null,
+  contents text not null,
+  created_at timestamp not null
+);`,
+	`create index if not exists ach_files_file_creation_date_idx on ach_files(file_creation_date);`,
+	`create table if not exists ach_batches(
+  file_id text not null,
+  batch_id text not null,
+  position integer not null,
+  service_class_code integer not null,
+  standard_entry_class_code text not null,
+  company_name text not null,
+  company_identification text not null,
+  company_entry_description text not null,
+  effective_entry_date text not null,
+  odfi_identification text not null,
+  batch_number integer not null,
+  contents text not null,
+  primary key (file_id, batch_id)
+);`,
+	`create index if not exists ach_batches_company_identification_idx on ach_batches(company_identification);`,
+	`create index if not exists ach_batches_effective_entry_date_idx on ach_batches(effective_entry_date);`,
+	`create table if not exists ach_entries(
+  file_id text not null,
+  batch_id text not null,
+  position integer not null,
+  transaction_code integer not null,
+  rdfi_identification text not null,
+  dfi_account_number text not null,
+  amount bigint not null,
+  individual_name text not null,
+  trace_number text not null,
+  primary key (file_id, batch_id, position)
+);`,
+	`create index if not exists ach_entries_trace_number_idx on ach_entries(trace_number);`,
+	`create index if not exists ach_entries_amount_idx on ach_entries(amount);`,
+
+	// v2: index IAT batches and entry categories
+	`alter table ach_batches add column iat integer not null default 0;`,
+	`alter table ach_entries add column category text not null default '';`,
+	`create index if not exists ach_batches_standard_entry_class_code_idx on ach_batches(standard_entry_class_code);`,
+}
+
+// repositorySQL stores files in a database. File and batch headers are kept in columns and
+// each entry has a row, so files can be searched by trace number, company, amount or dates.
+type repositorySQL struct {
+	db *sql.DB
+
+	ttl    time.Duration
+	logger log.Logger
+	expiryHooks
+}
+
+// NewRepositorySQL returns a Repository which stores files in db after applying any missing
+// migrations. The SQL is written for SQLite (modernc.org/sqlite) and Postgres (github.com/lib/pq),
+// callers need to import the driver. SQLite databases should be opened with db.SetMaxOpenConns(1).
+//
+// Like NewRepositoryInMemory, files whose FileCreationDate is older than ttl are removed
+// (a ttl of zero keeps files forever).
+func NewRepositorySQL(db *sql.DB, ttl time.Duration, logger log.Logger) (Repository, error) {
+	if db == nil {
+		return nil, errors.New("nil database provided")
+	}
+	if err := migrateSQL(db); err != nil {
+		return nil, fmt.Errorf("problem migrating database: %v", err)
+	}
+	repo := &repositorySQL{
+		db:     db,
+		ttl:    ttl,
+		logger: logger,
+	}
+
+	if ttl <= 0*time.Second {
+		// Don't run the cleanup if we've disabled the TTL
+		return repo, nil
+	}
+
+	// Run our anon goroutine to cleanup old ACH files
+	go func() {
+		t := time.NewTicker(1 * time.Minute)
+		for range t.C {
+			repo.cleanupOldFiles()
+		}
+	}()
+
+	return repo, nil
+}
+
+func migrateSQL(db *sql.DB) error {
+	if _, err := db.Exec(`create table if not exists ach_migrations(version integer primary key not null, applied_at timestamp not null);`); err != nil {
+		return err
+	}
+	var version int
+	if err := db.QueryRow(`select coalesce(max(version), 0) from ach_migrations;`).Scan(&version); err != nil {
+		return err
+	}
+	for i := version; i < len(sqlMigrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(sqlMigrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %v", i+1, err)
+		}
+		if _, err := tx.Exec(`insert into ach_migrations(version, applied_at) values ($1, $2);`, i+1, time.Now()); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %v", i+1, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *repositorySQL) log(keyvals ...interface{}) {
+	if r.logger != nil {
+		r.logger.Log(keyvals...)
+	}
+}
+
+// queryer is implemented by *sql.DB and *sql.Tx
+type queryer interface {
+	Exec(query string, args ...interface{}) (sql.Result, error)
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+	QueryRow(query string, args ...interface{}) *sql.Row
+}
+
+// withTx runs fn in a transaction, which is committed if fn returns nil
+func (r *repositorySQL) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func fileExists(q queryer, id string) (bool, error) {
+	var n int
+	if err := q.QueryRow(`select count(*) from ach_files where file_id = $1;`, id).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (r *repositorySQL) StoreFile(f *ach.File) error {
+	if f == nil {
+		return errors.New("nil ACH file provided")
+	}
+	return r.withTx(func(tx *sql.Tx) error {
+		if exists, err := fileExists(tx, f.ID); err != nil {
+			return err
+		} else if exists {
+			return ErrAlreadyExists
+		}
+
+		// Batches are stored in their own rows
+		withoutBatches := *f
+		withoutBatches.Batches = nil
+		contents, err := json.Marshal(&withoutBatches)
+		if err != nil {
+			return err
+		}
+		h := f.Header
+		query := `insert into ach_files(file_id, immediate_destination, immediate_origin, immediate_destination_name, immediate_origin_name,
+file_creation_date, file_creation_time, file_id_modifier, reference_code, contents, created_at)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);`
+		_, err = tx.Exec(query, f.ID, h.ImmediateDestination, h.ImmediateOrigin, h.ImmediateDestinationName, h.ImmediateOriginName,
+			h.FileCreationDate, h.FileCreationTime, h.FileIDModifier, h.ReferenceCode, string(contents), time.Now())
+		if err != nil {
+			return err
+		}
+		for i := range f.Batches {
+			if err := insertBatch(tx, f.ID, i, f.Batches[i]); err != nil {
+				return err
+			}
+		}
+		for i := range f.IATBatches {
+			if err := insertIATBatch(tx, f.ID, i, f.IATBatches[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func insertBatch(tx *sql.Tx, fileID string, position int, batch ach.Batcher) error {
+	contents, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+	h := batch.GetHeader()
+	if h == nil {
+		return errors.New("batch has no header")
+	}
+	query := `insert into ach_batches(file_id, batch_id, position, service_class_code, standard_entry_class_code, company_name,
+company_identification, company_entry_description, effective_entry_date, odfi_identification, batch_number, contents)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);`
+	_, err = tx.Exec(query, fileID, batch.ID(), position, h.ServiceClassCode, h.StandardEntryClassCode, h.CompanyName,
+		h.CompanyIdentification, h.CompanyEntryDescription, h.EffectiveEntryDate, h.ODFIIdentification, h.BatchNumber, string(contents))
+	if err != nil {
+		return err
+	}
+
+	for i, e := range batch.GetEntries() {
+		_, err := tx.Exec(insertEntryQuery, fileID, batch.ID(), i, e.TransactionCode, e.RDFIIdentification, e.DFIAccountNumber,
+			e.Amount, e.IndividualName, e.TraceNumber, e.Category)
+		if err != nil {
+			return err
+		}
+	}
+	// ADV entries have no trace number
+	offset := len(batch.GetEntries())
+	for i, e := range batch.GetADVEntries() {
+		_, err := tx.Exec(insertEntryQuery, fileID, batch.ID(), offset+i, e.TransactionCode, e.RDFIIdentification, e.DFIAccountNumber,
+			e.Amount, e.IndividualName, "", e.Category)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const insertEntryQuery = `insert into ach_entries(file_id, batch_id, position, transaction_code, rdfi_identification, dfi_account_number,
+amount, individual_name, trace_number, category) values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);`
+
+// insertIATBatch indexes an IAT batch so it can be searched. IAT batches are read from the file's
+// contents, so their rows use a generated batch_id which can't conflict with other batches.
+func insertIATBatch(tx *sql.Tx, fileID string, position int, batch ach.IATBatch) error {
+	h := batch.Header
+	if h == nil {
+		return errors.New("IAT batch has no header")
+	}
+	batchID := fmt.Sprintf("iat:%d", position)
+	query := `insert into ach_batches(file_id, batch_id, position, service_class_code, standard_entry_class_code, company_name,
+company_identification, company_entry_description, effective_entry_date, odfi_identification, batch_number, contents, iat)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, 1);`
+	_, err := tx.Exec(query, fileID, batchID, position, h.ServiceClassCode, h.StandardEntryClassCode, "",
+		h.OriginatorIdentification, h.CompanyEntryDescription, h.EffectiveEntryDate, h.ODFIIdentification, h.BatchNumber, "")
+	if err != nil {
+		return err
+	}
+	for i, e := range batch.Entries {
+		_, err := tx.Exec(insertEntryQuery, fileID, batchID, i, e.TransactionCode, e.RDFIIdentification, e.DFIAccountNumber,
+			e.Amount, "", e.TraceNumber, e.Category)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FindFile retrieves a ach.File based on the supplied ID
+func (r *repositorySQL) FindFile(id string) (*ach.File, error) {
+	return readFileSQL(r.db, id)
+}
+
+func readFileSQL(q queryer, id string) (*ach.File, error) {
+	var contents string
+	if err := q.QueryRow(`select contents from ach_files where file_id = $1;`, id).Scan(&contents); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// Read the batches and add them into the file's JSON
+	rows, err := q.Query(`select batch_id, contents from ach_batches where file_id = $1 and iat = 0 order by position asc;`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batchIDs []string
+	var batches []json.RawMessage
+	for rows.Next() {
+		var batchID, batch string
+		if err := rows.Scan(&batchID, &batch); err != nil {
+			return nil, err
+		}
+		batchIDs = append(batchIDs, batchID)
+		batches = append(batches, json.RawMessage(batch))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(contents), &doc); err != nil {
+		return nil, fmt.Errorf("problem reading file %s: %v", id, err)
+	}
+	if doc["batches"], err = json.Marshal(batches); err != nil {
+		return nil, err
+	}
+	bs, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalid files are stored (see createFileEndpoint) so only fail if nothing was read
+	file, err := ach.FileFromJSON(bs)
+	if file == nil {
+		return nil, fmt.Errorf("problem reading file %s: %v", id, err)
+	}
+	// Batch IDs aren't written in JSON
+	for i := range file.Batches {
+		if i < len(batchIDs) {
+			file.Batches[i].SetID(batchIDs[i])
+		}
+	}
+	return file, nil
+}
+
+// FindAllFiles returns all files stored in the database
+func (r *repositorySQL) FindAllFiles() []*ach.File {
+	files, err := r.readFiles(`select file_id from ach_files order by created_at asc;`)
+	if err != nil {
+		r.log("files", fmt.Sprintf("problem listing files: %v", err))
+	}
+	return files
+}
+
+// FindFiles returns a page of files selected by the query and the cursor of the next page
+func (r *repositorySQL) FindFiles(q FileQuery) ([]*ach.File, string, error) {
+	if err := q.validate(); err != nil {
+		return nil, "", err
+	}
+
+	var where []string
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+	if q.idPrefix != "" {
+		where = append(where, fmt.Sprintf("substr(f.file_id, 1, %d) = %s", len(q.idPrefix), arg(q.idPrefix)))
+	}
+	if q.ImmediateOrigin != "" {
+		where = append(where, "trim(f.immediate_origin) = "+arg(strings.TrimSpace(q.ImmediateOrigin)))
+	}
+	if q.ImmediateDestination != "" {
+		where = append(where, "trim(f.immediate_destination) = "+arg(strings.TrimSpace(q.ImmediateDestination)))
+	}
+	after, before := q.createdRange()
+	if after != "" {
+		where = append(where, "f.file_creation_date >= "+arg(after))
+	}
+	if before != "" {
+		where = append(where, "f.file_creation_date <= "+arg(before))
+	}
+	if q.StandardEntryClassCode != "" {
+		where = append(where, "exists (select 1 from ach_batches b where b.file_id = f.file_id and upper(b.standard_entry_class_code) = "+
+			arg(strings.ToUpper(q.StandardEntryClassCode))+")")
+	}
+	if q.Category != "" {
+		// an empty category is a forward entry, see hasCategory
+		where = append(where, "exists (select 1 from ach_entries e where e.file_id = f.file_id and lower(coalesce(nullif(e.category, ''), "+
+			arg(strings.ToLower(ach.CategoryForward))+")) = "+arg(strings.ToLower(q.Category))+")")
+	}
+
+	var orderBy []string
+	switch q.SortBy {
+	case SortByImmediateOrigin:
+		orderBy = []string{"f.immediate_origin"}
+	case SortByImmediateDestination:
+		orderBy = []string{"f.immediate_destination"}
+	case SortByID:
+	default:
+		orderBy = []string{"f.file_creation_date", "f.file_creation_time"}
+	}
+	orderBy = append(orderBy, "f.file_id")
+	if q.Descending {
+		for i := range orderBy {
+			orderBy[i] += " desc"
+		}
+	}
+
+	query := "select f.file_id from ach_files f"
+	if len(where) > 0 {
+		query += " where " + strings.Join(where, " and ")
+	}
+	query += " order by " + strings.Join(orderBy, ", ")
+
+	offset, _ := decodeCursor(q.Cursor)
+	if q.Limit > 0 {
+		// read one extra file to know if there's another page
+		query += fmt.Sprintf(" limit %s offset %s", arg(q.Limit+1), arg(offset))
+	}
+	files, err := r.readFiles(query+";", args...)
+	if err != nil {
+		return nil, "", err
+	}
+	if q.Limit <= 0 {
+		if offset > len(files) {
+			offset = len(files)
+		}
+		return files[offset:], "", nil
+	}
+	if len(files) > q.Limit {
+		return files[:q.Limit], encodeCursor(offset + q.Limit), nil
+	}
+	return files, "", nil
+}
+
+// readFiles reads each file whose ID is returned by query
+func (r *repositorySQL) readFiles(query string, args ...interface{}) ([]*ach.File, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	files := make([]*ach.File, 0, len(ids))
+	for _, id := range ids {
+		f, err := r.FindFile(id)
+		if err != nil {
+			// the file might have been deleted since listing
+			if err != ErrNotFound {
+				r.log("files", fmt.Sprintf("problem reading file %s: %v", id, err))
+			}
+			continue
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+func (r *repositorySQL) DeleteFile(id string) error {
+	return r.withTx(func(tx *sql.Tx) error {
+		return deleteFileSQL(tx, id)
+	})
+}
+
+func deleteFileSQL(tx *sql.Tx, id string) error {
+	for _, query := range []string{
+		`delete from ach_entries where file_id = $1;`,
+		`delete from ach_batches where file_id = $1;`,
+		`delete from ach_files where file_id = $1;`,
+	} {
+		if _, err := tx.Exec(query, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *repositorySQL) StoreBatch(fileID string, batch ach.Batcher) error {
+	return r.withTx(func(tx *sql.Tx) error {
+		if exists, err := fileExists(tx, fileID); err != nil {
+			return err
+		} else if !exists {
+			return ErrNotFound
+		}
+
+		// ensure the batch does not already exist
+		var n int
+		query := `select count(*) from ach_batches where file_id = $1 and batch_id = $2 and iat = 0;`
+		if err := tx.QueryRow(query, fileID, batch.ID()).Scan(&n); err != nil {
+			return err
+		}
+		if n > 0 {
+			return ErrAlreadyExists
+		}
+		var position int
+		query = `select coalesce(max(position) + 1, 0) from ach_batches where file_id = $1 and iat = 0;`
+		if err := tx.QueryRow(query, fileID).Scan(&position); err != nil {
+			return err
+		}
+		return insertBatch(tx, fileID, position, batch)
+	})
+}
+
+// FindBatch retrieves a ach.Batcher based on the supplied ID
+func (r *repositorySQL) FindBatch(fileID string, batchID string) (ach.Batcher, error) {
+	file, err := r.FindFile(fileID)
+	if err != nil {
+		return nil, err
+	}
+	for _, val := range file.Batches {
+		if val.ID() == batchID {
+			return val, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (r *repositorySQL) FindAllBatches(fileID string) []ach.Batcher {
+	file, err := r.FindFile(fileID)
+	if err != nil {
+		return nil
+	}
+	return file.Batches
+}
+
+// UpdateBatch replaces the batch with the same ID, keeping its position in the file
+func (r *repositorySQL) UpdateBatch(fileID string, batch ach.Batcher) error {
+	return r.withTx(func(tx *sql.Tx) error {
+		var position int
+		query := `select position from ach_batches where file_id = $1 and batch_id = $2 and iat = 0;`
+		if err := tx.QueryRow(query, fileID, batch.ID()).Scan(&position); err != nil {
+			if err == sql.ErrNoRows {
+				return ErrNotFound
+			}
+			return err
+		}
+		if _, err := tx.Exec(`delete from ach_batches where file_id = $1 and batch_id = $2;`, fileID, batch.ID()); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`delete from ach_entries where file_id = $1 and batch_id = $2;`, fileID, batch.ID()); err != nil {
+			return err
+		}
+		return insertBatch(tx, fileID, position, batch)
+	})
+}
+
+func (r *repositorySQL) DeleteBatch(fileID string, batchID string) error {
+	return r.withTx(func(tx *sql.Tx) error {
+		if exists, err := fileExists(tx, fileID); err != nil {
+			return err
+		} else if !exists {
+			return fmt.Errorf("%v: no file %s with batch %s found", ErrNotFound, fileID, batchID)
+		}
+
+		res, err := tx.Exec(`delete from ach_batches where file_id = $1 and batch_id = $2 and iat = 0;`, fileID, batchID)
+		if err != nil {
+			return err
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return ErrNotFound
+		}
+		_, err = tx.Exec(`delete from ach_entries where file_id = $1 and batch_id = $2;`, fileID, batchID)
+		return err
+	})
+}
+
+// cleanupOldFiles removes files whose FileCreationDate is older than the TTL, see repositoryInMemory.cleanupOldFiles
+func (r *repositorySQL) cleanupOldFiles() {
+	tooOld := time.Now().Add(-1 * r.ttl)
+	tooOldStr := tooOld.Format("060102") // YYMMDD
+
+	var removed []string
+	err := r.withTx(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`select file_id from ach_files where file_creation_date < $1;`, tooOldStr)
+		if err != nil {
+			return err
+		}
+		var ids []string
+		for rows.Next() {
+			var id string
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return err
+			}
+			ids = append(ids, id)
+		}
+		rows.Close()
+
+		for _, id := range ids {
+			if err := deleteFileSQL(tx, id); err != nil {
+				return err
+			}
+			removed = append(removed, id)
+		}
+		return nil
+	})
+	if err != nil {
+		r.log("files", fmt.Sprintf("problem removing old files: %v", err))
+		return
+	}
+
+	for _, id := range removed {
+		r.expired(id)
+	}
+	r.log("files", fmt.Sprintf("removed %d ACH files older than %v", len(removed), tooOld.Format(time.RFC3339)))
+}
@@ -0,0 +1,201 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/base"
+
+	"github.com/go-kit/kit/log"
+	_ "modernc.org/sqlite"
+)
+
+func testRepositorySQLite(t *testing.T) *repositorySQL {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1) // each connection has its own in-memory database
+	t.Cleanup(func() { db.Close() })
+
+	r, err := NewRepositorySQL(db, testTTLDuration, log.NewNopLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r.(*repositorySQL)
+}
+
+func readTestFile(t *testing.T, name string) *ach.File {
+	t.Helper()
+
+	fd, err := os.Open(filepath.Join("..", "test", "testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+
+	file, err := ach.NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.ID = base.ID()
+	for _, b := range file.Batches {
+		b.SetID(base.ID())
+	}
+	return &file
+}
+
+func nachaContents(t *testing.T, file *ach.File) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := ach.NewWriter(&buf).Write(file); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func TestRepositorySQL__roundTrip(t *testing.T) {
+	r := testRepositorySQLite(t)
+
+	for _, name := range []string{"ppd-debit.ach", "return-WEB.ach", "iat-debit.ach", "cor-example.ach"} {
+		t.Run(name, func(t *testing.T) {
+			file := readTestFile(t, name)
+			if err := r.StoreFile(file); err != nil {
+				t.Fatal(err)
+			}
+
+			found, err := r.FindFile(file.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want, got := nachaContents(t, file), nachaContents(t, found); want != got {
+				t.Errorf("expected:\n%s\ngot:\n%s", want, got)
+			}
+			for i := range file.Batches {
+				if file.Batches[i].ID() != found.Batches[i].ID() {
+					t.Errorf("batch #%d: expected ID %q, got %q", i, file.Batches[i].ID(), found.Batches[i].ID())
+				}
+			}
+		})
+	}
+}
+
+func TestRepositorySQL__entries(t *testing.T) {
+	r := testRepositorySQLite(t)
+
+	file := readTestFile(t, "ppd-debit.ach")
+	if err := r.StoreFile(file); err != nil {
+		t.Fatal(err)
+	}
+	entry := file.Batches[0].GetEntries()[0]
+
+	// entries can be found by trace number and amount
+	var fileID, batchID string
+	query := `select e.file_id, e.batch_id from ach_entries e
+inner join ach_batches b on e.file_id = b.file_id and e.batch_id = b.batch_id
+where e.trace_number = $1 and e.amount = $2 and b.company_identification = $3;`
+	row := r.db.QueryRow(query, entry.TraceNumber, entry.Amount, file.Batches[0].GetHeader().CompanyIdentification)
+	if err := row.Scan(&fileID, &batchID); err != nil {
+		t.Fatal(err)
+	}
+	if fileID != file.ID || batchID != file.Batches[0].ID() {
+		t.Errorf("fileID=%q batchID=%q", fileID, batchID)
+	}
+
+	// entries are removed with their batch
+	if err := r.DeleteBatch(file.ID, batchID); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	if err := r.db.QueryRow(`select count(*) from ach_entries where file_id = $1;`, file.ID).Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	if n != 0 {
+		t.Errorf("found %d entries", n)
+	}
+}
+
+func TestRepositorySQL__batchOrder(t *testing.T) {
+	r := testRepositorySQLite(t)
+
+	f := &ach.File{
+		ID:     base.ID(),
+		Header: *mockFileHeader(),
+	}
+	if err := r.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+	first, second := mockBatchWEB(), mockBatchWEB()
+	first.SetID("first")
+	second.SetID("second")
+	if err := r.StoreBatch(f.ID, first); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.StoreBatch(f.ID, second); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.StoreBatch(f.ID, second); err != ErrAlreadyExists {
+		t.Errorf("expected ErrAlreadyExists: %v", err)
+	}
+	if err := r.StoreBatch("missing", second); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+
+	batches := r.FindAllBatches(f.ID)
+	if len(batches) != 2 || batches[0].ID() != "first" || batches[1].ID() != "second" {
+		t.Fatalf("unexpected batches: %#v", batches)
+	}
+	if b, err := r.FindBatch(f.ID, "second"); err != nil || b.ID() != "second" {
+		t.Errorf("b=%v, err=%v", b, err)
+	}
+	if err := r.DeleteBatch(f.ID, "missing"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+	if err := r.DeleteBatch("missing", "first"); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRepositorySQL__migrations(t *testing.T) {
+	r := testRepositorySQLite(t)
+
+	// migrating again is a no-op
+	if err := migrateSQL(r.db); err != nil {
+		t.Fatal(err)
+	}
+	var version int
+	if err := r.db.QueryRow(`select max(version) from ach_migrations;`).Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version != len(sqlMigrations) {
+		t.Errorf("version %d, expected %d", version, len(sqlMigrations))
+	}
+
+	if _, err := NewRepositorySQL(nil, testTTLDuration, nil); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -18,6 +18,7 @@
 package server
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -31,9 +32,27 @@ var (
 	testTTLDuration = 0 * time.Second // disable TTL expiry
 )
 
+// testRepositories returns each Repository implementation so they're held to the same tests
+func testRepositories(t *testing.T) map[string]Repository {
+	t.Helper()
+
+	disk, _ := testRepositoryOnDisk(t)
+	return map[string]Repository{
+		"memory":     NewRepositoryInMemory(testTTLDuration, nil),
+		"filesystem": disk,
+		"sqlite":     testRepositorySQLite(t),
+	}
+}
+
 func TestRepositoryFiles(t *testing.T) {
-	r := NewRepositoryInMemory(testTTLDuration, nil)
+	for name, r := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			testRepositoryFiles(t, r)
+		})
+	}
+}
 
+func testRepositoryFiles(t *testing.T, r Repository) {
 	if v := len(r.FindAllFiles()); v != 0 {
 		t.Errorf("unexpected length: %d", v)
 	}
@@ -62,8 +81,14 @@ func TestRepositoryFiles(t *testing.T) {
 }
 
 func TestRepositoryBatches(t *testing.T) {
-	r := NewRepositoryInMemory(testTTLDuration, nil)
+	for name, r := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			testRepositoryBatches(t, r)
+		})
+	}
+}
 
+func testRepositoryBatches(t *testing.T, r Repository) {
 	// make sure our tests are setup
 	if v := len(r.FindAllFiles()); v != 0 {
 		t.Errorf("unexpected length: %d", v)
@@ -107,28 +132,185 @@ func TestRepositoryBatches(t *testing.T) {
 }
 
 func TestRepository__cleanupOldFiles(t *testing.T) {
-	r := NewRepositoryInMemory(testTTLDuration, nil)
-	if repo, ok := r.(*repositoryInMemory); !ok {
-		t.Fatalf("unexpected repository: %T %#v", r, r)
-	} else {
-		// write a file and later verify it's cleaned up
-		file := ach.NewFile()
-		file.Header.FileCreationDate = time.Now().Add(-1 * 24 * time.Hour).Format("060102") // YYMMDD of 24hrs ago
-		repo.StoreFile(file)
-		if n := len(repo.FindAllFiles()); n != 1 {
-			t.Errorf("got %d ACH files", n)
-		}
-		repo.cleanupOldFiles() // make sure we don't panic
-		if n := len(repo.FindAllFiles()); n != 0 {
-			t.Errorf("got %d ACH files", n)
-		}
+	for name, r := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			testRepositoryCleanupOldFiles(t, r)
+		})
 	}
 
 	// Create a repo with a logger
-	r = NewRepositoryInMemory(testTTLDuration, log.NewNopLogger())
+	r := NewRepositoryInMemory(testTTLDuration, log.NewNopLogger())
 	if repo, ok := r.(*repositoryInMemory); !ok {
 		t.Fatalf("unexpected repository: %T %#v", r, r)
 	} else {
 		repo.cleanupOldFiles() // make sure we don't panic
 	}
 }
+
+func testRepositoryCleanupOldFiles(t *testing.T, r Repository) {
+	repo, ok := r.(interface{ cleanupOldFiles() })
+	if !ok {
+		t.Fatalf("unexpected repository: %T", r)
+	}
+
+	// write a file and later verify it's cleaned up, testTTLDuration expires files created before today
+	file := readTestFile(t, "ppd-debit.ach")
+	file.ID = base.ID()
+	file.Header.FileCreationDate = time.Now().Add(-1 * 24 * time.Hour).Format("060102") // YYMMDD of 24hrs ago
+	if err := r.StoreFile(file); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(r.FindAllFiles()); n != 1 {
+		t.Errorf("got %d ACH files", n)
+	}
+	repo.cleanupOldFiles()
+	if n := len(r.FindAllFiles()); n != 0 {
+		t.Errorf("got %d ACH files", n)
+	}
+
+	// batches are removed with their file
+	if sql, ok := r.(*repositorySQL); ok {
+		var n int
+		if err := sql.db.QueryRow(`select count(*) from ach_batches;`).Scan(&n); err != nil || n != 0 {
+			t.Errorf("found %d batches: %v", n, err)
+		}
+	}
+}
+
+func TestRepositoryFindFiles(t *testing.T) {
+	for name, r := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			testRepositoryFindFiles(t, r)
+		})
+	}
+}
+
+func testRepositoryFindFiles(t *testing.T, r Repository) {
+	// ppd-debit.ach is created 2019-06-24, return-WEB.ach 2018-10-17 and iat-debit.ach 2019-08-07
+	for id, name := range map[string]string{"ppd": "ppd-debit.ach", "return": "return-WEB.ach", "iat": "iat-debit.ach"} {
+		file := readTestFile(t, name)
+		file.ID = id
+		if err := r.StoreFile(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+	date := func(v string) time.Time {
+		when, _ := time.Parse("2006-01-02", v)
+		return when
+	}
+	ids := func(files []*ach.File) []string {
+		var out []string
+		for i := range files {
+			out = append(out, files[i].ID)
+		}
+		return out
+	}
+
+	cases := []struct {
+		query    FileQuery
+		expected []string
+	}{
+		{FileQuery{}, []string{"return", "ppd", "iat"}},
+		{FileQuery{Descending: true}, []string{"iat", "ppd", "return"}},
+		{FileQuery{SortBy: SortByID}, []string{"iat", "ppd", "return"}},
+		{FileQuery{SortBy: SortByImmediateOrigin}, []string{"ppd", "iat", "return"}},
+		{FileQuery{SortBy: SortByImmediateDestination, Descending: true}, []string{"ppd", "iat", "return"}},
+		{FileQuery{ImmediateOrigin: "121042882"}, []string{"ppd"}},
+		{FileQuery{ImmediateDestination: "091400606"}, []string{"return"}},
+		{FileQuery{CreatedAfter: date("2019-01-01")}, []string{"ppd", "iat"}},
+		{FileQuery{CreatedAfter: date("2019-01-01"), CreatedBefore: date("2019-06-24")}, []string{"ppd"}},
+		{FileQuery{StandardEntryClassCode: "iat"}, []string{"iat"}},
+		{FileQuery{StandardEntryClassCode: "WEB"}, []string{"return"}},
+		{FileQuery{Category: ach.CategoryReturn}, []string{"return"}},
+		{FileQuery{Category: ach.CategoryForward}, []string{"ppd", "iat"}},
+		{FileQuery{Category: ach.CategoryNOC}, nil},
+	}
+	for i := range cases {
+		files, cursor, err := r.FindFiles(cases[i].query)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if got := ids(files); !reflect.DeepEqual(got, cases[i].expected) {
+			t.Errorf("#%d: expected %v, got %v", i, cases[i].expected, got)
+		}
+		if cursor != "" {
+			t.Errorf("#%d: unexpected cursor %q", i, cursor)
+		}
+	}
+
+	// page through every file
+	var pages [][]string
+	query := FileQuery{Limit: 2}
+	for {
+		files, cursor, err := r.FindFiles(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages = append(pages, ids(files))
+		if cursor == "" {
+			break
+		}
+		query.Cursor = cursor
+	}
+	if expected := [][]string{{"return", "ppd"}, {"iat"}}; !reflect.DeepEqual(pages, expected) {
+		t.Errorf("expected %v, got %v", expected, pages)
+	}
+
+	if _, _, err := r.FindFiles(FileQuery{Cursor: "invalid!"}); err == nil {
+		t.Error("expected error")
+	}
+	if _, _, err := r.FindFiles(FileQuery{SortBy: "amount"}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestRepositoryUpdateBatch(t *testing.T) {
+	for name, r := range testRepositories(t) {
+		t.Run(name, func(t *testing.T) {
+			testRepositoryUpdateBatch(t, r)
+		})
+	}
+}
+
+func testRepositoryUpdateBatch(t *testing.T, r Repository) {
+	f := &ach.File{
+		ID:     base.ID(),
+		Header: *mockFileHeader(),
+	}
+	if err := r.StoreFile(f); err != nil {
+		t.Fatal(err)
+	}
+	// batch IDs match their header, like Service.CreateBatch
+	batch := func(id string) ach.Batcher {
+		b := mockBatchWEB()
+		b.SetID(id)
+		b.GetHeader().ID = id
+		return b
+	}
+	if err := r.StoreBatch(f.ID, batch("first")); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.StoreBatch(f.ID, batch("second")); err != nil {
+		t.Fatal(err)
+	}
+
+	updated := batch("first")
+	updated.GetEntries()[0].Amount = 1234
+	if err := r.UpdateBatch(f.ID, updated); err != nil {
+		t.Fatal(err)
+	}
+	batches := r.FindAllBatches(f.ID)
+	if len(batches) != 2 || batches[0].ID() != "first" || batches[1].ID() != "second" {
+		t.Fatalf("unexpected batches: %#v", batches)
+	}
+	if amt := batches[0].GetEntries()[0].Amount; amt != 1234 {
+		t.Errorf("Amount=%d", amt)
+	}
+
+	if err := r.UpdateBatch(f.ID, batch("missing")); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+	if err := r.UpdateBatch("missing", updated); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+}
@@ -27,7 +27,16 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/moov-io/ach/audit"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/ach/csvimport"
+	"github.com/moov-io/ach/dedupe"
+	"github.com/moov-io/ach/limits"
+	"github.com/moov-io/ach/pgp"
+	"github.com/moov-io/ach/webhook"
 	"github.com/moov-io/base"
 	moovhttp "github.com/moov-io/base/http"
 
@@ -45,6 +54,7 @@ var (
 	ErrFoundABug  = fmt.Errorf("snuck into encodeError with err == nil, %s", bugReportHelp)
 
 	errInvalidFile = errors.New("invalid ACH file")
+	errInvalidCSV  = errors.New("invalid CSV file")
 )
 
 // contextKey is a unique (and compariable) type we use
@@ -96,12 +106,128 @@ func preflightHandler(options []httptransport.ServerOption) http.Handler {
 	)
 }
 
-func MakeHTTPHandler(s Service, repo Repository, logger log.Logger) http.Handler {
+// HandlerOption configures optional behavior of the http.Handler returned from MakeHTTPHandler.
+type HandlerOption func(*handlerOptions)
+
+type handlerOptions struct {
+	csvMapping *csvimport.Mapping
+	duplicates *dedupe.Detector
+	limits     *limits.Checker
+	strictJSON bool
+	pgpKeys    *pgp.Config
+	webhooks   *webhook.Dispatcher
+	auth       auth.Authenticator
+	history    audit.Store
+
+	idempotency *idempotencyKeys
+	fileLocks   *[64]sync.Mutex // shared by every tenant's routes
+}
+
+// ImportCSV sets the csvimport.Mapping used to create files from 'text/csv' requests.
+// Without this option csvimport.DefaultMapping is used.
+func ImportCSV(mapping *csvimport.Mapping) HandlerOption {
+	return func(o *handlerOptions) {
+		o.csvMapping = mapping
+	}
+}
+
+// RejectDuplicateFiles will check each file created against those previously seen by
+// the dedupe.Detector and respond with '409 Conflict' when duplicates are found. With
+// Authenticate each tenant's files are only checked against its own. Give NewGRPCServer
+// a Service from WithDuplicateDetector to check files created over gRPC too.
+func RejectDuplicateFiles(detector *dedupe.Detector) HandlerOption {
+	return func(o *handlerOptions) {
+		o.duplicates = detector
+	}
+}
+
+// CheckLimits adds routes to configure originator exposure limits and to check
+// (or release) files against them.
+func CheckLimits(checker *limits.Checker) HandlerOption {
+	return func(o *handlerOptions) {
+		o.limits = checker
+	}
+}
+
+// StrictJSON rejects JSON files on POST /files/create and POST /files/import which have unknown keys
+// or values of the wrong type (see ach.FileFromJSONStrict) instead of ignoring them.
+func StrictJSON() HandlerOption {
+	return func(o *handlerOptions) {
+		o.strictJSON = true
+	}
+}
+
+// EncryptFiles accepts OpenPGP encrypted files on POST /files/create, which are decrypted and verified
+// with keys, and serves files encrypted for the public keys from GET /files/{fileID}/contents?format=pgp
+func EncryptFiles(keys *pgp.Config) HandlerOption {
+	return func(o *handlerOptions) {
+		o.pgpKeys = keys
+	}
+}
+
+// SendWebhooks adds routes to manage webhook subscriptions and delivers events to them
+// as files and batches are created, validated, deleted or expire. The events are sent from
+// the Service (see WithWebhooks), so give NewGRPCServer the same to send events for gRPC calls.
+func SendWebhooks(dispatcher *webhook.Dispatcher) HandlerOption {
+	return func(o *handlerOptions) {
+		o.webhooks = dispatcher
+	}
+}
+
+// ReplayIdempotentRequests records the response of each POST request with an Idempotency-Key header
+// for ttl and replays it to retries of the request, instead of creating files or batches again.
+// Reusing a key for a different request responds with '422 Unprocessable Entity' and retrying while
+// the first request is still in progress responds with '409 Conflict'.
+func ReplayIdempotentRequests(ttl time.Duration) HandlerOption {
+	return func(o *handlerOptions) {
+		o.idempotency = newIdempotencyKeys(ttl)
+	}
+}
+
+// RecordHistory records an audit.Event in store for each request which changes or validates a file and
+// adds GET /files/{fileID}/history to read them. Give NewGRPCServer a Service from WithHistory so
+// changes made over gRPC are recorded too.
+func RecordHistory(store audit.Store) HandlerOption {
+	return func(o *handlerOptions) {
+		o.history = store
+	}
+}
+
+// Authenticate requires every request (except pre-flight and /ping) to carry credentials accepted by a,
+// either as "Authorization: Bearer <token>" or an "X-API-Key" header. Each tenant is served from its own
+// Service over repo, so it can only access files it created. The Service given to MakeHTTPHandler is unused.
+func Authenticate(a auth.Authenticator) HandlerOption {
+	return func(o *handlerOptions) {
+		o.auth = a
+	}
+}
+
+func MakeHTTPHandler(s Service, repo Repository, logger log.Logger, opts ...HandlerOption) http.Handler {
+	var cfg handlerOptions
+	for i := range opts {
+		opts[i](&cfg)
+	}
+	cfg.fileLocks = new([64]sync.Mutex)
+	if cfg.auth != nil {
+		return newTenantHandler(repo, logger, cfg)
+	}
+	s = withDuplicates(s, cfg.duplicates)
+	s = withWebhooks(s, cfg.webhooks, "", logger)
+	return makeRouter(withHistory(s, cfg.history, "", logger), repo, logger, cfg)
+}
+
+// makeRouter returns the routes serving the files of s and repo
+func makeRouter(s Service, repo Repository, logger log.Logger, cfg handlerOptions) http.Handler {
 	r := mux.NewRouter()
+	if cfg.idempotency != nil {
+		r.Use(cfg.idempotency.middleware)
+	}
+	r.Use(fileVersions{repo: repo, locks: cfg.fileLocks}.middleware)
+
 	options := []httptransport.ServerOption{
 		httptransport.ServerErrorLogger(logger),
 		httptransport.ServerErrorEncoder(encodeError),
-		httptransport.ServerBefore(saveCORSHeadersIntoContext()),
+		httptransport.ServerBefore(saveCORSHeadersIntoContext(), saveRequestIdentityIntoContext()),
 		httptransport.ServerAfter(respondWithSavedCORSHeaders()),
 	}
 
@@ -119,8 +245,8 @@ func MakeHTTPHandler(s Service, repo Repository, logger log.Logger) http.Handler
 		options...,
 	))
 	r.Methods("POST").Path("/files/create").Handler(httptransport.NewServer(
-		createFileEndpoint(s, repo, logger),
-		decodeCreateFileRequest,
+		createFileEndpoint(s, logger),
+		decodeCreateFileRequest(cfg.csvMapping, cfg.strictJSON, cfg.pgpKeys),
 		encodeResponse,
 		options...,
 	))
@@ -131,7 +257,7 @@ func MakeHTTPHandler(s Service, repo Repository, logger log.Logger) http.Handler
 		options...,
 	))
 	r.Methods("GET").Path("/files/{id}/contents").Handler(httptransport.NewServer(
-		getFileContentsEndpoint(s, logger),
+		getFileContentsEndpoint(s, cfg.pgpKeys, logger),
 		decodeGetFileContentsRequest,
 		encodeTextResponse,
 		options...,
@@ -185,17 +311,30 @@ func MakeHTTPHandler(s Service, repo Repository, logger log.Logger) http.Handler
 		options...,
 	))
 	r.Methods("POST").Path("/files/{fileID}/segment").Handler(httptransport.NewServer(
-		segmentFileEndpoint(s, repo, logger),
+		segmentFileEndpoint(s, logger),
 		decodeSegmentFileRequest,
 		encodeResponse,
 		options...,
 	))
 	r.Methods("POST").Path("/files/{fileID}/flatten").Handler(httptransport.NewServer(
-		flattenBatchesEndpoint(s, repo, logger),
+		flattenBatchesEndpoint(s, logger),
 		decodeFlattenBatchesRequest,
 		encodeResponse,
 		options...,
 	))
+	addEntryRoutes(r, s, logger, options)
+	addMergeRoutes(r, s, cfg.strictJSON, logger, options)
+	addISO20022Routes(r, s, logger, options)
+	addConvertRoutes(r, logger, cfg, options)
+	if cfg.limits != nil {
+		addLimitsRoutes(r, s, cfg.limits, logger, options)
+	}
+	if cfg.webhooks != nil {
+		addWebhookRoutes(r, repo, cfg.webhooks, logger, options)
+	}
+	if cfg.history != nil {
+		addHistoryRoutes(r, repo, cfg.history, logger, options)
+	}
 	return r
 }
 
@@ -263,17 +402,28 @@ func encodeResponse(ctx context.Context, w http.ResponseWriter, response interfa
 	return nil
 }
 
+// fileContents is an io.Reader of a file written in a format other than text/plain
+type fileContents struct {
+	io.Reader
+	contentType string
+}
+
 // encodeTextResponse will marshal response into the HTTP Response
 // This method is designed text/plain content-types and expects response
 // to be an io.Reader.
 func encodeTextResponse(ctx context.Context, w http.ResponseWriter, response interface{}) error {
 	if r, ok := response.(io.Reader); ok {
-		w.Header().Set("Content-Type", "text/plain")
+		contentType := "text/plain"
+		if fc, ok := r.(fileContents); ok {
+			contentType = fc.contentType
+		}
+		w.Header().Set("Content-Type", contentType)
 		w.WriteHeader(http.StatusOK)
 		_, err := io.Copy(w, r)
 		return err
 	}
-	return nil
+	// errors are returned as JSON
+	return encodeResponse(ctx, w, response)
 }
 
 // encodeError JSON encodes the supplied error
@@ -292,6 +442,9 @@ func codeFrom(err error) int {
 	if err == nil {
 		return http.StatusOK
 	}
+	if _, ok := err.(*dedupe.DuplicateError); ok {
+		return http.StatusConflict
+	}
 
 	errString := fmt.Sprintf("%#v", err)
 	if el, ok := err.(base.ErrorList); ok {
@@ -300,6 +453,15 @@ func codeFrom(err error) int {
 	switch {
 	case
 		strings.Contains(errString, errInvalidFile.Error()), // This branch comes from validateFileEndpoint
+		strings.Contains(errString, errInvalidCSV.Error()),
+		strings.Contains(errString, errInvalidLimit.Error()),
+		strings.Contains(errString, errInvalidISO20022.Error()),
+		strings.Contains(errString, errInvalidQuery.Error()),
+		strings.Contains(errString, errInvalidEntry.Error()),
+		strings.Contains(errString, errInvalidMerge.Error()),
+		strings.Contains(errString, errInvalidImport.Error()),
+		strings.Contains(errString, errInvalidWebhook.Error()),
+		strings.Contains(errString, errInvalidIdempotencyKey.Error()),
 		strings.Contains(errString, "*ach.FieldError"),
 		strings.Contains(errString, "*ach.BatchError"),
 		strings.Contains(errString, "*ach.ErrFile"),
@@ -313,6 +475,12 @@ func codeFrom(err error) int {
 		return http.StatusNotFound
 	case ErrAlreadyExists:
 		return http.StatusBadRequest
+	case errPreconditionFailed:
+		return http.StatusPreconditionFailed
+	case errIdempotencyKeyInUse, limits.ErrAlreadyReleased:
+		return http.StatusConflict
+	case errIdempotencyKeyReused:
+		return http.StatusUnprocessableEntity
 	default:
 		return http.StatusInternalServerError
 	}
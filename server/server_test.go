@@ -89,7 +89,7 @@ func TestServer__CreateFileEndpoint(t *testing.T) {
 		}
 		httpReq.Header.Set("Content-Type", "application/json; charset=utf-8")
 
-		createFileReq, err := decodeCreateFileRequest(context.TODO(), httpReq)
+		createFileReq, err := decodeCreateFileRequest(nil, false, nil)(context.TODO(), httpReq)
 		if err != nil {
 			t.Error(string(bs))
 			t.Fatalf("file %s had error against HTTP decode: %v", file.ACHFilepath, err)
@@ -98,7 +98,7 @@ func TestServer__CreateFileEndpoint(t *testing.T) {
 		repo := NewRepositoryInMemory(testTTLDuration, nil)
 		s := NewService(repo)
 
-		endpoint := createFileEndpoint(s, repo, nil) // nil logger
+		endpoint := createFileEndpoint(s, nil) // nil logger
 
 		resp, err := endpoint(context.TODO(), createFileReq)
 		if err != nil {
@@ -19,6 +19,8 @@ package server
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -32,15 +34,19 @@ var (
 	ErrAlreadyExists = errors.New("already exists")
 )
 
-// Service is a REST interface for interacting with ACH file structures
-// TODO: Add ctx to function parameters to pass the client security token
+// Service is a REST interface for interacting with ACH file structures.
+// Servers which authenticate requests create a Service for each tenant (see Authenticate).
 type Service interface {
 	// CreateFile creates a new ach file record and returns a resource ID
 	CreateFile(f *ach.FileHeader) (string, error)
+	// StoreFile saves a complete file, such as one read from a request, under its ID
+	StoreFile(f *ach.File) error
 	// AddFile retrieves a file based on the File id
 	GetFile(id string) (*ach.File, error)
 	// GetFiles retrieves all files accessible from the client.
 	GetFiles() []*ach.File
+	// FindFiles retrieves a page of files selected by the query and the cursor of the next page
+	FindFiles(query FileQuery) ([]*ach.File, string, error)
 	// DeleteFile takes a file resource ID and deletes it from the store
 	DeleteFile(id string) error
 	// GetFileContents creates a valid plaintext file in memory assuming it has a FileHeader and at least one Batch record.
@@ -53,6 +59,8 @@ type Service interface {
 	SegmentFile(id string, opts *ach.SegmentFileConfiguration) (*ach.File, *ach.File, error)
 	// FlattenBatches will minimize the ach.Batch objects in a file by consolidating EntryDetails under distinct batch headers
 	FlattenBatches(id string) (*ach.File, error)
+	// MergeFiles combines the files into as few files as possible (see ach.MergeFilesWith)
+	MergeFiles(fileIDs []string, conditions ach.Conditions) ([]*ach.File, error)
 	// CreateBatch creates a new batch within and ach file and returns its resource ID
 	CreateBatch(fileID string, bh ach.Batcher) (string, error)
 	// GetBatch retrieves a batch based oin the file id and batch id
@@ -61,6 +69,26 @@ type Service interface {
 	GetBatches(fileID string) []ach.Batcher
 	// DeleteBatch takes a fileID and BatchID and removes the batch from the file
 	DeleteBatch(fileID string, batchID string) error
+	// GetEntries retrieves all entries of a batch
+	GetEntries(fileID string, batchID string) ([]*ach.EntryDetail, error)
+	// GetEntry retrieves an entry based on its EntryDetail ID
+	GetEntry(fileID string, batchID string, entryID string) (*ach.EntryDetail, error)
+	// CreateEntry adds an entry to the batch, recomputes the BatchControl and returns the entry's ID
+	CreateEntry(fileID string, batchID string, entry *ach.EntryDetail) (string, error)
+	// UpdateEntry replaces the entry with the same ID and recomputes the BatchControl
+	UpdateEntry(fileID string, batchID string, entry *ach.EntryDetail) error
+	// DeleteEntry removes an entry from the batch and recomputes the BatchControl
+	DeleteEntry(fileID string, batchID string, entryID string) error
+	// GetAllAddenda retrieves every addenda record of an entry
+	GetAllAddenda(fileID string, batchID string, entryID string) ([]Addenda, error)
+	// GetAddenda retrieves an addenda record based on its ID
+	GetAddenda(fileID string, batchID string, entryID string, addendaID string) (Addenda, error)
+	// CreateAddenda adds an addenda record to the entry and returns its ID
+	CreateAddenda(fileID string, batchID string, entryID string, addenda Addenda) (string, error)
+	// UpdateAddenda replaces the addenda record with the same ID
+	UpdateAddenda(fileID string, batchID string, entryID string, addenda Addenda) error
+	// DeleteAddenda removes an addenda record from the entry
+	DeleteAddenda(fileID string, batchID string, entryID string, addendaID string) error
 }
 
 // service a concrete implementation of the service.
@@ -97,6 +125,10 @@ func (s *service) CreateFile(fh *ach.FileHeader) (string, error) {
 	return f.ID, nil
 }
 
+func (s *service) StoreFile(f *ach.File) error {
+	return s.store.StoreFile(f)
+}
+
 // GetFile returns a files based on the supplied id
 func (s *service) GetFile(id string) (*ach.File, error) {
 	f, err := s.store.FindFile(id)
@@ -110,6 +142,10 @@ func (s *service) GetFiles() []*ach.File {
 	return s.store.FindAllFiles()
 }
 
+func (s *service) FindFiles(query FileQuery) ([]*ach.File, string, error) {
+	return s.store.FindFiles(query)
+}
+
 func (s *service) DeleteFile(id string) error {
 	return s.store.DeleteFile(id)
 }
@@ -142,7 +178,7 @@ func (s *service) GetFileContents(id string) (io.Reader, error) {
 func (s *service) ValidateFile(id string, opts *ach.ValidateOpts) error {
 	f, err := s.GetFile(id)
 	if err != nil {
-		return fmt.Errorf("problem reading file %s: %v", id, err)
+		return fmt.Errorf("problem reading file %s: %w", id, err)
 	}
 	return f.ValidateWith(opts)
 }
@@ -160,6 +196,7 @@ func (s *service) CreateBatch(fileID string, batch ach.Batcher) (string, error)
 		batch.SetID(batch.GetHeader().ID)
 		batch.GetControl().ID = batch.GetHeader().ID
 	}
+	setMissingEntryIDs(batch)
 	if err := s.store.StoreBatch(fileID, batch); err != nil {
 		return "", err
 	}
@@ -242,3 +279,284 @@ func (s *service) FlattenBatches(fileID string) (*ach.File, error) {
 	}
 	return ff, err
 }
+
+// MergeFiles combines copies of the files, so those stored are unchanged, into as few files as
+// conditions allow. Each merged file is given a new ID.
+func (s *service) MergeFiles(fileIDs []string, conditions ach.Conditions) ([]*ach.File, error) {
+	if len(fileIDs) == 0 {
+		return nil, fmt.Errorf("%v: no fileIDs", errInvalidMerge)
+	}
+	files := make([]*ach.File, 0, len(fileIDs))
+	for _, id := range fileIDs {
+		f, err := s.GetFile(id)
+		if err != nil {
+			return nil, err
+		}
+		bs, err := json.Marshal(f)
+		if err != nil {
+			return nil, err
+		}
+		f, err = ach.FileFromJSON(bs)
+		if err != nil {
+			return nil, fmt.Errorf("%v: file %s: %v", errInvalidMerge, id, err)
+		}
+		files = append(files, f)
+	}
+	merged, err := ach.MergeFilesWith(files, conditions)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidMerge, err)
+	}
+	for i := range merged {
+		merged[i].ID = base.ID()
+		setMissingIDs(merged[i])
+	}
+	return merged, nil
+}
+
+func (s *service) GetEntries(fileID string, batchID string) ([]*ach.EntryDetail, error) {
+	b, err := s.GetBatch(fileID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	return b.GetEntries(), nil
+}
+
+func (s *service) GetEntry(fileID string, batchID string, entryID string) (*ach.EntryDetail, error) {
+	entries, err := s.GetEntries(fileID, batchID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range entries {
+		if entries[i].ID == entryID {
+			return entries[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *service) CreateEntry(fileID string, batchID string, entry *ach.EntryDetail) (string, error) {
+	if entry == nil {
+		return "", errors.New("no entry provided")
+	}
+	if entry.ID == "" {
+		entry.ID = base.ID()
+	}
+	setMissingAddendaIDs(entry)
+	if err := entry.Validate(); err != nil {
+		return entry.ID, fmt.Errorf("%v %s: %v", errInvalidEntry, entry.ID, err)
+	}
+	err := s.updateEntries(fileID, batchID, func(entries []*ach.EntryDetail) ([]*ach.EntryDetail, error) {
+		for i := range entries {
+			if entries[i].ID == entry.ID {
+				return nil, ErrAlreadyExists
+			}
+		}
+		return append(entries, entry), nil
+	})
+	return entry.ID, err
+}
+
+func (s *service) UpdateEntry(fileID string, batchID string, entry *ach.EntryDetail) error {
+	if entry == nil {
+		return errors.New("no entry provided")
+	}
+	setMissingAddendaIDs(entry)
+	if err := entry.Validate(); err != nil {
+		return fmt.Errorf("%v %s: %v", errInvalidEntry, entry.ID, err)
+	}
+	return s.updateEntries(fileID, batchID, func(entries []*ach.EntryDetail) ([]*ach.EntryDetail, error) {
+		for i := range entries {
+			if entries[i].ID == entry.ID {
+				entries[i] = entry
+				return entries, nil
+			}
+		}
+		return nil, ErrNotFound
+	})
+}
+
+func (s *service) DeleteEntry(fileID string, batchID string, entryID string) error {
+	return s.updateEntries(fileID, batchID, func(entries []*ach.EntryDetail) ([]*ach.EntryDetail, error) {
+		for i := range entries {
+			if entries[i].ID == entryID {
+				if len(entries) == 1 {
+					return nil, fmt.Errorf("%v: can't delete the only entry of batch %s, delete the batch instead", errInvalidEntry, batchID)
+				}
+				return append(entries[:i], entries[i+1:]...), nil
+			}
+		}
+		return nil, ErrNotFound
+	})
+}
+
+func (s *service) GetAllAddenda(fileID string, batchID string, entryID string) ([]Addenda, error) {
+	entry, err := s.GetEntry(fileID, batchID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	return entryAddenda(entry), nil
+}
+
+func (s *service) GetAddenda(fileID string, batchID string, entryID string, addendaID string) (Addenda, error) {
+	addenda, err := s.GetAllAddenda(fileID, batchID, entryID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range addenda {
+		if addendaID == getAddendaID(addenda[i]) {
+			return addenda[i], nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (s *service) CreateAddenda(fileID string, batchID string, entryID string, addenda Addenda) (string, error) {
+	if addenda == nil {
+		return "", errors.New("no addenda provided")
+	}
+	id := getAddendaID(addenda)
+	if id == "" {
+		id = base.ID()
+		setAddendaID(addenda, id)
+	}
+	err := s.updateEntry(fileID, batchID, entryID, func(entry *ach.EntryDetail) error {
+		for _, a := range entryAddenda(entry) {
+			if getAddendaID(a) == id {
+				return ErrAlreadyExists
+			}
+		}
+		return addAddenda(entry, addenda)
+	})
+	return id, err
+}
+
+func (s *service) UpdateAddenda(fileID string, batchID string, entryID string, addenda Addenda) error {
+	if addenda == nil {
+		return errors.New("no addenda provided")
+	}
+	return s.updateEntry(fileID, batchID, entryID, func(entry *ach.EntryDetail) error {
+		if !removeAddenda(entry, getAddendaID(addenda)) {
+			return ErrNotFound
+		}
+		return addAddenda(entry, addenda)
+	})
+}
+
+func (s *service) DeleteAddenda(fileID string, batchID string, entryID string, addendaID string) error {
+	return s.updateEntry(fileID, batchID, entryID, func(entry *ach.EntryDetail) error {
+		if !removeAddenda(entry, addendaID) {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
+
+// updateEntry applies fn to a copy of the entry, which replaces it in the batch
+func (s *service) updateEntry(fileID string, batchID string, entryID string, fn func(*ach.EntryDetail) error) error {
+	return s.updateEntries(fileID, batchID, func(entries []*ach.EntryDetail) ([]*ach.EntryDetail, error) {
+		for i := range entries {
+			if entries[i].ID == entryID {
+				entry := *entries[i]
+				entry.Addenda05 = append([]*ach.Addenda05(nil), entry.Addenda05...)
+				if err := fn(&entry); err != nil {
+					return nil, err
+				}
+				if err := entry.Validate(); err != nil {
+					return nil, fmt.Errorf("%v %s: %v", errInvalidEntry, entryID, err)
+				}
+				entries[i] = &entry
+				return entries, nil
+			}
+		}
+		return nil, ErrNotFound
+	})
+}
+
+// updateEntries builds a new batch from the stored batch's header and the entries returned by fn,
+// which recomputes the BatchControl (and addenda sequence numbers). The stored batch is only
+// replaced if the new batch is valid.
+func (s *service) updateEntries(fileID string, batchID string, fn func([]*ach.EntryDetail) ([]*ach.EntryDetail, error)) error {
+	existing, err := s.store.FindBatch(fileID, batchID)
+	if err != nil {
+		return err
+	}
+	if len(existing.GetADVEntries()) > 0 {
+		return fmt.Errorf("%v: entries of ADV batches can't be changed", errInvalidEntry)
+	}
+	entries, err := fn(append([]*ach.EntryDetail(nil), existing.GetEntries()...))
+	if err != nil {
+		return err
+	}
+
+	header := *existing.GetHeader()
+	batch, err := ach.NewBatch(&header)
+	if err != nil {
+		return fmt.Errorf("%v: %v", errInvalidEntry, err)
+	}
+	batch.SetID(existing.ID())
+	for i := range entries {
+		// Create modifies entries, so add copies
+		entry := *entries[i]
+		if entry.Category == "" {
+			entry.Category = ach.CategoryForward // entries read from NACHA files, see hasCategory
+		}
+		batch.AddEntry(&entry)
+	}
+	if err := batch.Create(); err != nil {
+		return fmt.Errorf("%v: %v", errInvalidEntry, err)
+	}
+	batch.GetControl().ID = existing.ID()
+	return s.store.UpdateBatch(fileID, batch)
+}
+
+// unwrapService returns the Service decorated by s (such as with WithWebhooks), or nil
+func unwrapService(s Service) Service {
+	switch d := s.(type) {
+	case *webhookService:
+		return d.Service
+	case *historyService:
+		return d.Service
+	case *duplicateService:
+		return d.Service
+	}
+	return nil
+}
+
+// forTenant returns base, which serves a tenant's files, decorated like s is
+func forTenant(s Service, base Service, tenant string) Service {
+	switch d := s.(type) {
+	case *webhookService:
+		return withWebhooks(forTenant(d.Service, base, tenant), d.hooks, tenant, d.logger)
+	case *historyService:
+		return withHistory(forTenant(d.Service, base, tenant), d.history, tenant, d.logger)
+	case *duplicateService:
+		return withDuplicates(forTenant(d.Service, base, tenant), d.detector.Namespace(tenant))
+	}
+	return base
+}
+
+// forRequest returns s making its changes on behalf of the request in ctx, so they're
+// recorded with its ID and actor when s keeps history (see WithHistory)
+func forRequest(ctx context.Context, s Service) Service {
+	switch d := s.(type) {
+	case *webhookService:
+		if inner := forRequest(ctx, d.Service); inner != d.Service {
+			return &webhookService{Service: inner, hooks: d.hooks, tenant: d.tenant, logger: d.logger}
+		}
+	case *duplicateService:
+		if inner := forRequest(ctx, d.Service); inner != d.Service {
+			return &duplicateService{Service: inner, detector: d.detector, unchecked: d.unchecked}
+		}
+	case *historyService:
+		return &historyService{
+			Service:   forRequest(ctx, d.Service),
+			history:   d.history,
+			tenant:    d.tenant,
+			logger:    d.logger,
+			requestID: requestIDOf(ctx),
+			actor:     actorOf(ctx),
+			derived:   d.derived,
+		}
+	}
+	return s
+}
@@ -0,0 +1,259 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/audit"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/ach/webhook"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/log"
+)
+
+var (
+	errForbidden = errors.New("forbidden")
+)
+
+// tenantRepository scopes every file stored in repo to a tenant by prefixing their IDs
+// with the tenant's name. Files read back have the prefix removed, so callers never see it.
+type tenantRepository struct {
+	repo   Repository
+	prefix string
+}
+
+func newTenantRepository(repo Repository, tenant string) *tenantRepository {
+	return &tenantRepository{
+		repo:   repo,
+		prefix: tenantPrefix(tenant),
+	}
+}
+
+// tenantPrefix is prepended to the IDs of a tenant's files. Tenants can't contain
+// a period (see auth.ValidateTenant) so the prefix is unambiguous.
+func tenantPrefix(tenant string) string {
+	return tenant + "."
+}
+
+// splitTenant returns the tenant and ID of a file stored by a tenantRepository
+func splitTenant(id string) (tenant string, fileID string) {
+	if idx := strings.Index(id, "."); idx > 0 {
+		return id[:idx], id[idx+1:]
+	}
+	return "", id
+}
+
+func (r *tenantRepository) id(fileID string) string {
+	return r.prefix + fileID
+}
+
+// strip returns a copy of f without the tenant's prefix
+func (r *tenantRepository) strip(f *ach.File) *ach.File {
+	if f == nil {
+		return nil
+	}
+	out := *f
+	out.ID = strings.TrimPrefix(f.ID, r.prefix)
+	return &out
+}
+
+func (r *tenantRepository) StoreFile(f *ach.File) error {
+	if f == nil {
+		return r.repo.StoreFile(nil)
+	}
+	file := *f
+	file.ID = r.id(f.ID)
+	return r.repo.StoreFile(&file)
+}
+
+func (r *tenantRepository) FindFile(id string) (*ach.File, error) {
+	f, err := r.repo.FindFile(r.id(id))
+	if err != nil {
+		return nil, err
+	}
+	return r.strip(f), nil
+}
+
+func (r *tenantRepository) FindAllFiles() []*ach.File {
+	files := r.repo.FindAllFiles()
+	out := make([]*ach.File, 0, len(files))
+	for i := range files {
+		if strings.HasPrefix(files[i].ID, r.prefix) {
+			out = append(out, r.strip(files[i]))
+		}
+	}
+	return out
+}
+
+func (r *tenantRepository) FindFiles(query FileQuery) ([]*ach.File, string, error) {
+	query.idPrefix = r.prefix
+	files, cursor, err := r.repo.FindFiles(query)
+	for i := range files {
+		files[i] = r.strip(files[i])
+	}
+	return files, cursor, err
+}
+
+func (r *tenantRepository) DeleteFile(id string) error {
+	return r.repo.DeleteFile(r.id(id))
+}
+
+func (r *tenantRepository) StoreBatch(fileID string, batch ach.Batcher) error {
+	return r.repo.StoreBatch(r.id(fileID), batch)
+}
+
+func (r *tenantRepository) FindBatch(fileID string, batchID string) (ach.Batcher, error) {
+	return r.repo.FindBatch(r.id(fileID), batchID)
+}
+
+func (r *tenantRepository) FindAllBatches(fileID string) []ach.Batcher {
+	return r.repo.FindAllBatches(r.id(fileID))
+}
+
+func (r *tenantRepository) UpdateBatch(fileID string, batch ach.Batcher) error {
+	return r.repo.UpdateBatch(r.id(fileID), batch)
+}
+
+func (r *tenantRepository) DeleteBatch(fileID string, batchID string) error {
+	return r.repo.DeleteBatch(r.id(fileID), batchID)
+}
+
+// tenantHandler authenticates each request and serves it from a router whose Service
+// and Repository only contain the files of the caller's tenant.
+type tenantHandler struct {
+	auth   auth.Authenticator
+	repo   Repository
+	cfg    handlerOptions
+	logger log.Logger
+
+	routers sync.Map // tenant -> http.Handler
+}
+
+func newTenantHandler(repo Repository, logger log.Logger, cfg handlerOptions) *tenantHandler {
+	h := &tenantHandler{
+		auth:   cfg.auth,
+		repo:   repo,
+		cfg:    cfg,
+		logger: logger,
+	}
+	// Expired files are removed from the shared repository, so send their events from here
+	if n, ok := repo.(expiryNotifier); ok && cfg.webhooks != nil {
+		n.onExpired(func(id string) {
+			tenant, fileID := splitTenant(id)
+			sendEvent(cfg.webhooks, logger, webhook.Event{Type: webhook.FileExpired, FileID: fileID, Tenant: tenant})
+		})
+	}
+	if n, ok := repo.(expiryNotifier); ok && cfg.history != nil {
+		n.onExpired(func(id string) {
+			tenant, fileID := splitTenant(id)
+			recordEvent(cfg.history, logger, audit.Event{Operation: audit.FileExpired, FileID: fileID, Tenant: tenant})
+		})
+	}
+	return h
+}
+
+func (h *tenantHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// CORS pre-flight requests and health checks don't carry credentials
+	if r.Method == "OPTIONS" || r.URL.Path == "/ping" {
+		h.router("").ServeHTTP(w, r)
+		return
+	}
+
+	p, err := h.auth.Authenticate(auth.TokenFromRequest(r))
+	if err != nil {
+		h.reject(w, r, http.StatusUnauthorized, err)
+		return
+	}
+	if err := authorize(p, r); err != nil {
+		h.reject(w, r, http.StatusForbidden, err)
+		return
+	}
+	h.router(p.Tenant).ServeHTTP(w, r.WithContext(auth.NewContext(r.Context(), p)))
+}
+
+func (h *tenantHandler) reject(w http.ResponseWriter, r *http.Request, code int, err error) {
+	if h.logger != nil {
+		h.logger.Log("auth", "reject", "method", r.Method, "path", r.URL.Path, "requestID", moovhttp.GetRequestID(r), "error", err)
+	}
+	moovhttp.SetAccessControlAllowHeaders(w, r.Header.Get("Origin"))
+	if code == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="ach"`)
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": err.Error(),
+	})
+}
+
+// router returns the routes serving tenant's files, creating them on first use
+func (h *tenantHandler) router(tenant string) http.Handler {
+	if r, ok := h.routers.Load(tenant); ok {
+		return r.(http.Handler)
+	}
+	repo := newTenantRepository(h.repo, tenant)
+	cfg := h.cfg
+	if cfg.duplicates != nil {
+		cfg.duplicates = cfg.duplicates.Namespace(tenant) // files are only duplicates of the same tenant's
+	}
+	s := withDuplicates(NewService(repo), cfg.duplicates)
+	s = withWebhooks(s, cfg.webhooks, tenant, h.logger)
+	s = withHistory(s, cfg.history, tenant, h.logger)
+	r, _ := h.routers.LoadOrStore(tenant, makeRouter(s, repo, h.logger, cfg))
+	return r.(http.Handler)
+}
+
+// authorize checks the Principal has the scope needed for a request: reads need auth.ScopeRead,
+// creating or changing files needs auth.ScopeWrite and deletes need auth.ScopeDelete.
+// Validating a file is a read, even with POST.
+// Originator limits and their usage are shared by every tenant, so only admins can read or change
+// them and release files against them.
+func authorize(p *auth.Principal, r *http.Request) error {
+	method, path := r.Method, r.URL.Path
+	scope := auth.ScopeWrite
+	switch method {
+	case "GET", "HEAD":
+		scope = auth.ScopeRead
+	case "DELETE":
+		scope = auth.ScopeDelete
+	}
+	if method == "POST" && (strings.HasSuffix(path, "/validate") || path == "/convert") {
+		scope = auth.ScopeRead // validating or converting doesn't change files
+	}
+	if strings.HasPrefix(path, "/limits") {
+		scope = auth.ScopeAdmin
+	}
+	if strings.HasPrefix(path, "/files/") && strings.HasSuffix(path, "/limits") {
+		if release, _ := strconv.ParseBool(r.URL.Query().Get("release")); release {
+			scope = auth.ScopeAdmin // adds to the usage of every tenant
+		}
+	}
+	if !p.Allowed(scope) {
+		return fmt.Errorf("%v: %s requires the %s scope", errForbidden, method, scope)
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/ach/dedupe"
+	"github.com/moov-io/ach/limits"
+	"github.com/moov-io/ach/server/pb"
+	"github.com/moov-io/ach/webhook"
+
+	"github.com/go-kit/kit/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestTenantRepository(t *testing.T) {
+	disk, _ := testRepositoryOnDisk(t)
+	repos := map[string]Repository{
+		"memory": NewRepositoryInMemory(testTTLDuration, nil),
+		"disk":   disk,
+		"sql":    testRepositorySQLite(t),
+	}
+	for name, repo := range repos {
+		t.Run(name, func(t *testing.T) {
+			acme, other := newTenantRepository(repo, "acme"), newTenantRepository(repo, "other")
+
+			// both tenants can use the same file ID
+			for _, r := range []*tenantRepository{acme, other} {
+				file := readTestFile(t, "ppd-debit.ach")
+				file.ID = "shared"
+				if err := r.StoreFile(file); err != nil {
+					t.Fatal(err)
+				}
+				if file.ID != "shared" {
+					t.Errorf("file.ID was changed to %q", file.ID)
+				}
+			}
+			f, err := acme.FindFile("shared")
+			if err != nil || f.ID != "shared" {
+				t.Fatalf("file=%v error=%v", f, err)
+			}
+			if files := acme.FindAllFiles(); len(files) != 1 || files[0].ID != "shared" {
+				t.Errorf("unexpected files: %v", files)
+			}
+			files, _, err := other.FindFiles(FileQuery{})
+			if err != nil || len(files) != 1 || files[0].ID != "shared" {
+				t.Errorf("files=%v error=%v", files, err)
+			}
+			if batches := other.FindAllBatches("shared"); len(batches) != 1 {
+				t.Errorf("unexpected batches: %v", batches)
+			}
+
+			// deleting one tenant's file leaves the other
+			if err := acme.DeleteFile("shared"); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := acme.FindFile("shared"); err != ErrNotFound {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if _, err := other.FindFile("shared"); err != nil {
+				t.Error(err)
+			}
+			if files, _, _ := acme.FindFiles(FileQuery{}); len(files) != 0 {
+				t.Errorf("unexpected files: %v", files)
+			}
+		})
+	}
+}
+
+func testAuthenticator(t *testing.T) auth.Authenticator {
+	t.Helper()
+
+	a, err := auth.NewAPIKeys([]auth.APIKey{
+		{Key: "acme-key", Principal: auth.Principal{Subject: "acme", Tenant: "acme", Scopes: []string{auth.ScopeRead, auth.ScopeWrite}}},
+		{Key: "acme-reader", Principal: auth.Principal{Subject: "reader", Tenant: "acme", Scopes: []string{auth.ScopeRead}}},
+		{Key: "other-key", Principal: auth.Principal{Subject: "other", Tenant: "other", Scopes: []string{auth.ScopeAdmin}}},
+		{Key: "acme-admin", Principal: auth.Principal{Subject: "admin", Tenant: "acme", Scopes: []string{auth.ScopeAdmin}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func serveWithKey(t *testing.T, handler http.Handler, key, method, path string, body []byte) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	if body != nil {
+		req.Header.Set("Content-Type", "text/plain")
+	}
+	if key != "" {
+		req.Header.Set("X-API-Key", key)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	w.Flush()
+	return w
+}
+
+func TestAuthenticate(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(100))
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), Authenticate(testAuthenticator(t)), SendWebhooks(hooks))
+
+	if w := serveWithKey(t, handler, "", "GET", "/ping", nil); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	for _, key := range []string{"", "wrong"} {
+		w := serveWithKey(t, handler, key, "GET", "/files", nil)
+		if w.Code != http.StatusUnauthorized || w.Header().Get("WWW-Authenticate") == "" {
+			t.Errorf("key=%q bogus HTTP status: %d", key, w.Code)
+		}
+	}
+
+	// acme creates a file the other tenant can't see
+	w := serveWithKey(t, handler, "acme-key", "POST", "/files/create", readTestdata(t, "ppd-debit.ach"))
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil || created.ID == "" {
+		t.Fatalf("bogus HTTP status: %d: %v", w.Code, err)
+	}
+	if w := serveWithKey(t, handler, "acme-reader", "GET", "/files/"+created.ID, nil); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "other-key", "GET", "/files/"+created.ID, nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "other-key", "GET", "/files", nil); w.Header().Get("X-Total-Count") != "0" {
+		t.Errorf("other tenant found files: %s", w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "acme-key", "GET", "/files", nil); w.Header().Get("X-Total-Count") != "1" {
+		t.Errorf("acme didn't find its file: %s", w.Body.String())
+	}
+
+	// scopes
+	if w := serveWithKey(t, handler, "acme-reader", "POST", "/files/"+created.ID+"/validate", nil); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "acme-reader", "POST", "/convert?to=json", readTestdata(t, "ppd-debit.ach")); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "acme-reader", "POST", "/files/create", readTestdata(t, "ppd-debit.ach")); w.Code != http.StatusForbidden {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveWithKey(t, handler, "acme-key", "DELETE", "/files/"+created.ID, nil); w.Code != http.StatusForbidden {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveWithKey(t, handler, "acme-key", "PUT", "/limits/123456789", nil); w.Code != http.StatusForbidden {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveWithKey(t, handler, "acme-reader", "GET", "/limits", nil); w.Code != http.StatusForbidden {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveWithKey(t, handler, "acme-key", "POST", "/files/"+created.ID+"/limits?release=true", nil); w.Code != http.StatusForbidden {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if _, err := repo.FindFile(tenantPrefix("acme") + created.ID); err != nil {
+		t.Error(err)
+	}
+
+	// webhook subscriptions are per tenant
+	sub, _ := json.Marshal(webhook.Subscription{URL: "https://example.com/webhooks"})
+	if w := serveWithKey(t, handler, "acme-key", "POST", "/webhooks", sub); w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "acme-reader", "GET", "/webhooks", nil); w.Header().Get("X-Total-Count") != "1" {
+		t.Errorf("acme didn't find its subscription: %s", w.Body.String())
+	}
+	if w := serveWithKey(t, handler, "other-key", "GET", "/webhooks", nil); w.Header().Get("X-Total-Count") != "0" {
+		t.Errorf("other tenant found subscriptions: %s", w.Body.String())
+	}
+}
+
+func TestAuthenticate__duplicates(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	detector := dedupe.NewDetector(dedupe.NewStoreInMemory(), nil)
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), Authenticate(testAuthenticator(t)), RejectDuplicateFiles(detector))
+
+	contents := readTestdata(t, "ppd-debit.ach")
+	w := serveWithKey(t, handler, "acme-key", "POST", "/files/create", contents)
+	var created createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil || created.ID == "" {
+		t.Fatalf("bogus HTTP status: %d: %v", w.Code, err)
+	}
+
+	// another tenant can create the same file
+	if w := serveWithKey(t, handler, "other-key", "POST", "/files/create", contents); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// but it's a duplicate of the tenant's own file
+	w = serveWithKey(t, handler, "acme-key", "POST", "/files/create", contents)
+	if w.Code != http.StatusConflict {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), created.ID) {
+		t.Errorf("unexpected response: %s", w.Body.String())
+	}
+}
+
+func TestAuthenticate__limits(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	checker := limits.NewChecker(limits.NewStoreInMemory())
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), Authenticate(testAuthenticator(t)), CheckLimits(checker))
+
+	// each tenant has a file with the same ID
+	for _, tenant := range []string{"acme", "other"} {
+		file, err := ach.NewReader(bytes.NewReader(readTestdata(t, "ppd-debit.ach"))).Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.ID = tenantPrefix(tenant) + "same"
+		if err := repo.StoreFile(&file); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// releasing one tenant's file doesn't release the other's
+	for _, key := range []string{"acme-admin", "other-key"} {
+		w := serveWithKey(t, handler, key, "POST", "/files/same/limits?release=true", nil)
+		if w.Code != http.StatusOK {
+			t.Fatalf("%s: bogus HTTP status: %d: %s", key, w.Code, w.Body.String())
+		}
+	}
+	if w := serveWithKey(t, handler, "acme-admin", "POST", "/files/same/limits?release=true", nil); w.Code != http.StatusConflict {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+}
+
+func TestGRPCAuthentication(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+
+	lis := bufconn.Listen(1024 * 1024)
+	srv := NewGRPCServer(NewService(repo), repo, log.NewNopLogger(), GRPCAuthentication(testAuthenticator(t))...)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}
+	conn, err := grpc.Dial("bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	client := pb.NewACHClient(conn)
+
+	withKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), "x-api-key", key)
+	}
+
+	if _, err := client.ListFiles(context.Background(), &pb.ListFilesRequest{}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("unexpected error: %v", err)
+	}
+	created, err := client.CreateFile(withKey("acme-key"), &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: readTestdata(t, "ppd-debit.ach")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetFile(withKey("other-key"), &pb.GetFileRequest{Id: created.GetId()}); status.Code(err) != codes.NotFound {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := client.DeleteFile(withKey("acme-key"), &pb.DeleteFileRequest{Id: created.GetId()}); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	stream, err := client.GetFileContents(withKey("acme-reader"), &pb.GetFileContentsRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if chunk, err := stream.Recv(); err != nil || len(chunk.GetContents()) == 0 {
+		t.Errorf("chunk=%v error=%v", chunk, err)
+	}
+}
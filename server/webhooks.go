@@ -0,0 +1,412 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/auth"
+	"github.com/moov-io/ach/webhook"
+	"github.com/moov-io/base"
+	moovhttp "github.com/moov-io/base/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/go-kit/kit/log"
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
+)
+
+var (
+	errInvalidWebhook = errors.New("invalid webhook")
+)
+
+// expiryNotifier is implemented by repositories which remove files older than their TTL
+type expiryNotifier interface {
+	onExpired(fn func(fileID string))
+}
+
+func addWebhookRoutes(r *mux.Router, repo Repository, hooks *webhook.Dispatcher, logger log.Logger, options []httptransport.ServerOption) {
+	if n, ok := repo.(expiryNotifier); ok {
+		n.onExpired(func(fileID string) {
+			sendEvent(hooks, logger, webhook.Event{Type: webhook.FileExpired, FileID: fileID})
+		})
+	}
+
+	r.Methods("GET").Path("/webhooks").Handler(httptransport.NewServer(
+		getWebhooksEndpoint(hooks, logger),
+		decodeWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("POST").Path("/webhooks").Handler(httptransport.NewServer(
+		createWebhookEndpoint(hooks, logger),
+		decodeWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("GET").Path("/webhooks/deliveries").Handler(httptransport.NewServer(
+		getDeliveriesEndpoint(hooks, logger),
+		decodeWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("GET").Path("/webhooks/{subscriptionID}").Handler(httptransport.NewServer(
+		getWebhookEndpoint(hooks, logger),
+		decodeWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("DELETE").Path("/webhooks/{subscriptionID}").Handler(httptransport.NewServer(
+		deleteWebhookEndpoint(hooks, logger),
+		decodeWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+	r.Methods("GET").Path("/webhooks/{subscriptionID}/deliveries").Handler(httptransport.NewServer(
+		getDeliveriesEndpoint(hooks, logger),
+		decodeWebhookRequest,
+		encodeResponse,
+		options...,
+	))
+}
+
+// sendEvent delivers event to the subscriptions of hooks, which can be nil. Events with
+// a Tenant are only sent to that tenant's subscriptions.
+func sendEvent(hooks *webhook.Dispatcher, logger log.Logger, event webhook.Event) {
+	if err := hooks.Send(event); err != nil && logger != nil {
+		logger.Log("webhooks", "sendEvent", "type", event.Type, "fileID", event.FileID, "error", err)
+	}
+}
+
+// WithWebhooks returns a Service which sends events to the subscriptions of hooks as files and batches
+// are created, validated or deleted through s. Give it to NewGRPCServer so calls send the same events
+// as HTTP requests to MakeHTTPHandler with SendWebhooks.
+func WithWebhooks(s Service, hooks *webhook.Dispatcher, logger log.Logger) Service {
+	return withWebhooks(s, hooks, "", logger)
+}
+
+// withWebhooks returns s sending the events of tenant's changes to hooks, which can be nil
+func withWebhooks(s Service, hooks *webhook.Dispatcher, tenant string, logger log.Logger) Service {
+	if hooks == nil {
+		return s
+	}
+	for inner := s; inner != nil; inner = unwrapService(inner) {
+		if ws, ok := inner.(*webhookService); ok && ws.hooks == hooks && ws.tenant == tenant {
+			return s // already sending events
+		}
+	}
+	return &webhookService{
+		Service: s,
+		hooks:   hooks,
+		tenant:  tenant,
+		logger:  logger,
+	}
+}
+
+// webhookService sends an event after each change made through its Service, whichever
+// transport (HTTP or gRPC) the change came from.
+type webhookService struct {
+	Service
+
+	hooks  *webhook.Dispatcher
+	tenant string
+	logger log.Logger
+}
+
+func (s *webhookService) send(event webhook.Event) {
+	event.Tenant = s.tenant
+	sendEvent(s.hooks, s.logger, event)
+}
+
+func (s *webhookService) CreateFile(fh *ach.FileHeader) (string, error) {
+	id, err := s.Service.CreateFile(fh)
+	if err == nil {
+		s.send(webhook.Event{Type: webhook.FileCreated, FileID: id})
+	}
+	return id, err
+}
+
+func (s *webhookService) StoreFile(f *ach.File) error {
+	err := s.Service.StoreFile(f)
+	if err == nil {
+		s.send(webhook.Event{Type: webhook.FileCreated, FileID: f.ID})
+	}
+	return err
+}
+
+func (s *webhookService) DeleteFile(id string) error {
+	err := s.Service.DeleteFile(id)
+	if err == nil {
+		s.send(webhook.Event{Type: webhook.FileDeleted, FileID: id})
+	}
+	return err
+}
+
+func (s *webhookService) ValidateFile(id string, opts *ach.ValidateOpts) error {
+	err := s.Service.ValidateFile(id, opts)
+	switch {
+	case err == nil:
+		s.send(webhook.Event{Type: webhook.FileValidated, FileID: id})
+	case !errors.Is(err, ErrNotFound):
+		s.send(webhook.Event{Type: webhook.FileValidationFailed, FileID: id, Error: err.Error()})
+	}
+	return err
+}
+
+func (s *webhookService) CreateBatch(fileID string, batch ach.Batcher) (string, error) {
+	id, err := s.Service.CreateBatch(fileID, batch)
+	if err == nil {
+		s.send(webhook.Event{Type: webhook.BatchCreated, FileID: fileID, BatchID: id})
+	}
+	return id, err
+}
+
+func (s *webhookService) DeleteBatch(fileID string, batchID string) error {
+	err := s.Service.DeleteBatch(fileID, batchID)
+	if err == nil {
+		s.send(webhook.Event{Type: webhook.BatchDeleted, FileID: fileID, BatchID: batchID})
+	}
+	return err
+}
+
+// tenantOf returns the tenant of an authenticated request, or an empty string
+func tenantOf(ctx context.Context) string {
+	if p := auth.FromContext(ctx); p != nil {
+		return p.Tenant
+	}
+	return ""
+}
+
+// getSubscription returns the subscription with id if it belongs to tenant
+func getSubscription(hooks *webhook.Dispatcher, tenant string, id string) (*webhook.Subscription, error) {
+	sub, err := hooks.Store().GetSubscription(id)
+	if err == webhook.ErrNotFound || (err == nil && sub.Tenant != tenant) {
+		return nil, ErrNotFound
+	}
+	return sub, err
+}
+
+// randomSecret returns 32 random bytes hex encoded
+func randomSecret() (string, error) {
+	bs := make([]byte, 32)
+	if _, err := rand.Read(bs); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bs), nil
+}
+
+// withoutSecret returns a copy of sub which doesn't reveal its secret
+func withoutSecret(sub *webhook.Subscription) *webhook.Subscription {
+	out := *sub
+	out.Secret = ""
+	return &out
+}
+
+type webhookRequest struct {
+	subscriptionID string
+	subscription   *webhook.Subscription
+	requestID      string
+}
+
+func decodeWebhookRequest(_ context.Context, r *http.Request) (interface{}, error) {
+	req := webhookRequest{
+		subscriptionID: mux.Vars(r)["subscriptionID"],
+		requestID:      moovhttp.GetRequestID(r),
+	}
+	if r.Method == "POST" {
+		if err := json.NewDecoder(r.Body).Decode(&req.subscription); err != nil {
+			return nil, fmt.Errorf("%v: %v", errInvalidWebhook, err)
+		}
+		if req.subscription == nil {
+			return nil, fmt.Errorf("%v: no subscription provided", errInvalidWebhook)
+		}
+	}
+	return req, nil
+}
+
+type getWebhooksResponse struct {
+	Subscriptions []*webhook.Subscription `json:"subscriptions"`
+	Err           error                   `json:"error"`
+}
+
+func (r getWebhooksResponse) count() int { return len(r.Subscriptions) }
+
+func (r getWebhooksResponse) error() error { return r.Err }
+
+func getWebhooksEndpoint(hooks *webhook.Dispatcher, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(webhookRequest)
+		if !ok {
+			return getWebhooksResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		subs, err := hooks.Store().ListSubscriptions()
+		if logger != nil {
+			logger.Log("webhooks", "getWebhooks", "requestID", req.requestID, "error", err)
+		}
+		tenant := tenantOf(ctx)
+		out := make([]*webhook.Subscription, 0, len(subs))
+		for i := range subs {
+			if subs[i].Tenant == tenant {
+				out = append(out, withoutSecret(subs[i]))
+			}
+		}
+		return getWebhooksResponse{
+			Subscriptions: out,
+			Err:           err,
+		}, nil
+	}
+}
+
+type webhookResponse struct {
+	Subscription *webhook.Subscription `json:"subscription"`
+	Err          error                 `json:"error"`
+}
+
+func (r webhookResponse) error() error { return r.Err }
+
+// createWebhookEndpoint stores a new subscription, generating its secret if none was provided.
+// The secret is only included in this response.
+func createWebhookEndpoint(hooks *webhook.Dispatcher, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(webhookRequest)
+		if !ok || req.subscription == nil {
+			return webhookResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		sub := req.subscription
+		sub.ID = base.ID()
+		sub.Tenant = tenantOf(ctx)
+
+		var err error
+		if sub.Secret == "" {
+			sub.Secret, err = randomSecret()
+		}
+		if err == nil {
+			if err = sub.Validate(); err != nil {
+				err = fmt.Errorf("%v: %v", errInvalidWebhook, err)
+			} else {
+				err = hooks.Store().SaveSubscription(sub)
+			}
+		}
+		if logger != nil {
+			logger.Log("webhooks", "createWebhook", "subscriptionID", sub.ID, "requestID", req.requestID, "error", err)
+		}
+		if err != nil {
+			return webhookResponse{Err: err}, nil
+		}
+		return webhookResponse{Subscription: sub}, nil
+	}
+}
+
+func getWebhookEndpoint(hooks *webhook.Dispatcher, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(webhookRequest)
+		if !ok {
+			return webhookResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		sub, err := getSubscription(hooks, tenantOf(ctx), req.subscriptionID)
+		if logger != nil {
+			logger.Log("webhooks", "getWebhook", "subscriptionID", req.subscriptionID, "requestID", req.requestID, "error", err)
+		}
+		if err != nil {
+			return webhookResponse{Err: err}, nil
+		}
+		return webhookResponse{Subscription: withoutSecret(sub)}, nil
+	}
+}
+
+type deleteWebhookResponse struct {
+	Err error `json:"error"`
+}
+
+func (r deleteWebhookResponse) error() error { return r.Err }
+
+func deleteWebhookEndpoint(hooks *webhook.Dispatcher, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(webhookRequest)
+		if !ok {
+			return deleteWebhookResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		_, err := getSubscription(hooks, tenantOf(ctx), req.subscriptionID)
+		if err == ErrNotFound {
+			err = nil // deleting is idempotent
+		} else if err == nil {
+			err = hooks.Store().DeleteSubscription(req.subscriptionID)
+		}
+		if logger != nil {
+			logger.Log("webhooks", "deleteWebhook", "subscriptionID", req.subscriptionID, "requestID", req.requestID, "error", err)
+		}
+		return deleteWebhookResponse{Err: err}, nil
+	}
+}
+
+type getDeliveriesResponse struct {
+	Deliveries []*webhook.Delivery `json:"deliveries"`
+	Err        error               `json:"error"`
+}
+
+func (r getDeliveriesResponse) count() int { return len(r.Deliveries) }
+
+func (r getDeliveriesResponse) error() error { return r.Err }
+
+// getDeliveriesEndpoint returns the delivery log of a subscription, or of every
+// subscription when none is in the path, newest first.
+func getDeliveriesEndpoint(hooks *webhook.Dispatcher, logger log.Logger) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(webhookRequest)
+		if !ok {
+			return getDeliveriesResponse{Err: ErrFoundABug}, ErrFoundABug
+		}
+
+		tenant := tenantOf(ctx)
+		var err error
+		if req.subscriptionID != "" {
+			_, err = getSubscription(hooks, tenant, req.subscriptionID)
+		}
+		var deliveries []*webhook.Delivery
+		if err == nil {
+			var all []*webhook.Delivery
+			all, err = hooks.Store().ListDeliveries(req.subscriptionID)
+			for i := range all {
+				if all[i].Event.Tenant == tenant {
+					deliveries = append(deliveries, all[i])
+				}
+			}
+		}
+		if logger != nil {
+			logger.Log("webhooks", "getDeliveries", "subscriptionID", req.subscriptionID, "requestID", req.requestID, "error", err)
+		}
+		if deliveries == nil {
+			deliveries = []*webhook.Delivery{}
+		}
+		return getDeliveriesResponse{
+			Deliveries: deliveries,
+			Err:        err,
+		}, nil
+	}
+}
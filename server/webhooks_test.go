@@ -0,0 +1,246 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/moov-io/ach"
+	"github.com/moov-io/ach/server/pb"
+	"github.com/moov-io/ach/webhook"
+
+	"github.com/go-kit/kit/log"
+)
+
+type webhookReceiver struct {
+	mu     sync.Mutex
+	events []webhook.Event
+}
+
+func (rcv *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	var event webhook.Event
+	if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	rcv.events = append(rcv.events, event)
+}
+
+func (rcv *webhookReceiver) types() []string {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	var out []string
+	for _, e := range rcv.events {
+		out = append(out, e.Type)
+	}
+	return out
+}
+
+func TestWebhooks(t *testing.T) {
+	rcv := &webhookReceiver{}
+	srv := httptest.NewServer(rcv)
+	defer srv.Close()
+
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(100), webhook.Retries(1, time.Millisecond))
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), SendWebhooks(hooks))
+
+	// subscribe
+	w := serveJSON(t, handler, "POST", "/webhooks", webhook.Subscription{URL: srv.URL})
+	if w.Code != http.StatusOK {
+		t.Fatalf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	var created webhookResponse
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	sub := created.Subscription
+	if sub == nil || sub.ID == "" || len(sub.Secret) != 64 {
+		t.Fatalf("unexpected subscription: %#v", sub)
+	}
+
+	w = serveJSON(t, handler, "GET", "/webhooks/"+sub.ID, nil)
+	var found webhookResponse
+	json.NewDecoder(w.Body).Decode(&found)
+	if w.Code != http.StatusOK || found.Subscription.URL != srv.URL || found.Subscription.Secret != "" {
+		t.Errorf("bogus HTTP status: %d: %#v", w.Code, found.Subscription)
+	}
+	if w := serveJSON(t, handler, "GET", "/webhooks", nil); w.Code != http.StatusOK || w.Header().Get("X-Total-Count") != "1" {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// create, validate, change and delete a file
+	req := httptest.NewRequest("POST", "/files/create", bytes.NewReader(readTestdata(t, "ppd-debit.ach")))
+	req.Header.Set("Content-Type", "text/plain")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	var file createFileResponse
+	if err := json.NewDecoder(w.Body).Decode(&file); err != nil || file.ID == "" {
+		t.Fatalf("file=%#v error=%v", file, err)
+	}
+	f, _ := repo.FindFile(file.ID)
+	batchID := f.Batches[0].ID()
+
+	hooks.Wait() // each event is delivered in the background, so wait to keep them in order
+	serveJSON(t, handler, "POST", "/files/"+file.ID+"/validate", nil)
+	hooks.Wait()
+	serveJSON(t, handler, "DELETE", "/files/"+file.ID+"/batches/"+batchID, nil)
+	hooks.Wait()
+	serveJSON(t, handler, "POST", "/files/"+file.ID+"/validate", nil) // no batches
+	hooks.Wait()
+	serveJSON(t, handler, "POST", "/files/missing/validate", nil) // no event for files which don't exist
+	hooks.Wait()
+	serveJSON(t, handler, "DELETE", "/files/"+file.ID, nil)
+	hooks.Wait()
+
+	expected := []string{webhook.FileCreated, webhook.FileValidated, webhook.BatchDeleted, webhook.FileValidationFailed, webhook.FileDeleted}
+	if types := rcv.types(); len(types) != len(expected) {
+		t.Fatalf("got events %v", types)
+	} else {
+		for i := range expected {
+			if types[i] != expected[i] {
+				t.Errorf("events[%d]=%s expected %s", i, types[i], expected[i])
+			}
+		}
+	}
+
+	// delivery log
+	w = serveJSON(t, handler, "GET", "/webhooks/"+sub.ID+"/deliveries", nil)
+	var deliveries getDeliveriesResponse
+	if err := json.NewDecoder(w.Body).Decode(&deliveries); err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries.Deliveries) != len(expected) || deliveries.Deliveries[0].Event.Type != webhook.FileDeleted {
+		t.Errorf("unexpected deliveries: %#v", deliveries.Deliveries)
+	}
+	for _, d := range deliveries.Deliveries {
+		if d.Status != webhook.StatusDelivered {
+			t.Errorf("unexpected delivery: %#v", d)
+		}
+	}
+	if w := serveJSON(t, handler, "GET", "/webhooks/deliveries", nil); w.Code != http.StatusOK || w.Header().Get("X-Total-Count") != "5" {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// unsubscribe
+	if w := serveJSON(t, handler, "DELETE", "/webhooks/"+sub.ID, nil); w.Code != http.StatusOK {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveJSON(t, handler, "GET", "/webhooks/"+sub.ID, nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+	if w := serveJSON(t, handler, "GET", "/webhooks/"+sub.ID+"/deliveries", nil); w.Code != http.StatusNotFound {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+}
+
+func TestWebhooks__gRPC(t *testing.T) {
+	rcv := &webhookReceiver{}
+	srv := httptest.NewServer(rcv)
+	defer srv.Close()
+
+	hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(100))
+	hooks.Store().SaveSubscription(&webhook.Subscription{ID: "sub", URL: srv.URL, Secret: "secret"})
+
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	client := grpcTestClient(t, WithWebhooks(NewService(repo), hooks, log.NewNopLogger()), repo)
+	ctx := context.Background()
+
+	created, err := client.CreateFile(ctx, &pb.CreateFileRequest{
+		Source: &pb.CreateFileRequest_Contents{Contents: readTestdata(t, "ppd-debit.ach")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hooks.Wait()
+	if _, err := client.ValidateFile(ctx, &pb.ValidateFileRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	hooks.Wait()
+	if _, err := client.DeleteFile(ctx, &pb.DeleteFileRequest{Id: created.GetId()}); err != nil {
+		t.Fatal(err)
+	}
+	hooks.Wait()
+
+	expected := []string{webhook.FileCreated, webhook.FileValidated, webhook.FileDeleted}
+	if types := rcv.types(); strings.Join(types, ",") != strings.Join(expected, ",") {
+		t.Errorf("got events %v", types)
+	}
+}
+
+func TestWebhooks__errors(t *testing.T) {
+	repo := NewRepositoryInMemory(testTTLDuration, nil)
+	hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(100))
+	handler := MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), SendWebhooks(hooks))
+
+	if w := serveJSON(t, handler, "POST", "/webhooks", webhook.Subscription{URL: "ftp://example.com"}); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveJSON(t, handler, "POST", "/webhooks", webhook.Subscription{URL: "https://example.com", Events: []string{"other"}}); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+	if w := serveJSON(t, handler, "POST", "/webhooks", "subscription"); w.Code != http.StatusBadRequest {
+		t.Errorf("bogus HTTP status: %d: %s", w.Code, w.Body.String())
+	}
+
+	// routes only exist with the option
+	handler = MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger())
+	if w := serveJSON(t, handler, "GET", "/webhooks", nil); w.Code == http.StatusOK {
+		t.Errorf("bogus HTTP status: %d", w.Code)
+	}
+}
+
+func TestWebhooks__expired(t *testing.T) {
+	rcv := &webhookReceiver{}
+	srv := httptest.NewServer(rcv)
+	defer srv.Close()
+
+	hooks := webhook.NewDispatcher(webhook.NewStoreInMemory(100))
+	hooks.Store().SaveSubscription(&webhook.Subscription{ID: "sub", URL: srv.URL, Secret: "secret", Events: []string{webhook.FileExpired}})
+
+	repo := &repositoryInMemory{
+		files: make(map[string]*ach.File),
+		ttl:   time.Hour,
+	}
+	MakeHTTPHandler(NewService(repo), repo, log.NewNopLogger(), SendWebhooks(hooks))
+
+	f := ach.NewFile()
+	f.ID = "old"
+	f.Header.FileCreationDate = time.Now().AddDate(0, 0, -2).Format("060102")
+	repo.StoreFile(f)
+
+	repo.cleanupOldFiles()
+	hooks.Wait()
+
+	if rcv.mu.Lock(); len(rcv.events) != 1 || rcv.events[0].FileID != "old" {
+		t.Errorf("events=%#v", rcv.events)
+	}
+	rcv.mu.Unlock()
+}
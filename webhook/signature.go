@@ -0,0 +1,92 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers sent with each webhook.
+const (
+	// SignatureHeader holds the timestamp and signature of the body as "t=<unix seconds>,v1=<hex>"
+	SignatureHeader = "X-ACH-Signature"
+	// EventHeader holds the Event's type
+	EventHeader = "X-ACH-Event"
+	// DeliveryHeader holds the Delivery ID, which is the same for each retry
+	DeliveryHeader = "X-ACH-Delivery"
+)
+
+var (
+	errInvalidSignature = errors.New("invalid webhook signature")
+)
+
+// Sign returns the SignatureHeader value for body sent at timestamp. The signature is the
+// HMAC-SHA256, keyed with secret, of the unix timestamp, a '.' and the body.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	ts := strconv.FormatInt(timestamp.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", ts, signature(secret, ts, body))
+}
+
+func signature(secret string, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks header (the SignatureHeader value) is a signature of body made with secret
+// within tolerance of now. A tolerance of zero skips checking the timestamp.
+func Verify(secret string, header string, body []byte, tolerance time.Duration) error {
+	var ts, sig string
+	for _, part := range strings.Split(header, ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "t":
+			ts = kv[1]
+		case "v1":
+			sig = kv[1]
+		}
+	}
+	if ts == "" || sig == "" {
+		return fmt.Errorf("%v: malformed header", errInvalidSignature)
+	}
+	if tolerance > 0 {
+		n, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%v: malformed timestamp", errInvalidSignature)
+		}
+		if diff := time.Since(time.Unix(n, 0)); diff > tolerance || diff < -tolerance {
+			return fmt.Errorf("%v: timestamp outside of tolerance", errInvalidSignature)
+		}
+	}
+	if !hmac.Equal([]byte(sig), []byte(signature(secret, ts, body))) {
+		return errInvalidSignature
+	}
+	return nil
+}
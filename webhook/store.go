@@ -0,0 +1,140 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package webhook
+
+import (
+	"errors"
+	"sort"
+	"sync"
+)
+
+// ErrNotFound is returned for subscriptions which don't exist.
+var ErrNotFound = errors.New("subscription not found")
+
+// Store holds subscriptions and the log of deliveries.
+type Store interface {
+	// ListSubscriptions returns every Subscription.
+	ListSubscriptions() ([]*Subscription, error)
+	// GetSubscription returns the Subscription with id, or ErrNotFound.
+	GetSubscription(id string) (*Subscription, error)
+	// SaveSubscription creates or replaces the Subscription with sub.ID.
+	SaveSubscription(sub *Subscription) error
+	// DeleteSubscription removes the Subscription with id.
+	DeleteSubscription(id string) error
+
+	// SaveDelivery creates or replaces the Delivery with delivery.ID.
+	SaveDelivery(delivery *Delivery) error
+	// ListDeliveries returns the deliveries to subscriptionID (or to every Subscription when empty), newest first.
+	ListDeliveries(subscriptionID string) ([]*Delivery, error)
+}
+
+type storeInMemory struct {
+	mu            sync.RWMutex
+	subscriptions map[string]*Subscription
+	deliveries    []*Delivery // oldest first
+
+	maxDeliveries int
+}
+
+// NewStoreInMemory returns a Store which keeps subscriptions and the most recent
+// maxDeliveries deliveries in memory.
+func NewStoreInMemory(maxDeliveries int) Store {
+	return &storeInMemory{
+		subscriptions: make(map[string]*Subscription),
+		maxDeliveries: maxDeliveries,
+	}
+}
+
+func copySubscription(sub *Subscription) *Subscription {
+	out := *sub
+	out.Events = append([]string(nil), sub.Events...)
+	return &out
+}
+
+func (s *storeInMemory) ListSubscriptions() ([]*Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*Subscription, 0, len(s.subscriptions))
+	for _, sub := range s.subscriptions {
+		out = append(out, copySubscription(sub))
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].ID < out[j].ID
+	})
+	return out, nil
+}
+
+func (s *storeInMemory) GetSubscription(id string) (*Subscription, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if sub, ok := s.subscriptions[id]; ok {
+		return copySubscription(sub), nil
+	}
+	return nil, ErrNotFound
+}
+
+func (s *storeInMemory) SaveSubscription(sub *Subscription) error {
+	if err := sub.Validate(); err != nil {
+		return err
+	}
+	if sub.ID == "" {
+		return errors.New("missing Subscription ID")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions[sub.ID] = copySubscription(sub)
+	return nil
+}
+
+func (s *storeInMemory) DeleteSubscription(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscriptions, id)
+	return nil
+}
+
+func (s *storeInMemory) SaveDelivery(delivery *Delivery) error {
+	d := *delivery
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.deliveries {
+		if s.deliveries[i].ID == d.ID {
+			s.deliveries[i] = &d
+			return nil
+		}
+	}
+	s.deliveries = append(s.deliveries, &d)
+	if s.maxDeliveries > 0 && len(s.deliveries) > s.maxDeliveries {
+		s.deliveries = s.deliveries[len(s.deliveries)-s.maxDeliveries:]
+	}
+	return nil
+}
+
+func (s *storeInMemory) ListDeliveries(subscriptionID string) ([]*Delivery, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var out []*Delivery
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		if subscriptionID == "" || s.deliveries[i].SubscriptionID == subscriptionID {
+			d := *s.deliveries[i]
+			out = append(out, &d)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,75 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package webhook
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStoreInMemory__subscriptions(t *testing.T) {
+	store := NewStoreInMemory(10)
+
+	if sub, err := store.GetSubscription("a"); sub != nil || err != ErrNotFound {
+		t.Fatalf("subscription=%#v error=%v", sub, err)
+	}
+	if err := store.SaveSubscription(&Subscription{ID: "a"}); err == nil {
+		t.Error("expected error")
+	}
+	for _, id := range []string{"b", "a"} {
+		if err := store.SaveSubscription(&Subscription{ID: id, URL: "https://example.com", Secret: "secret"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	subs, err := store.ListSubscriptions()
+	if err != nil || len(subs) != 2 || subs[0].ID != "a" {
+		t.Fatalf("subscriptions=%#v error=%v", subs, err)
+	}
+
+	if err := store.DeleteSubscription("a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetSubscription("a"); err != ErrNotFound {
+		t.Errorf("expected ErrNotFound: %v", err)
+	}
+}
+
+func TestStoreInMemory__deliveries(t *testing.T) {
+	store := NewStoreInMemory(3)
+
+	for i := 0; i < 5; i++ {
+		sub := "a"
+		if i%2 == 1 {
+			sub = "b"
+		}
+		store.SaveDelivery(&Delivery{ID: fmt.Sprintf("d%d", i), SubscriptionID: sub, Status: StatusPending})
+	}
+	store.SaveDelivery(&Delivery{ID: "d4", SubscriptionID: "a", Status: StatusDelivered})
+
+	deliveries, err := store.ListDeliveries("")
+	if err != nil || len(deliveries) != 3 {
+		t.Fatalf("deliveries=%#v error=%v", deliveries, err)
+	}
+	if deliveries[0].ID != "d4" || deliveries[0].Status != StatusDelivered || deliveries[2].ID != "d2" {
+		t.Errorf("unexpected deliveries: %#v", deliveries)
+	}
+	if deliveries, _ := store.ListDeliveries("b"); len(deliveries) != 1 || deliveries[0].ID != "d3" {
+		t.Errorf("unexpected deliveries: %#v", deliveries)
+	}
+}
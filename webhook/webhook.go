@@ -0,0 +1,323 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package webhook delivers signed HTTP callbacks for ACH file lifecycle events.
+//
+// Each Subscription receives a POST of the Event as JSON for the event types it lists.
+// Requests carry an HMAC-SHA256 signature of the body, made with the Subscription's secret,
+// in the SignatureHeader so receivers can check them with Verify. Failed deliveries are
+// retried with exponential backoff and every attempt is recorded in a Delivery.
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/moov-io/base"
+)
+
+// Types of events sent to subscriptions.
+const (
+	FileCreated          = "file.created"
+	FileValidated        = "file.validated"
+	FileValidationFailed = "file.validationFailed"
+	FileDeleted          = "file.deleted"
+	FileExpired          = "file.expired"
+	BatchCreated         = "batch.created"
+	BatchDeleted         = "batch.deleted"
+)
+
+// EventTypes lists every event type a Subscription can receive.
+var EventTypes = []string{
+	FileCreated,
+	FileValidated,
+	FileValidationFailed,
+	FileDeleted,
+	FileExpired,
+	BatchCreated,
+	BatchDeleted,
+}
+
+// Event describes a change to a file, sent as the body of each webhook.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	FileID    string    `json:"fileID"`
+	BatchID   string    `json:"batchID,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Tenant owns the file, it's empty unless the server authenticates requests
+	Tenant string `json:"tenant,omitempty"`
+
+	// Error is the validation error of FileValidationFailed events
+	Error string `json:"error,omitempty"`
+}
+
+// Subscription is an endpoint which receives events.
+type Subscription struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+
+	// Secret signs the body of each webhook (see Sign and Verify)
+	Secret string `json:"secret,omitempty"`
+
+	// Events lists the event types to send, every type when empty
+	Events []string `json:"events,omitempty"`
+
+	// Tenant only receives events of its own files
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate checks the Subscription has an http(s) URL, a secret and known event types.
+func (s *Subscription) Validate() error {
+	if s == nil {
+		return errors.New("nil Subscription")
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL %q", s.URL)
+	}
+	if s.Secret == "" {
+		return errors.New("missing Secret")
+	}
+	for _, e := range s.Events {
+		if !knownEventType(e) {
+			return fmt.Errorf("unknown event type %q", e)
+		}
+	}
+	return nil
+}
+
+func (s *Subscription) wants(event Event) bool {
+	if s.Tenant != event.Tenant {
+		return false
+	}
+	if len(s.Events) == 0 {
+		return true
+	}
+	for _, e := range s.Events {
+		if e == event.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func knownEventType(eventType string) bool {
+	for _, e := range EventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Statuses of a Delivery.
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// Delivery records the attempts made to send an Event to a Subscription.
+type Delivery struct {
+	ID             string `json:"id"`
+	SubscriptionID string `json:"subscriptionID"`
+	URL            string `json:"url"`
+	Event          Event  `json:"event"`
+
+	// Status is StatusPending until the Event is delivered or every attempt fails
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+
+	// StatusCode and Error describe the last attempt
+	StatusCode int    `json:"statusCode,omitempty"`
+	Error      string `json:"error,omitempty"`
+
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Dispatcher sends events to every matching Subscription in its Store.
+type Dispatcher struct {
+	store  Store
+	client *http.Client
+
+	maxAttempts int
+	backoff     time.Duration
+
+	now func() time.Time
+	wg  sync.WaitGroup
+}
+
+// Option configures a Dispatcher.
+type Option func(*Dispatcher)
+
+// HTTPClient sets the client used to send webhooks, which defaults to a client with a 10s timeout.
+func HTTPClient(client *http.Client) Option {
+	return func(d *Dispatcher) {
+		d.client = client
+	}
+}
+
+// Retries sets how many times a webhook is attempted (default 5) and the delay before the first
+// retry (default 1s), which doubles after each failed attempt.
+func Retries(maxAttempts int, backoff time.Duration) Option {
+	return func(d *Dispatcher) {
+		d.maxAttempts = maxAttempts
+		d.backoff = backoff
+	}
+}
+
+// NewDispatcher returns a Dispatcher which reads subscriptions and records deliveries in store.
+func NewDispatcher(store Store, opts ...Option) *Dispatcher {
+	d := &Dispatcher{
+		store:       store,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		maxAttempts: 5,
+		backoff:     time.Second,
+		now:         time.Now,
+	}
+	for i := range opts {
+		opts[i](d)
+	}
+	if d.maxAttempts < 1 {
+		d.maxAttempts = 1
+	}
+	return d
+}
+
+// Store returns the underlying Store of subscriptions and deliveries.
+func (d *Dispatcher) Store() Store {
+	return d.store
+}
+
+// Send delivers event in the background to each Subscription which wants its type.
+// A nil Dispatcher sends nothing.
+func (d *Dispatcher) Send(event Event) error {
+	if d == nil {
+		return nil
+	}
+	if event.ID == "" {
+		event.ID = base.ID()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = d.now()
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	subs, err := d.store.ListSubscriptions()
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		if !sub.wants(event) {
+			continue
+		}
+		delivery := &Delivery{
+			ID:             base.ID(),
+			SubscriptionID: sub.ID,
+			URL:            sub.URL,
+			Event:          event,
+			Status:         StatusPending,
+			CreatedAt:      d.now(),
+		}
+		delivery.UpdatedAt = delivery.CreatedAt
+		if err := d.store.SaveDelivery(delivery); err != nil {
+			return err
+		}
+
+		d.wg.Add(1)
+		go func(sub *Subscription, delivery *Delivery) {
+			defer d.wg.Done()
+			d.deliver(sub, delivery, body)
+		}(sub, delivery)
+	}
+	return nil
+}
+
+// Wait blocks until every event sent has been delivered or failed.
+func (d *Dispatcher) Wait() {
+	if d != nil {
+		d.wg.Wait()
+	}
+}
+
+func (d *Dispatcher) deliver(sub *Subscription, delivery *Delivery, body []byte) {
+	backoff := d.backoff
+	for {
+		delivery.Attempts++
+		delivery.StatusCode, delivery.Error = 0, ""
+
+		code, err := d.post(sub, delivery, body)
+		delivery.StatusCode = code
+		if err != nil {
+			delivery.Error = err.Error()
+		}
+		delivery.UpdatedAt = d.now()
+
+		switch {
+		case err == nil:
+			delivery.Status = StatusDelivered
+		case delivery.Attempts >= d.maxAttempts:
+			delivery.Status = StatusFailed
+		}
+		d.store.SaveDelivery(delivery)
+		if delivery.Status != StatusPending {
+			return
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (d *Dispatcher) post(sub *Subscription, delivery *Delivery, body []byte) (int, error) {
+	req, err := http.NewRequest("POST", sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "moov-io/ach webhooks")
+	req.Header.Set(EventHeader, delivery.Event.Type)
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, d.now(), body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected %s response", strings.TrimSpace(resp.Status))
+	}
+	return resp.StatusCode, nil
+}
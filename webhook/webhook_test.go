@@ -0,0 +1,202 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testReceiver records the events it's sent and fails the first failures requests
+type testReceiver struct {
+	mu       sync.Mutex
+	events   []Event
+	failures int
+	secret   string
+	t        *testing.T
+}
+
+func (rcv *testReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+
+	if rcv.failures > 0 {
+		rcv.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	body, _ := ioutil.ReadAll(r.Body)
+	if err := Verify(rcv.secret, r.Header.Get(SignatureHeader), body, time.Minute); err != nil {
+		rcv.t.Errorf("signature: %v", err)
+	}
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		rcv.t.Error(err)
+	}
+	if r.Header.Get(EventHeader) != event.Type || r.Header.Get(DeliveryHeader) == "" {
+		rcv.t.Errorf("unexpected headers: %v", r.Header)
+	}
+	rcv.events = append(rcv.events, event)
+}
+
+func TestDispatcher(t *testing.T) {
+	rcv := &testReceiver{secret: "secret", t: t}
+	srv := httptest.NewServer(rcv)
+	defer srv.Close()
+
+	store := NewStoreInMemory(100)
+	d := NewDispatcher(store, Retries(3, time.Millisecond))
+
+	all := &Subscription{ID: "all", URL: srv.URL, Secret: "secret"}
+	deleted := &Subscription{ID: "deleted", URL: srv.URL, Secret: "secret", Events: []string{FileDeleted}}
+	tenant := &Subscription{ID: "tenant", URL: srv.URL, Secret: "secret", Tenant: "acme"}
+	for _, sub := range []*Subscription{all, deleted, tenant} {
+		if err := store.SaveSubscription(sub); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := d.Send(Event{Type: FileCreated, FileID: "f1"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Send(Event{Type: FileDeleted, FileID: "f1"}); err != nil {
+		t.Fatal(err)
+	}
+	d.Wait()
+
+	if len(rcv.events) != 3 {
+		t.Fatalf("got %d events", len(rcv.events))
+	}
+	for _, e := range rcv.events {
+		if e.ID == "" || e.FileID != "f1" || e.CreatedAt.IsZero() {
+			t.Errorf("unexpected event: %#v", e)
+		}
+	}
+
+	deliveries, err := store.ListDeliveries("deleted")
+	if err != nil || len(deliveries) != 1 {
+		t.Fatalf("deliveries=%#v error=%v", deliveries, err)
+	}
+	if d := deliveries[0]; d.Status != StatusDelivered || d.Attempts != 1 || d.StatusCode != http.StatusOK || d.Event.Type != FileDeleted {
+		t.Errorf("unexpected delivery: %#v", d)
+	}
+
+	// subscriptions of a tenant only receive its events
+	if deliveries, _ := store.ListDeliveries("tenant"); len(deliveries) != 0 {
+		t.Errorf("deliveries=%#v", deliveries)
+	}
+	d.Send(Event{Type: FileCreated, FileID: "f2", Tenant: "acme"})
+	d.Wait()
+	if deliveries, _ := store.ListDeliveries(""); len(deliveries) != 4 || deliveries[0].SubscriptionID != "tenant" {
+		t.Errorf("deliveries=%#v", deliveries)
+	}
+}
+
+func TestDispatcher__retries(t *testing.T) {
+	rcv := &testReceiver{secret: "secret", failures: 2, t: t}
+	srv := httptest.NewServer(rcv)
+	defer srv.Close()
+
+	store := NewStoreInMemory(100)
+	store.SaveSubscription(&Subscription{ID: "sub", URL: srv.URL, Secret: "secret"})
+
+	d := NewDispatcher(store, Retries(3, time.Millisecond))
+	d.Send(Event{Type: BatchCreated, FileID: "f1", BatchID: "b1"})
+	d.Wait()
+
+	deliveries, _ := store.ListDeliveries("")
+	if len(deliveries) != 1 || deliveries[0].Status != StatusDelivered || deliveries[0].Attempts != 3 {
+		t.Fatalf("deliveries=%#v", deliveries)
+	}
+	if len(rcv.events) != 1 || rcv.events[0].BatchID != "b1" {
+		t.Errorf("events=%#v", rcv.events)
+	}
+
+	// give up after every attempt fails
+	rcv.failures = 5
+	d.Send(Event{Type: FileExpired, FileID: "f1"})
+	d.Wait()
+
+	deliveries, _ = store.ListDeliveries("sub")
+	if len(deliveries) != 2 {
+		t.Fatalf("deliveries=%#v", deliveries)
+	}
+	if d := deliveries[0]; d.Status != StatusFailed || d.Attempts != 3 || d.StatusCode != http.StatusServiceUnavailable || d.Error == "" {
+		t.Errorf("unexpected delivery: %#v", d)
+	}
+}
+
+func TestDispatcher__nil(t *testing.T) {
+	var d *Dispatcher
+	if err := d.Send(Event{Type: FileCreated}); err != nil {
+		t.Error(err)
+	}
+	d.Wait()
+}
+
+func TestSubscription__Validate(t *testing.T) {
+	cases := map[string]*Subscription{
+		"nil":      nil,
+		"url":      {URL: "ftp://example.com", Secret: "secret"},
+		"relative": {URL: "/webhooks", Secret: "secret"},
+		"secret":   {URL: "https://example.com"},
+		"events":   {URL: "https://example.com", Secret: "secret", Events: []string{"file.renamed"}},
+	}
+	for name, sub := range cases {
+		if err := sub.Validate(); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	sub := &Subscription{URL: "https://example.com/webhooks", Secret: "secret", Events: []string{FileCreated}}
+	if err := sub.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"type":"file.created"}`)
+	header := Sign("secret", time.Now(), body)
+
+	if err := Verify("secret", header, body, time.Minute); err != nil {
+		t.Error(err)
+	}
+	if err := Verify("other", header, body, time.Minute); err == nil {
+		t.Error("expected error")
+	}
+	if err := Verify("secret", header, []byte(`{}`), time.Minute); err == nil {
+		t.Error("expected error")
+	}
+	if err := Verify("secret", "v1=abc", body, 0); err == nil {
+		t.Error("expected error")
+	}
+
+	old := Sign("secret", time.Now().Add(-1*time.Hour), body)
+	if err := Verify("secret", old, body, time.Minute); err == nil {
+		t.Error("expected error")
+	}
+	if err := Verify("secret", old, body, 0); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,179 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+)
+
+// fileXML is the XML layout of a File. Batchers are written as batch elements with
+// the records of their Batch and the NotificationOfChange and ReturnEntries references
+// are left out since they're rebuilt from the batches.
+type fileXML struct {
+	ID         string          `xml:"id"`
+	Header     FileHeader      `xml:"fileHeader"`
+	Batches    []*Batch        `xml:"batch"`
+	IATBatches []IATBatch      `xml:"IATBatch"`
+	Control    *FileControl    `xml:"fileControl,omitempty"`
+	ADVControl *ADVFileControl `xml:"fileADVControl,omitempty"`
+}
+
+// FileFromXML attempts to return a *File object assuming the input is valid XML
+// in the layout described by ach.xsd.
+//
+// Callers should always check for a nil-error before using the returned file.
+//
+// The File returned may not be valid and callers should confirm with Validate().
+// Invalid files may be rejected by other Financial Institutions or ACH tools.
+func FileFromXML(bs []byte) (*File, error) {
+	if len(bs) == 0 {
+		return nil, errors.New("no XML data provided")
+	}
+	fx := newFileXML()
+	if err := xml.NewDecoder(bytes.NewReader(bs)).Decode(fx); err != nil {
+		return nil, fmt.Errorf("problem reading File: %v", err)
+	}
+	return fx.file()
+}
+
+// MarshalXML writes the File as XML in the layout described by ach.xsd.
+func (f *File) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	fx := &fileXML{
+		ID:         f.ID,
+		Header:     f.Header,
+		IATBatches: f.IATBatches,
+	}
+	for _, b := range f.Batches {
+		batch := &Batch{Header: b.GetHeader()}
+		if b.GetHeader().StandardEntryClassCode == ADV {
+			batch.ADVEntries = b.GetADVEntries()
+			batch.ADVControl = b.GetADVControl()
+		} else {
+			batch.Entries = b.GetEntries()
+			batch.Control = b.GetControl()
+		}
+		fx.Batches = append(fx.Batches, batch)
+	}
+	if f.IsADV() {
+		fx.ADVControl = &f.ADVControl
+	} else {
+		fx.Control = &f.Control
+	}
+	return e.EncodeElement(fx, start)
+}
+
+// UnmarshalXML reads a File from XML in the layout described by ach.xsd.
+func (f *File) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	fx := newFileXML()
+	if err := d.DecodeElement(fx, &start); err != nil {
+		return err
+	}
+	file, err := fx.file()
+	if err != nil {
+		return err
+	}
+	if file != nil {
+		*f = *file
+	}
+	return nil
+}
+
+func newFileXML() *fileXML {
+	control, advControl := NewFileControl(), NewADVFileControl()
+	return &fileXML{
+		Header:     NewFileHeader(),
+		Control:    &control,
+		ADVControl: &advControl,
+	}
+}
+
+// file builds a File from the decoded records, just like FileFromJSON.
+func (fx *fileXML) file() (*File, error) {
+	file := NewFile()
+	file.ID = fx.ID
+	file.Header = fx.Header
+
+	for _, batch := range fx.Batches {
+		if batch == nil {
+			continue
+		}
+		batch.Header.recordType = batchHeaderPos
+		for _, e := range batch.Entries {
+			setEntryRecordType(e)
+		}
+		for _, e := range batch.ADVEntries {
+			setADVEntryRecordType(e)
+		}
+		if err := batch.build(); err != nil {
+			return nil, batch.Error("Invalid Batch", err, batch.Header.ID)
+		}
+		file.AddBatch(ConvertBatchType(*batch))
+	}
+	for i := range fx.IATBatches {
+		iatBatch := fx.IATBatches[i]
+		iatBatch.Header.recordType = "5"
+		for _, e := range iatBatch.Entries {
+			setIATEntryRecordType(e)
+		}
+		if err := iatBatch.build(); err != nil {
+			return nil, iatBatch.Error("from XML", err)
+		}
+		file.IATBatches = append(file.IATBatches, iatBatch)
+	}
+
+	// Overwrite various timestamps with their ACH formatted values
+	file.overwriteDateTimeFields()
+
+	if !file.IsADV() {
+		file.Control = *fx.Control
+		file.Control.BatchCount = len(file.Batches) + len(file.IATBatches)
+	} else {
+		file.ADVControl = *fx.ADVControl
+		file.ADVControl.BatchCount = len(file.Batches)
+	}
+
+	if err := file.Create(); err != nil {
+		return file, err
+	}
+	if err := file.Validate(); err != nil {
+		return file, err
+	}
+	return file, nil
+}
+
+// UnmarshalXML reads a Batch from XML, missing records are left as their defaults.
+func (batch *Batch) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	batch.Header = NewBatchHeader()
+	batch.Control = NewBatchControl()
+	batch.ADVControl = NewADVBatchControl()
+
+	type Alias Batch
+	return d.DecodeElement((*Alias)(batch), &start)
+}
+
+// UnmarshalXML reads an IATBatch from XML, missing records are left as their defaults.
+func (iatBatch *IATBatch) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	iatBatch.Header = NewIATBatchHeader()
+	iatBatch.Control = NewBatchControl()
+
+	type Alias IATBatch
+	return d.DecodeElement((*Alias)(iatBatch), &start)
+}
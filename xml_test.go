@@ -0,0 +1,232 @@
+// Licensed to The Moov Authors under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. The Moov Authors licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package ach
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestXML__roundTrip writes every valid file in test/testdata as XML and expects
+// the file read back from XML to be written as the same NACHA text.
+func TestXML__roundTrip(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("test", "testdata", "*.*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	checked := 0
+	for _, path := range paths {
+		var file *File
+		switch filepath.Ext(path) {
+		case ".ach":
+			fd, err := os.Open(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f, err := NewReader(fd).Read()
+			fd.Close()
+			if err == nil {
+				file = &f
+			}
+		case ".json":
+			bs, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f, err := FileFromJSON(bs); err == nil {
+				file = f
+			}
+		}
+		if file == nil || createXMLTestFile(file) != nil {
+			continue // invalid files are tested elsewhere
+		}
+
+		bs, err := xml.Marshal(file)
+		if err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		read, err := FileFromXML(bs)
+		if err != nil {
+			t.Fatalf("%s: %v\n%s", path, err, bs)
+		}
+
+		var expected, got bytes.Buffer
+		if err := NewWriter(&expected).Write(file); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if err := NewWriter(&got).Write(read); err != nil {
+			t.Fatalf("%s: %v", path, err)
+		}
+		if expected.String() != got.String() {
+			t.Errorf("%s: NACHA contents changed\nexpected:\n%s\ngot:\n%s", path, expected.String(), got.String())
+		}
+		if len(read.ReturnEntries) != len(file.ReturnEntries) || len(read.NotificationOfChange) != len(file.NotificationOfChange) {
+			t.Errorf("%s: ReturnEntries=%d NotificationOfChange=%d", path, len(read.ReturnEntries), len(read.NotificationOfChange))
+		}
+		checked++
+	}
+	if checked < 25 {
+		t.Errorf("only %d files were checked", checked)
+	}
+}
+
+// createXMLTestFile builds each batch and calls Create like FileFromXML, which renumbers records.
+func createXMLTestFile(file *File) error {
+	for _, b := range file.Batches {
+		if err := b.Create(); err != nil {
+			return err
+		}
+	}
+	for i := range file.IATBatches {
+		if err := file.IATBatches[i].Create(); err != nil {
+			return err
+		}
+	}
+	if err := file.Create(); err != nil {
+		return err
+	}
+	return file.Validate()
+}
+
+func TestXML__layout(t *testing.T) {
+	fd, err := os.Open(filepath.Join("test", "testdata", "ppd-debit.ach"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fd.Close()
+	file, err := NewReader(fd).Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.ID = "ppd-debit"
+
+	bs, err := xml.MarshalIndent(&file, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(bs)
+	for _, expected := range []string{
+		"<File>\n  <id>ppd-debit</id>\n  <fileHeader>",
+		"<immediateOrigin>121042882</immediateOrigin>",
+		"<batch>\n    <batchHeader>",
+		"<entryDetail>",
+		"<DFIAccountNumber>12345678         </DFIAccountNumber>",
+		"<fileControl>",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected %q in\n%s", expected, out)
+		}
+	}
+	for _, unexpected := range []string{"fileADVControl", "advBatchControl", "ReturnEntries", "NotificationOfChange"} {
+		if strings.Contains(out, unexpected) {
+			t.Errorf("unexpected %q in\n%s", unexpected, out)
+		}
+	}
+
+	// File is also decoded as part of other documents
+	var doc struct {
+		Files []File `xml:"File"`
+	}
+	if err := xml.Unmarshal([]byte("<files>"+out+out+"</files>"), &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Files) != 2 || doc.Files[1].ID != "ppd-debit" || len(doc.Files[1].Batches) != 1 {
+		t.Errorf("unexpected files: %#v", doc.Files)
+	}
+}
+
+func TestXML__errors(t *testing.T) {
+	if _, err := FileFromXML(nil); err == nil {
+		t.Error("expected error")
+	}
+	if _, err := FileFromXML([]byte("<File><id>")); err == nil {
+		t.Error("expected error")
+	}
+
+	// batches must have entries
+	_, err := FileFromXML([]byte(`<File><batch><batchHeader><standardEntryClassCode>PPD</standardEntryClassCode></batchHeader></batch></File>`))
+	if err == nil {
+		t.Error("expected error")
+	}
+}
+
+// TestXML__schema checks every complexType of ach.xsd lists the XML fields of its Go type in order.
+func TestXML__schema(t *testing.T) {
+	bs, err := ioutil.ReadFile("ach.xsd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema struct {
+		ComplexTypes []struct {
+			Name     string `xml:"name,attr"`
+			Elements []struct {
+				Name string `xml:"name,attr"`
+				Type string `xml:"type,attr"`
+			} `xml:"sequence>element"`
+		} `xml:"complexType"`
+	}
+	if err := xml.Unmarshal(bs, &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	types := map[string]reflect.Type{
+		"File": reflect.TypeOf(fileXML{}),
+	}
+	for _, v := range []interface{}{
+		FileHeader{}, FileControl{}, ADVFileControl{}, Batch{}, BatchHeader{}, BatchControl{}, ADVBatchControl{},
+		EntryDetail{}, ADVEntryDetail{}, IATBatch{}, IATBatchHeader{}, IATEntryDetail{},
+		Addenda02{}, Addenda05{}, Addenda10{}, Addenda11{}, Addenda12{}, Addenda13{}, Addenda14{},
+		Addenda15{}, Addenda16{}, Addenda17{}, Addenda18{}, Addenda98{}, Addenda99{},
+	} {
+		types[reflect.TypeOf(v).Name()] = reflect.TypeOf(v)
+	}
+	if len(schema.ComplexTypes) != len(types) {
+		t.Errorf("ach.xsd has %d complexTypes, expected %d", len(schema.ComplexTypes), len(types))
+	}
+
+	for _, ct := range schema.ComplexTypes {
+		typ, ok := types[ct.Name]
+		if !ok {
+			t.Errorf("unknown complexType %s", ct.Name)
+			continue
+		}
+		var fields []string
+		for i := 0; i < typ.NumField(); i++ {
+			tag := typ.Field(i).Tag.Get("xml")
+			if tag != "" && tag != "-" {
+				fields = append(fields, strings.Split(tag, ",")[0])
+			}
+		}
+		var elements []string
+		for _, elm := range ct.Elements {
+			elements = append(elements, elm.Name)
+			if _, ok := types[elm.Type]; !ok && !strings.HasPrefix(elm.Type, "xs:") {
+				t.Errorf("%s/%s has unknown type %s", ct.Name, elm.Name, elm.Type)
+			}
+		}
+		if !reflect.DeepEqual(fields, elements) {
+			t.Errorf("%s: ach.xsd elements %v don't match fields %v", ct.Name, elements, fields)
+		}
+	}
+}